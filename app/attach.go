@@ -0,0 +1,94 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"image/color"
+)
+
+/*
+
+  File:    attach.go
+  Author:  Bob Shofner
+
+  MIT License - https://opensource.org/license/mit/
+
+  This permission notice shall be included in all copies
+    or substantial portions of the Software.
+
+*/
+/*
+  Description: Choose the original files to embed in the PDF.
+
+  Each file up to the "attach" size is attached, in the order shown,
+  until the "attachTotal" size is reached ("0" for no limit). The files
+  after that have a note under their name instead.
+*/
+
+const DefaultAttachTotal = "20m"
+
+const noteSize = 5.0
+
+// Attach is an original file embedded in the PDF. Its badge, at the
+// corner of the image, opens it.
+type Attach struct {
+	Path  string
+	Badge Rect
+	Text  TextLine
+}
+
+// attachSizes are the largest file to attach, and the total. A total that
+// isn't a size is DefaultAttachTotal (not no limit), and an issue.
+func attachSizes(options Options, summary *Summary) (int64, int64) {
+	max, err := parseSize(options.Attach)
+	if err != nil {
+		summary.AddIssue("attach "+options.Attach, IssueAttach, err)
+		return 0, 0
+	}
+	total, err := parseSize(options.AttachTotal)
+	if err != nil {
+		summary.AddIssue("attachTotal "+options.AttachTotal, IssueAttach,
+			errors.New(fmt.Sprintf("%v, %s is used", err, DefaultAttachTotal)))
+		total, _ = parseSize(DefaultAttachTotal)
+	}
+	return max, total
+}
+
+// attach marks the cells of the files to be embedded.
+func (d *Document) attach(max, total int64, summary *Summary) {
+	if max <= 0 {
+		return
+	}
+	var used int64
+	for i := range d.Sections {
+		for j := range d.Sections[i].Pages {
+			for k := range d.Sections[i].Pages[j].Cells {
+				cell := &d.Sections[i].Pages[j].Cells[k]
+				meta := metaOf(cell.Entry)
+				if meta.Category == FolderExt || meta.Size == 0 || meta.Size > max {
+					continue
+				}
+				if total > 0 && used+meta.Size > total {
+					cell.Note = TextLine{Text: "(not attached)", X: cell.Name.X, Y: cell.Name.Y + noteSize + 1, Size: noteSize}
+					summary.AddIssue(cell.Entry.Path, IssueAttach,
+						errors.New(fmt.Sprintf("not attached, over the total of %s", sizeText(total))))
+					continue
+				}
+				used += meta.Size
+				cell.Attach = attachBadge(cell.Entry.Path, cell.Image)
+			}
+		}
+	}
+}
+
+// attachBadge is at the bottom right of the image.
+func attachBadge(path string, image Rect) *Attach {
+	w, h := badgeSize*2.2, badgeSize+3
+	badge := Rect{X: image.X + image.W - w + 2, Y: image.Y + image.H - h + 2, W: w, H: h}
+	return &Attach{
+		Path:  path,
+		Badge: badge,
+		Text: TextLine{Text: "FILE", X: badge.X + badgeSize*0.25, Y: badge.Y + badgeSize + 0.5, Size: badgeSize - 1, Bold: true,
+			Color: color.RGBA{R: 0xFF, G: 0xFF, B: 0xFF, A: 0xFF}},
+	}
+}
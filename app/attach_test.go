@@ -0,0 +1,50 @@
+package app
+
+import (
+	"testing"
+)
+
+func TestAttachSizes(t *testing.T) {
+	tests := []struct {
+		attach, total string
+		max, want     int64
+		issues        int
+	}{
+		{"1m", "20m", 1 << 20, 20 << 20, 0},
+		{"1m", "0", 1 << 20, 0, 0}, // no limit
+		{"1m", "lots", 1 << 20, 20 << 20, 1},
+		{"1m", "", 1 << 20, 20 << 20, 1},
+		{"big", "20m", 0, 0, 1},
+	}
+	for _, tt := range tests {
+		summary := NewSummary()
+		max, total := attachSizes(Options{Attach: tt.attach, AttachTotal: tt.total}, summary)
+		if max != tt.max || total != tt.want || len(summary.Issues) != tt.issues {
+			t.Errorf("attachSizes(%q, %q) = %d, %d and %d issues, want %d, %d and %d", tt.attach, tt.total,
+				max, total, len(summary.Issues), tt.max, tt.want, tt.issues)
+		}
+	}
+}
+
+func TestAttach(t *testing.T) {
+	entries := layoutEntries(5)
+	for i, size := range []int64{100, 5000, 300, 400, 0} {
+		entries[i].Meta.Size = size
+	}
+	doc := &Document{Sections: []Section{NewLayout(0, 0).Section("/d", entries, 1)}}
+	summary := NewSummary()
+	doc.attach(1000, 500, summary)
+	cells := doc.Sections[0].Cells()
+	// too big, then over the total, then empty
+	for i, want := range []bool{true, false, true, false, false} {
+		if got := cells[i].Attach != nil; got != want {
+			t.Errorf("cell %d attached %v, want %v", i, got, want)
+		}
+	}
+	if cells[3].Note.Text != "(not attached)" || cells[1].Note.Text != "" {
+		t.Errorf("notes %q %q", cells[3].Note.Text, cells[1].Note.Text)
+	}
+	if len(summary.Issues) != 1 || summary.Issues[0].Path != entries[3].Path {
+		t.Errorf("issues %v, want the one over the total", summary.Issues)
+	}
+}
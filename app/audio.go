@@ -31,6 +31,7 @@ type AudioInfo struct {
 	collection string
 	genre      string
 	year       string
+	track      string // "3" or "3/12"
 	length     uint16 // seconds
 	audioType  string // MP3, ...
 	mime       string
@@ -76,10 +77,23 @@ func ID3Details(path string) (audioInfo AudioInfo, err error) {
 	audioInfo.collection = noHidden(tag.Album())
 	audioInfo.year = noHidden(tag.Year())
 	audioInfo.genre = noHidden(tag.Genre())
+	audioInfo.track = noHidden(tag.GetTextFrame(tag.CommonID("Track number/Position in set")).Text)
 
 	return
 }
 
+// Meta is the tag values, by name.
+func (a AudioInfo) Meta() map[string]string {
+	return map[string]string{
+		"artist": a.artist,
+		"title":  a.title,
+		"album":  a.collection,
+		"year":   a.year,
+		"genre":  a.genre,
+		"track":  a.track,
+	}
+}
+
 const audioFmt = "Artist: %s\nTitle: %s\nAlbum: %s\n" + "Year: %s, Genre: %s"
 
 func (a AudioInfo) String() string {
@@ -0,0 +1,185 @@
+package app
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+/*
+
+  File:    build.go
+  Author:  Bob Shofner
+
+  MIT License - https://opensource.org/license/mit/
+
+  This permission notice shall be included in all copies
+    or substantial portions of the Software.
+
+*/
+/*
+  Description: Only the directories that changed are read again.
+
+  What was made for each directory is kept next to the output
+  (sheet.pdf -> sheet.snap.json): a fingerprint of its files (names,
+  sizes and times), its entries with their thumbnails and metadata, and
+  the pages they took. The next time, a directory with the same
+  fingerprint uses those entries, and is only laid out again.
+
+  Everything is read again if the options the entries depend on
+  (filter, sort, group, captions, manifest, dupes), the file types or the
+  thumbnail providers changed, or with Force.
+*/
+
+const buildVersion = 2
+
+// Build is the prepared entries of the directories of an output.
+type Build struct {
+	Version  int            `json:"version"`
+	Options  string         `json:"options"`
+	Sections []BuiltSection `json:"sections"`
+	Reused   int            `json:"-"`
+	previous map[string]BuiltSection
+}
+
+type BuiltSection struct {
+	Dir         string      `json:"dir"`
+	Fingerprint string      `json:"fingerprint"`
+	Pages       int         `json:"pages"`
+	Files       int         `json:"files"`
+	Entries     []Entry     `json:"entries"`
+	Metadata    []*FileMeta `json:"metadata"` // all of the files, the entries' are found by path
+	Mismatches  []Mismatch  `json:"mismatches"`
+	Issues      []Issue     `json:"issues"`
+}
+
+func buildFile(output string) string {
+	return strings.TrimSuffix(output, filepath.Ext(output)) + ".snap.json"
+}
+
+// entryOptions is a fingerprint of the options the entries depend on,
+// with the file types (types.json) and the providers in use.
+func entryOptions(o Options) string {
+	content, _ := json.Marshal(struct {
+		Filter     Filter
+		Sort       string
+		Descending bool
+		Group      string
+		Captions   bool
+		Manifest   bool // the files are hashed
+		Dupes      bool // and the images
+		Types      *FileTypes
+		Providers  []string
+	}{o.Filter, o.Sort, o.Descending, o.Group, o.Captions, o.Manifest != "", o.Dupes,
+		GetFileTypes(), providerNames()})
+	return fmt.Sprintf("%x", sha256.Sum256(content))
+}
+
+// dirFingerprint changes when a file of the directory (as filtered) is
+// added, removed, renamed or changed. "" if it can't be read.
+func dirFingerprint(dir string, filter Filter) string {
+	names, err := getAllFiles(dir, filter)
+	if err != nil {
+		return ""
+	}
+	h := sha256.New()
+	for _, name := range names {
+		info, err := os.Stat(filepath.Join(dir, name))
+		if err != nil {
+			return ""
+		}
+		_, _ = fmt.Fprintf(h, "%s\x00%d\x00%d\n", name, info.Size(), info.ModTime().UnixNano())
+	}
+	return fmt.Sprintf("%x", h.Sum(nil))
+}
+
+// loadBuild gets the Build of the last time the output was made. It is
+// empty with Force, or if that was with other options.
+func loadBuild(output string, options Options) *Build {
+	b := &Build{Version: buildVersion, Options: entryOptions(options),
+		Sections: make([]BuiltSection, 0), previous: make(map[string]BuiltSection)}
+	if options.Force {
+		return b
+	}
+	content, err := os.ReadFile(buildFile(output))
+	if err != nil {
+		return b
+	}
+	var last Build
+	if json.Unmarshal(content, &last) != nil || last.Version != buildVersion || last.Options != b.Options {
+		return b
+	}
+	for _, s := range last.Sections {
+		b.previous[s.Dir] = s
+	}
+	return b
+}
+
+// usable if the thumbnails are still there (those in TempDir go at exit).
+func (s BuiltSection) usable() bool {
+	for _, e := range s.Entries {
+		if _, err := os.Stat(e.Thumb.Path); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// link the entries to their metadata, as they were when prepared. Dupe
+// is found again, from all of the sections.
+func (s BuiltSection) link() {
+	metas := make(map[string]*FileMeta)
+	for _, m := range s.Metadata {
+		metas[m.Path] = m
+	}
+	for i := range s.Entries {
+		s.Entries[i].Meta = metas[s.Entries[i].Path]
+		s.Entries[i].Dupe = 0
+	}
+}
+
+// prepare is prepareDir, unless the directory is as it was the last time.
+func (b *Build) prepare(ctx context.Context, dir string, options Options, summary *Summary) []Entry {
+	fingerprint := dirFingerprint(dir, options.Filter)
+	last, ok := b.previous[dir]
+	if !ok || fingerprint == "" || last.Fingerprint != fingerprint || !last.usable() {
+		s := NewSummary()
+		entries := prepareDir(ctx, dir, options, s)
+		last = BuiltSection{Dir: dir, Fingerprint: fingerprint, Files: s.Files,
+			Entries: append([]Entry{}, entries...), Metadata: s.Metadata, Mismatches: s.Mismatches, Issues: s.Issues}
+	} else {
+		last.link()
+		b.Reused++
+	}
+	if ctx.Err() == nil {
+		b.Sections = append(b.Sections, last)
+	}
+	summary.Directories++
+	summary.Files += last.Files
+	summary.Metadata = append(summary.Metadata, last.Metadata...)
+	summary.Mismatches = append(summary.Mismatches, last.Mismatches...)
+	summary.Issues = append(summary.Issues, last.Issues...)
+	return append([]Entry{}, last.Entries...)
+}
+
+// pages records the pages a directory took.
+func (b *Build) pages(dir string, n int) {
+	for i := range b.Sections {
+		if b.Sections[i].Dir == dir {
+			b.Sections[i].Pages = n
+		}
+	}
+}
+
+// save the Build for the next time.
+func (b *Build) save(output string) error {
+	content, err := json.Marshal(b)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(buildFile(output), content, 0644)
+}
@@ -0,0 +1,174 @@
+package app
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestDirFingerprint(t *testing.T) {
+	dir := t.TempDir()
+	testPNG(t, filepath.Join(dir, "a.png"))
+	testPNG(t, filepath.Join(dir, "b.png"))
+	filter := DefaultFilter()
+	first := dirFingerprint(dir, filter)
+	if first == "" || dirFingerprint(dir, filter) != first {
+		t.Fatalf("fingerprint %q is not the same again", first)
+	}
+	later := time.Now().Add(time.Hour)
+	changes := []struct {
+		name   string
+		change func() error
+	}{
+		{"hidden file added", func() error { return os.WriteFile(filepath.Join(dir, ".hidden"), []byte("x"), 0644) }},
+		{"added", func() error { return os.WriteFile(filepath.Join(dir, "c.txt"), []byte("x"), 0644) }},
+		{"size", func() error { return os.WriteFile(filepath.Join(dir, "c.txt"), []byte("xy"), 0644) }},
+		{"time", func() error { return os.Chtimes(filepath.Join(dir, "a.png"), later, later) }},
+		{"renamed", func() error { return os.Rename(filepath.Join(dir, "b.png"), filepath.Join(dir, "d.png")) }},
+		{"removed", func() error { return os.Remove(filepath.Join(dir, "c.txt")) }},
+	}
+	last := first
+	for _, c := range changes {
+		if err := c.change(); err != nil {
+			t.Fatal(err)
+		}
+		got := dirFingerprint(dir, filter)
+		if hidden := c.name == "hidden file added"; hidden != (got == last) {
+			t.Errorf("%s: fingerprint changed %v", c.name, got != last)
+		}
+		last = got
+	}
+	// a filter that leaves out the file that changes
+	filter.Globs = []string{"*.png"}
+	before := dirFingerprint(dir, filter)
+	if err := os.WriteFile(filepath.Join(dir, "e.txt"), []byte("x"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if dirFingerprint(dir, filter) != before {
+		t.Errorf("a file the filter leaves out changed the fingerprint")
+	}
+	if got := dirFingerprint(filepath.Join(dir, "missing"), filter); got != "" {
+		t.Errorf("fingerprint of a missing directory = %q", got)
+	}
+}
+
+func TestLoadBuild(t *testing.T) {
+	defaultTypes()
+	dir, out := t.TempDir(), t.TempDir()
+	testPNG(t, filepath.Join(dir, "a.png"))
+	testPNG(t, filepath.Join(dir, "b.png"))
+	output := filepath.Join(out, "sheet.pdf")
+	options := DefaultOptions()
+	build := func(options Options) *Build {
+		b := loadBuild(output, options)
+		summary := NewSummary()
+		if entries := b.prepare(context.Background(), dir, options, summary); len(entries) != 2 || summary.Files != 2 {
+			t.Fatalf("%d entries, %d files, want 2", len(entries), summary.Files)
+		}
+		b.pages(dir, 1)
+		if err := b.save(output); err != nil {
+			t.Fatal(err)
+		}
+		return b
+	}
+	if b := build(options); b.Reused != 0 {
+		t.Errorf("the first build reused %d", b.Reused)
+	}
+	b := build(options)
+	if b.Reused != 1 || len(b.Sections) != 1 || b.Sections[0].Pages != 1 {
+		t.Errorf("the same build reused %d, sections %+v", b.Reused, b.Sections)
+	}
+	if entries := b.Sections[0].Entries; entries[0].Meta == nil || entries[0].Meta.Path != entries[0].Path {
+		t.Errorf("reused entries are not linked to their metadata")
+	}
+	b = loadBuild(output, options)
+	for _, s := range b.previous {
+		s.Entries[0].Dupe = 3 // as marked the last time
+	}
+	if entries := b.prepare(context.Background(), dir, options, NewSummary()); entries[0].Dupe != 0 {
+		t.Errorf("a reused entry kept Dupe %d", entries[0].Dupe)
+	}
+	forced := options
+	forced.Force = true
+	if b = build(forced); b.Reused != 0 {
+		t.Errorf("Force reused %d", b.Reused)
+	}
+	other := options
+	other.Sort = SortSize
+	if b = build(other); b.Reused != 0 {
+		t.Errorf("other options reused %d", b.Reused)
+	}
+	later := time.Now().Add(time.Hour)
+	if err := os.Chtimes(filepath.Join(dir, "a.png"), later, later); err != nil {
+		t.Fatal(err)
+	}
+	if b = build(other); b.Reused != 0 {
+		t.Errorf("a changed directory was reused")
+	}
+	if err := os.WriteFile(buildFile(output), []byte(`{"version": 1}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if b = loadBuild(output, other); len(b.previous) != 0 {
+		t.Errorf("a build of another version was used")
+	}
+}
+
+// noProvider makes no thumbnails.
+type noProvider struct{}
+
+func (noProvider) Match(string, fs.FileInfo) bool { return false }
+func (noProvider) Render(context.Context, string, int) (Thumbnail, error) {
+	return Thumbnail{}, nil
+}
+
+func TestEntryOptions(t *testing.T) {
+	defaultTypes()
+	options := DefaultOptions()
+	first := entryOptions(options)
+	if entryOptions(options) != first {
+		t.Fatalf("entryOptions is not the same again")
+	}
+	options.Title = "not for the entries"
+	if entryOptions(options) != first {
+		t.Errorf("the title changed entryOptions")
+	}
+	options.Captions = !options.Captions
+	if entryOptions(options) == first {
+		t.Errorf("captions didn't change entryOptions")
+	}
+	// with a manifest, the dupes still change them (the images are hashed)
+	options = DefaultOptions()
+	options.Manifest = ManifestJSON
+	manifest := entryOptions(options)
+	options.Dupes = true
+	if entryOptions(options) == manifest {
+		t.Errorf("dupes on didn't change entryOptions, with a manifest")
+	}
+	dupes := entryOptions(options)
+	options.Manifest = ""
+	if entryOptions(options) == dupes {
+		t.Errorf("the manifest off didn't change entryOptions, with dupes")
+	}
+	options = DefaultOptions()
+	GetFileTypes().Extensions[".xyz"] = CameraExt
+	changed := entryOptions(options)
+	delete(GetFileTypes().Extensions, ".xyz")
+	if changed == first {
+		t.Errorf("the file types didn't change entryOptions")
+	}
+	providerLock.RLock()
+	registered := append([]registeredProvider{}, providers...)
+	providerLock.RUnlock()
+	t.Cleanup(func() { // for the other tests
+		providerLock.Lock()
+		providers = registered
+		providerLock.Unlock()
+	})
+	RegisterThumbnailProvider("test-none", -1, noProvider{})
+	if entryOptions(options) == first {
+		t.Errorf("a provider didn't change entryOptions")
+	}
+}
@@ -0,0 +1,238 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"snap/element"
+	"strconv"
+	"strings"
+)
+
+/*
+
+  File:    command.go
+  Author:  Bob Shofner
+
+  MIT License - https://opensource.org/license/mit/
+
+  This permission notice shall be included in all copies
+    or substantial portions of the Software.
+
+*/
+/*
+  Description: The typed commands, their arguments and their help.
+
+  A line is split into words at spaces. "double" or 'single' quotes keep
+  spaces in a word, and \ before a quote, a space or a \ keeps it as is
+  (any other \ is part of the word, as in C:\Users).
+
+  After the command, --name, --name value, --name=value and -n are its
+  flags (-- ends them), the rest are its words:
+    a -r ~/Pictures
+    p --cols 6 "my sheets.pdf"
+    rm 3
+*/
+
+var ErrUnknownCommand = errors.New("unknown command")
+
+type Command struct {
+	Names []string // the first is the short one, all do the same
+	Usage string   // of the words and flags: "[-r] [path ...]"
+	Help  string   // one line
+	Flags []Flag
+	Raw   bool // the words are not flags (a "-" may start one)
+	Run   func(args Args) error
+}
+
+type Flag struct {
+	Name  string // typed as --name
+	Short string // typed as -s, if any
+	Value bool   // it takes a value
+	Help  string
+}
+
+// Args are what was typed after the command.
+type Args struct {
+	Words []string
+	flags map[string]string
+}
+
+// Has is true if the flag was typed.
+func (a Args) Has(name string) bool {
+	_, ok := a.flags[name]
+	return ok
+}
+
+// Value of a flag, "" if it wasn't typed.
+func (a Args) Value(name string) string {
+	return a.flags[name]
+}
+
+// Int value of a flag, fallback if it wasn't typed.
+func (a Args) Int(name string, fallback int) (int, error) {
+	v, ok := a.flags[name]
+	if !ok {
+		return fallback, nil
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		return fallback, errors.New(fmt.Sprintf("--%s %q is not a number", name, v))
+	}
+	return n, nil
+}
+
+// Word i, "" if there aren't that many.
+func (a Args) Word(i int) string {
+	if i < len(a.Words) {
+		return a.Words[i]
+	}
+	return ""
+}
+
+// Tokenize splits a line into words, by the rules of element.SplitWords
+// (which tab completion uses too).
+func Tokenize(line string) ([]string, error) {
+	split, open := element.SplitWords(line)
+	words := make([]string, 0, len(split))
+	for _, w := range split {
+		words = append(words, w.Text)
+	}
+	if open != 0 {
+		return words[:len(words)-1], errors.New(fmt.Sprintf("no closing %c", open))
+	}
+	return words, nil
+}
+
+// Commands is the registry of the typed commands.
+type Commands struct {
+	list []*Command
+}
+
+func (c *Commands) Add(cmd Command) {
+	c.list = append(c.list, &cmd)
+}
+
+func (c *Commands) find(name string) *Command {
+	name = strings.ToLower(name)
+	for _, cmd := range c.list {
+		for _, n := range cmd.Names {
+			if n == name {
+				return cmd
+			}
+		}
+	}
+	return nil
+}
+
+// Names of all of the commands.
+func (c *Commands) Names() []string {
+	names := make([]string, 0)
+	for _, cmd := range c.list {
+		names = append(names, cmd.Names...)
+	}
+	return names
+}
+
+// Run the command typed. An empty line, or an unknown command, is
+// ErrUnknownCommand.
+func (c *Commands) Run(line string) error {
+	words, err := Tokenize(line)
+	if err != nil {
+		return err
+	}
+	if len(words) == 0 {
+		return ErrUnknownCommand
+	}
+	cmd := c.find(words[0])
+	if cmd == nil {
+		return ErrUnknownCommand
+	}
+	args, err := cmd.parse(words[1:])
+	if err != nil {
+		return errors.New(fmt.Sprintf("%v (\"h %s\" for help)", err, cmd.Names[0]))
+	}
+	return cmd.Run(args)
+}
+
+// parse the words after the command into its flags and words.
+func (cmd *Command) parse(words []string) (Args, error) {
+	args := Args{Words: make([]string, 0), flags: make(map[string]string)}
+	for i := 0; i < len(words); i++ {
+		w := words[i]
+		if cmd.Raw || len(w) < 2 || w[0] != '-' {
+			args.Words = append(args.Words, w)
+			continue
+		}
+		if w == "--" {
+			args.Words = append(args.Words, words[i+1:]...)
+			break
+		}
+		name, value, hasValue := strings.Cut(strings.TrimLeft(w, "-"), "=")
+		var flag *Flag
+		for j := range cmd.Flags {
+			f := &cmd.Flags[j]
+			if strings.HasPrefix(w, "--") && f.Name == name || !strings.HasPrefix(w, "--") && f.Short == name {
+				flag = f
+			}
+		}
+		if flag == nil {
+			return args, errors.New(fmt.Sprintf("%s has no flag %s", cmd.Names[0], w))
+		}
+		if flag.Value && !hasValue {
+			if i+1 >= len(words) {
+				return args, errors.New(fmt.Sprintf("%s needs a value", w))
+			}
+			i++
+			value = words[i]
+		}
+		if !flag.Value && hasValue {
+			return args, errors.New(fmt.Sprintf("%s doesn't take a value", w))
+		}
+		args.flags[flag.Name] = value
+	}
+	return args, nil
+}
+
+// line is the one line of help.
+func (cmd *Command) line() string {
+	name := cmd.Names[0]
+	if len(cmd.Names) > 1 {
+		name = fmt.Sprintf("(%s) %s", cmd.Names[0], cmd.Names[1])
+	}
+	if cmd.Usage != "" {
+		name += " " + cmd.Usage
+	}
+	return fmt.Sprintf("%s - %s", name, cmd.Help)
+}
+
+// Help is a line for each command.
+func (c *Commands) Help() []string {
+	lines := make([]string, 0, len(c.list))
+	for _, cmd := range c.list {
+		lines = append(lines, cmd.line())
+	}
+	return lines
+}
+
+// HelpFor a command, with its flags.
+func (c *Commands) HelpFor(name string) ([]string, error) {
+	cmd := c.find(name)
+	if cmd == nil {
+		return nil, ErrUnknownCommand
+	}
+	lines := []string{cmd.line()}
+	if len(cmd.Names) > 2 {
+		lines = append(lines, "  also: "+strings.Join(cmd.Names[2:], ", "))
+	}
+	for _, f := range cmd.Flags {
+		flag := "--" + f.Name
+		if f.Short != "" {
+			flag = "-" + f.Short + ", " + flag
+		}
+		if f.Value {
+			flag += " <value>"
+		}
+		lines = append(lines, fmt.Sprintf("  %s  %s", flag, f.Help))
+	}
+	return lines, nil
+}
@@ -0,0 +1,115 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestTokenize(t *testing.T) {
+	tests := []struct {
+		line  string
+		words []string
+		err   bool
+	}{
+		{"", []string{}, false},
+		{"   ", []string{}, false},
+		{"a -r ~/Pictures", []string{"a", "-r", "~/Pictures"}, false},
+		{"  p\t--cols 6  ", []string{"p", "--cols", "6"}, false},
+		{`p "my sheets.pdf"`, []string{"p", "my sheets.pdf"}, false},
+		{`p 'my sheets.pdf'`, []string{"p", "my sheets.pdf"}, false},
+		{`a ~/My\ Docs/x`, []string{"a", "~/My Docs/x"}, false},
+		{`a "it's"`, []string{"a", "it's"}, false},
+		{`a 'say "hi"'`, []string{"a", `say "hi"`}, false},
+		{`a "say \"hi\""`, []string{"a", `say "hi"`}, false},
+		{`a 'no \' escape`, []string{"a", `no \`, "escape"}, false}, // \ is as is in single quotes
+		{`a C:\Users\me`, []string{"a", `C:\Users\me`}, false},
+		{`a back\\slash`, []string{"a", `back\slash`}, false},
+		{`a ""`, []string{"a", ""}, false},
+		{`a x""y`, []string{"a", "xy"}, false},
+		{`a "open`, []string{"a"}, true},
+		{`a trailing\`, []string{"a", `trailing\`}, false},
+	}
+	for _, tt := range tests {
+		words, err := Tokenize(tt.line)
+		if (err != nil) != tt.err {
+			t.Errorf("Tokenize(%s) error = %v, want an error %v", tt.line, err, tt.err)
+		}
+		if fmt.Sprintf("%q", words) != fmt.Sprintf("%q", tt.words) {
+			t.Errorf("Tokenize(%s) = %q, want %q", tt.line, words, tt.words)
+		}
+	}
+}
+
+func TestCommandParse(t *testing.T) {
+	cmd := &Command{Names: []string{"p"}, Flags: []Flag{
+		{Name: "cols", Value: true},
+		{Name: "recursive", Short: "r"},
+		{Name: "force"},
+	}}
+	tests := []struct {
+		words []string
+		want  []string // the words
+		flags map[string]string
+		err   bool
+	}{
+		{[]string{}, []string{}, map[string]string{}, false},
+		{[]string{"a.pdf"}, []string{"a.pdf"}, map[string]string{}, false},
+		{[]string{"--cols", "6", "a.pdf"}, []string{"a.pdf"}, map[string]string{"cols": "6"}, false},
+		{[]string{"--cols=6", "-r", "--force"}, []string{}, map[string]string{"cols": "6", "recursive": "", "force": ""}, false},
+		{[]string{"--", "-r", "--cols"}, []string{"-r", "--cols"}, map[string]string{}, false},
+		{[]string{"-", "x"}, []string{"-", "x"}, map[string]string{}, false},
+		{[]string{"--recursive"}, []string{}, map[string]string{"recursive": ""}, false},
+		{[]string{"-recursive"}, nil, nil, true}, // the long name with one -
+		{[]string{"--r"}, nil, nil, true},        // the short name with two
+		{[]string{"--cols"}, nil, nil, true},
+		{[]string{"--force=yes"}, nil, nil, true},
+		{[]string{"--rows", "2"}, nil, nil, true},
+	}
+	for _, tt := range tests {
+		args, err := cmd.parse(tt.words)
+		if (err != nil) != tt.err {
+			t.Errorf("parse(%q) error = %v, want an error %v", tt.words, err, tt.err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if fmt.Sprintf("%q", args.Words) != fmt.Sprintf("%q", tt.want) || fmt.Sprint(args.flags) != fmt.Sprint(tt.flags) {
+			t.Errorf("parse(%q) = %q %v, want %q %v", tt.words, args.Words, args.flags, tt.want, tt.flags)
+		}
+	}
+	raw := &Command{Names: []string{"f"}, Raw: true}
+	if args, _ := raw.parse([]string{"include", "-x", "--y"}); len(args.Words) != 3 {
+		t.Errorf("raw words %q", args.Words)
+	}
+}
+
+func TestCommandsRun(t *testing.T) {
+	var got Args
+	c := &Commands{}
+	c.Add(Command{Names: []string{"p", "pdf"}, Flags: []Flag{{Name: "cols", Value: true}}, Run: func(args Args) error {
+		got = args
+		return nil
+	}})
+	if err := c.Run(`PDF --cols 3 "a b.pdf"`); err != nil {
+		t.Fatal(err)
+	}
+	if cols, _ := got.Int("cols", 0); cols != 3 || got.Word(0) != "a b.pdf" || got.Word(1) != "" {
+		t.Errorf("args %+v", got)
+	}
+	if _, err := got.Int("rows", 5); err != nil {
+		t.Errorf("Int of a flag not typed: %v", err)
+	}
+	for _, line := range []string{"", "zz", "  "} {
+		if err := c.Run(line); !errors.Is(err, ErrUnknownCommand) {
+			t.Errorf("Run(%q) = %v, want ErrUnknownCommand", line, err)
+		}
+	}
+	if err := c.Run("p --cols"); err == nil {
+		t.Errorf("Run without a flag's value")
+	}
+	if err := c.Run(`p "open`); err == nil {
+		t.Errorf("Run without a closing quote")
+	}
+}
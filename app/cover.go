@@ -0,0 +1,55 @@
+package app
+
+import (
+	"fmt"
+	"time"
+)
+
+/*
+
+  File:    cover.go
+  Author:  Bob Shofner
+
+  MIT License - https://opensource.org/license/mit/
+
+  This permission notice shall be included in all copies
+    or substantial portions of the Software.
+
+*/
+/*
+  Description: The cover page: the logo, title, subtitle, date and
+    the folders in the document.
+*/
+
+const titleSize = 24.0
+const subtitleSize = 14.0
+const folderSize = 9.0
+
+// CoverPage lays out the cover, page 1. The title is the first line.
+func (l Layout) CoverPage(doc *Document, subtitle string, t *Template, now time.Time) Page {
+	page := Page{Number: 1, Lines: make([]TextLine, 0)}
+	if path := t.logo(); path != "" {
+		if logo, err := picture(path, Rect{X: (pageWidth - 200) / 2, Y: 90, W: 200, H: 200}); err == nil {
+			page.Pictures = append(page.Pictures, *logo)
+		}
+	}
+	center := func(text string, y, size float64, bold bool) {
+		page.Lines = append(page.Lines, TextLine{Text: text, Y: y, Size: size, Bold: bold, Center: true})
+	}
+	center(doc.Title, 340, titleSize, true)
+	if subtitle != "" {
+		center(subtitle, 368, subtitleSize, false)
+	}
+	center(now.Format("January 2, 2006"), 392, headerSize, false)
+	y := 440.0
+	center("Folders", y, folderSize, true)
+	for i, section := range doc.Sections {
+		y += folderSize + 4
+		if y > pageHeight-gridTop {
+			center(fmt.Sprintf("... and %d more", len(doc.Sections)-i), y, folderSize, false)
+			break
+		}
+		center(section.Title, y, folderSize, false)
+	}
+	return page
+}
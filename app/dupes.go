@@ -0,0 +1,307 @@
+package app
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"math"
+	"math/bits"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+)
+
+/*
+
+  File:    dupes.go
+  Author:  Bob Shofner
+
+  MIT License - https://opensource.org/license/mit/
+
+  This permission notice shall be included in all copies
+    or substantial portions of the Software.
+
+*/
+/*
+  Description: Find duplicate files, and images that look the same.
+
+  Exact duplicates have the same SHA256, which is only read for files
+  of the same size. Near duplicates (the same picture, resized or
+  re-compressed) have a difference hash (dHash) and a perceptual hash
+  (pHash) that each differ in only a few of their 64 bits.
+*/
+
+// nearBits is the most dHash bits that differ, for images to look the same.
+const nearBits = 5
+
+// nearPBits is the most pHash bits that differ. It is only a check of the
+// images the dHash found.
+const nearPBits = 10
+
+type DupeGroup struct {
+	Number int  // from 1
+	Exact  bool // all have the same content
+	Files  []*FileMeta
+}
+
+// dHash is the 64 bit difference hash of an image: is each pixel
+// brighter than the next, in a 9 x 8 gray copy.
+func dHash(img image.Image) uint64 {
+	small := resizeImage(img, 9, 8)
+	var hash uint64
+	for y := 0; y < 8; y++ {
+		for x := 0; x < 8; x++ {
+			if gray(small, x, y) > gray(small, x+1, y) {
+				hash |= 1 << uint(y*8+x)
+			}
+		}
+	}
+	return hash
+}
+
+func gray(img *image.NRGBA, x, y int) uint32 {
+	r, g, b, _ := img.At(x, y).RGBA()
+	return (299*r + 587*g + 114*b) / 1000
+}
+
+// pHash is the 64 bit perceptual hash of an image: is each of the lowest
+// 8 x 8 frequencies of a 32 x 32 gray copy more than their median.
+func pHash(img image.Image) uint64 {
+	const n = 32
+	small := resizeImage(img, n, n)
+	pixels := make([]float64, n*n)
+	for y := 0; y < n; y++ {
+		for x := 0; x < n; x++ {
+			pixels[y*n+x] = float64(gray(small, x, y))
+		}
+	}
+	// a DCT of the rows, then of the columns (of the 8 lowest only)
+	cosines := make([]float64, 8*n)
+	for u := 0; u < 8; u++ {
+		for x := 0; x < n; x++ {
+			cosines[u*n+x] = math.Cos(float64(2*x+1) * float64(u) * math.Pi / (2 * n))
+		}
+	}
+	rows := make([]float64, n*8)
+	for y := 0; y < n; y++ {
+		for u := 0; u < 8; u++ {
+			var sum float64
+			for x := 0; x < n; x++ {
+				sum += pixels[y*n+x] * cosines[u*n+x]
+			}
+			rows[y*8+u] = sum
+		}
+	}
+	dct := make([]float64, 64)
+	for v := 0; v < 8; v++ {
+		for u := 0; u < 8; u++ {
+			var sum float64
+			for y := 0; y < n; y++ {
+				sum += rows[y*8+u] * cosines[v*n+y]
+			}
+			dct[v*8+u] = sum
+		}
+	}
+	// the median leaves out the first, the average brightness
+	sorted := append([]float64(nil), dct[1:]...)
+	sort.Float64s(sorted)
+	median := sorted[len(sorted)/2]
+	var hash uint64
+	for i, c := range dct {
+		if c > median {
+			hash |= 1 << uint(i)
+		}
+	}
+	return hash
+}
+
+// imageHashes are the dHash and pHash of an image file, as hex.
+func imageHashes(path string) (string, string, error) {
+	img, err := loadImage(path)
+	if err != nil {
+		return "", "", err
+	}
+	return fmt.Sprintf("%016x", dHash(img)), fmt.Sprintf("%016x", pHash(img)), nil
+}
+
+// nearBands are the parts of a dHash that are looked up. Hashes that differ
+// in no more than nearBits bits are the same in at least one of the
+// nearBits+1 parts, so only the images that share a part are compared.
+func nearBands(hash uint64) []uint64 {
+	const bands = nearBits + 1
+	const width = (64 + bands - 1) / bands
+	parts := make([]uint64, bands)
+	for b := range parts {
+		part := (hash >> uint(b*width)) & (1<<width - 1)
+		parts[b] = uint64(b)<<width | part
+	}
+	return parts
+}
+
+// findDupes groups the files with the same content, or that look the same.
+func findDupes(metas []*FileMeta) []DupeGroup {
+	// union-find, by index in metas
+	parent := make([]int, len(metas))
+	for i := range parent {
+		parent[i] = i
+	}
+	var root func(i int) int
+	root = func(i int) int {
+		if parent[i] != i {
+			parent[i] = root(parent[i])
+		}
+		return parent[i]
+	}
+	join := func(i, j int) {
+		parent[root(i)] = root(j)
+	}
+	// only files of the same size can be the same
+	bySize := make(map[int64][]int)
+	for i, m := range metas {
+		if m.Size > 0 {
+			bySize[m.Size] = append(bySize[m.Size], i)
+		}
+	}
+	bySum := make(map[string]int)
+	for i, m := range metas {
+		if len(bySize[m.Size]) < 2 {
+			continue
+		}
+		if m.SHA256 == "" {
+			m.SHA256, _ = fileHash(m.Path)
+		}
+		if m.SHA256 == "" {
+			continue
+		}
+		if j, ok := bySum[m.SHA256]; ok {
+			join(i, j)
+		} else {
+			bySum[m.SHA256] = i
+		}
+	}
+	hashes := make([]uint64, len(metas))
+	pHashes := make([]uint64, len(metas))
+	bands := make(map[uint64][]int)
+	for i, m := range metas {
+		hashes[i], _ = strconv.ParseUint(m.DHash, 16, 64)
+		pHashes[i], _ = strconv.ParseUint(m.PHash, 16, 64)
+		// a zero hash is an image of one color, not worth matching
+		if hashes[i] == 0 {
+			continue
+		}
+		for _, band := range nearBands(hashes[i]) {
+			for _, j := range bands[band] {
+				if root(i) != root(j) && looksSame(hashes[i], hashes[j], pHashes[i], pHashes[j]) {
+					join(i, j)
+				}
+			}
+			bands[band] = append(bands[band], i)
+		}
+	}
+	members := make(map[int][]*FileMeta)
+	order := make([]int, 0)
+	for i, m := range metas {
+		r := root(i)
+		if _, ok := members[r]; !ok {
+			order = append(order, r)
+		}
+		members[r] = append(members[r], m)
+	}
+	groups := make([]DupeGroup, 0)
+	for _, r := range order {
+		files := members[r]
+		if len(files) < 2 {
+			continue
+		}
+		exact := true
+		for _, f := range files {
+			exact = exact && f.SHA256 != "" && f.SHA256 == files[0].SHA256
+		}
+		groups = append(groups, DupeGroup{Number: len(groups) + 1, Exact: exact, Files: files})
+	}
+	return groups
+}
+
+// looksSame is true if the dHashes are near, and the pHashes too
+// (when both have one).
+func looksSame(d1, d2, p1, p2 uint64) bool {
+	if bits.OnesCount64(d1^d2) > nearBits {
+		return false
+	}
+	return p1 == 0 || p2 == 0 || bits.OnesCount64(p1^p2) <= nearPBits
+}
+
+// FindDupes looks for duplicates in the files of the directories,
+// without making thumbnails.
+func FindDupes(dirs []string, filter Filter) []DupeGroup {
+	metas := make([]*FileMeta, 0)
+	for _, dir := range dirs {
+		names, _ := getAllFiles(dir, filter)
+		sort.Strings(names)
+		for _, name := range names {
+			file := filepath.Join(dir, name)
+			if info, err := os.Stat(file); err != nil || info.IsDir() {
+				continue
+			}
+			content := SniffContent(file)
+			meta := collectMeta(file, content, false)
+			if content.Image != "" {
+				meta.DHash, meta.PHash, _ = imageHashes(file)
+			}
+			metas = append(metas, meta)
+		}
+	}
+	return findDupes(metas)
+}
+
+// Lines of text for the console.
+func (g DupeGroup) Lines() []string {
+	kind := "look the same"
+	if g.Exact {
+		kind = "identical"
+	}
+	lines := []string{fmt.Sprintf("Group %d, %d files %s:", g.Number, len(g.Files), kind)}
+	for _, f := range g.Files {
+		lines = append(lines, fmt.Sprintf("  %10d  %s", f.Size, f.Path))
+	}
+	return lines
+}
+
+// markDupes numbers the entries that have duplicates.
+func markDupes(all [][]Entry, summary *Summary) {
+	metas := make([]*FileMeta, 0)
+	for _, entries := range all {
+		for _, e := range entries {
+			if e.Meta != nil && !e.Meta.Modified.IsZero() && e.Meta.Category != FolderExt {
+				metas = append(metas, e.Meta)
+			}
+		}
+	}
+	summary.Dupes = findDupes(metas)
+	group := make(map[*FileMeta]int)
+	for _, g := range summary.Dupes {
+		for _, f := range g.Files {
+			group[f] = g.Number
+		}
+	}
+	for _, entries := range all {
+		for i := range entries {
+			entries[i].Dupe = group[entries[i].Meta]
+		}
+	}
+}
+
+// dupeColors are the borders of the groups, in turn.
+var dupeColors = []color.RGBA{
+	{R: 0xE0, G: 0x20, B: 0x20, A: 0xFF},
+	{R: 0x20, G: 0x60, B: 0xE0, A: 0xFF},
+	{R: 0x20, G: 0xA0, B: 0x40, A: 0xFF},
+	{R: 0xE0, G: 0x80, B: 0x00, A: 0xFF},
+	{R: 0x90, G: 0x30, B: 0xC0, A: 0xFF},
+	{R: 0x00, G: 0xA0, B: 0xA0, A: 0xFF},
+}
+
+func dupeColor(group int) color.RGBA {
+	return dupeColors[(group-1)%len(dupeColors)]
+}
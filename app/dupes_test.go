@@ -0,0 +1,136 @@
+package app
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"math/bits"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// testPicture is a picture with some shapes, w x h.
+func testPicture(w, h int, shift uint8) image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			fx, fy := x*64/w, y*64/h
+			c := uint8(fx*3+fy) + shift
+			if (fx-20)*(fx-20)+(fy-30)*(fy-30) < 150 {
+				c = 250 - shift
+			}
+			img.Set(x, y, color.RGBA{R: c, G: c / 2, B: 255 - c, A: 0xFF})
+		}
+	}
+	return img
+}
+
+func TestImageHashes(t *testing.T) {
+	big, small := testPicture(400, 300, 0), testPicture(100, 75, 0)
+	brighter := testPicture(400, 300, 6)
+	other := image.NewRGBA(image.Rect(0, 0, 400, 300))
+	for y := 0; y < 300; y++ {
+		for x := 0; x < 400; x++ {
+			c := uint8(255 - y*255/300)
+			if x%100 < 50 {
+				c = uint8(x)
+			}
+			other.Set(x, y, color.RGBA{R: c, G: c, B: c, A: 0xFF})
+		}
+	}
+	distance := func(a, b uint64) int {
+		return bits.OnesCount64(a ^ b)
+	}
+	for _, near := range []image.Image{small, brighter} {
+		if d := distance(dHash(big), dHash(near)); d > nearBits {
+			t.Errorf("dHash of the same picture differ by %d bits", d)
+		}
+		if d := distance(pHash(big), pHash(near)); d > nearPBits {
+			t.Errorf("pHash of the same picture differ by %d bits", d)
+		}
+	}
+	if d := distance(dHash(big), dHash(other)); d <= nearBits {
+		t.Errorf("dHash of different pictures differ by only %d bits", d)
+	}
+	if d := distance(pHash(big), pHash(other)); d <= nearPBits {
+		t.Errorf("pHash of different pictures differ by only %d bits", d)
+	}
+}
+
+// TestNearBands finds the same pairs as comparing all of them.
+func TestNearBands(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	hashes := make([]uint64, 0)
+	for len(hashes) < 300 {
+		h := r.Uint64()
+		hashes = append(hashes, h)
+		// and some near it
+		for n := 1; n <= nearBits+2; n++ {
+			near := h
+			for b := 0; b < n; b++ {
+				near ^= 1 << uint(r.Intn(64))
+			}
+			hashes = append(hashes, near)
+		}
+	}
+	metas := make([]*FileMeta, len(hashes))
+	for i, h := range hashes {
+		metas[i] = &FileMeta{Path: fmt.Sprint(i), DHash: fmt.Sprintf("%016x", h)}
+	}
+	groups := findDupes(metas)
+	group := make(map[*FileMeta]int)
+	for _, g := range groups {
+		for _, f := range g.Files {
+			group[f] = g.Number
+		}
+	}
+	for i := range hashes {
+		for j := i + 1; j < len(hashes); j++ {
+			if bits.OnesCount64(hashes[i]^hashes[j]) <= nearBits && (group[metas[i]] == 0 || group[metas[i]] != group[metas[j]]) {
+				t.Fatalf("hashes %d and %d are near, but not in a group", i, j)
+			}
+		}
+	}
+}
+
+func TestFindDupes(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) *FileMeta {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		return &FileMeta{Path: path, Size: int64(len(content))}
+	}
+	a, copyOfA := write("a.txt", "the same"), write("b.txt", "the same")
+	other := write("c.txt", "not same") // the same size
+	alone := write("d.txt", "a size of its own")
+	empty1, empty2 := write("e.txt", ""), write("f.txt", "")
+	photo, resized := &FileMeta{Path: "p.jpg", Size: 1000}, &FileMeta{Path: "q.jpg", Size: 300}
+	photo.DHash, resized.DHash = "f0f0f0f0f0f0f0f0", "f0f0f0f0f0f0f0f3"
+	photo.PHash, resized.PHash = "00000000000000ff", "00000000000000f0"
+	// a dHash that is near, but not the pHash
+	lookalike := &FileMeta{Path: "r.jpg", Size: 500, DHash: "f0f0f0f0f0f0f0f1", PHash: "ffff0000ffff00ff"}
+	plain := &FileMeta{Path: "s.jpg", Size: 600, DHash: "0000000000000000"}
+	plain2 := &FileMeta{Path: "t.jpg", Size: 700, DHash: "0000000000000000"}
+
+	groups := findDupes([]*FileMeta{a, alone, other, photo, copyOfA, empty1, lookalike, resized, empty2, plain, plain2})
+	if len(groups) != 2 {
+		t.Fatalf("%d groups, want 2: %+v", len(groups), groups)
+	}
+	if g := groups[0]; !g.Exact || g.Number != 1 || len(g.Files) != 2 || g.Files[0] != a || g.Files[1] != copyOfA {
+		t.Errorf("group 1 = %+v, want a.txt and b.txt", g)
+	}
+	if g := groups[1]; g.Exact || g.Number != 2 || len(g.Files) != 2 || g.Files[0] != photo || g.Files[1] != resized {
+		t.Errorf("group 2 = %+v, want p.jpg and q.jpg", g)
+	}
+	// only the files whose sizes are the same are read
+	if alone.SHA256 != "" {
+		t.Errorf("a file with a size of its own was hashed")
+	}
+	if other.SHA256 == "" || other.SHA256 == a.SHA256 {
+		t.Errorf("c.txt SHA256 = %q", other.SHA256)
+	}
+}
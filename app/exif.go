@@ -0,0 +1,210 @@
+package app
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+/*
+
+  File:    exif.go
+  Author:  Bob Shofner
+
+  MIT License - https://opensource.org/license/mit/
+
+  This permission notice shall be included in all copies
+    or substantial portions of the Software.
+
+*/
+/*
+  Description: retrieve the EXIF fields of JPEG and TIFF files.
+*/
+
+// the tags of interest, by IFD
+var exifTags = map[uint16]string{
+	0x010F: "Make",
+	0x0110: "Model",
+	0x0112: "Orientation",
+	0x0131: "Software",
+	0x0132: "DateTime",
+	0x829A: "ExposureTime",
+	0x829D: "FNumber",
+	0x8827: "ISO",
+	0x9003: "DateTimeOriginal",
+	0x920A: "FocalLength",
+	0xA002: "PixelXDimension",
+	0xA003: "PixelYDimension",
+	0xA434: "LensModel",
+}
+var gpsTags = map[uint16]string{
+	0x0001: "GPSLatitudeRef",
+	0x0002: "GPSLatitude",
+	0x0003: "GPSLongitudeRef",
+	0x0004: "GPSLongitude",
+}
+
+// bytes in each value of the types
+var exifSizes = map[uint16]int{1: 1, 2: 1, 3: 2, 4: 4, 5: 8, 7: 1, 9: 4, 10: 8}
+
+const exifIFDPointer = 0x8769
+const gpsIFDPointer = 0x8825
+
+// ExifDate is the layout of EXIF date/times.
+const ExifDate = "2006:01:02 15:04:05"
+
+// ExifDetails reads the EXIF fields of a JPEG or TIFF file.
+func ExifDetails(path string) (map[string]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	r := bufio.NewReader(f)
+	head, err := r.Peek(4)
+	if err != nil {
+		return nil, err
+	}
+	var tiff []byte
+	switch {
+	case head[0] == 0xFF && head[1] == 0xD8:
+		tiff, err = jpegExif(r)
+	case string(head) == "II*\x00" || string(head) == "MM\x00*":
+		tiff, err = io.ReadAll(io.LimitReader(r, 1<<20))
+	default:
+		err = errors.New("not a JPEG or TIFF file")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return parseTiff(tiff)
+}
+
+// jpegExif finds the APP1 Exif segment.
+func jpegExif(r *bufio.Reader) ([]byte, error) {
+	if _, err := r.Discard(2); err != nil { // SOI
+		return nil, err
+	}
+	for {
+		marker := make([]byte, 4)
+		if _, err := io.ReadFull(r, marker); err != nil {
+			return nil, err
+		}
+		if marker[0] != 0xFF {
+			return nil, errors.New("bad JPEG marker")
+		}
+		size := int(binary.BigEndian.Uint16(marker[2:])) - 2
+		if marker[1] == 0xDA || size < 0 { // start of scan, no more metadata
+			return nil, errors.New("no EXIF")
+		}
+		if marker[1] != 0xE1 {
+			if _, err := r.Discard(size); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		segment := make([]byte, size)
+		if _, err := io.ReadFull(r, segment); err != nil {
+			return nil, err
+		}
+		if strings.HasPrefix(string(segment), "Exif\x00\x00") {
+			return segment[6:], nil
+		}
+	}
+}
+
+func parseTiff(tiff []byte) (map[string]string, error) {
+	if len(tiff) < 8 {
+		return nil, errors.New("short EXIF")
+	}
+	var order binary.ByteOrder = binary.LittleEndian
+	if string(tiff[:2]) == "MM" {
+		order = binary.BigEndian
+	}
+	fields := make(map[string]string)
+	ifd0 := order.Uint32(tiff[4:])
+	pointers := readIFD(tiff, order, ifd0, exifTags, fields)
+	if p, ok := pointers[exifIFDPointer]; ok {
+		readIFD(tiff, order, p, exifTags, fields)
+	}
+	if p, ok := pointers[gpsIFDPointer]; ok {
+		readIFD(tiff, order, p, gpsTags, fields)
+	}
+	return fields, nil
+}
+
+// readIFD adds the wanted tags to fields. it returns the IFD pointers.
+func readIFD(tiff []byte, order binary.ByteOrder, offset uint32, tags map[uint16]string,
+	fields map[string]string) map[uint16]uint32 {
+	pointers := make(map[uint16]uint32)
+	if int(offset)+2 > len(tiff) {
+		return pointers
+	}
+	count := int(order.Uint16(tiff[offset:]))
+	for i := 0; i < count; i++ {
+		e := int(offset) + 2 + i*12
+		if e+12 > len(tiff) {
+			break
+		}
+		tag := order.Uint16(tiff[e:])
+		typ := order.Uint16(tiff[e+2:])
+		n := int(order.Uint32(tiff[e+4:]))
+		if tag == exifIFDPointer || tag == gpsIFDPointer {
+			pointers[tag] = order.Uint32(tiff[e+8:])
+			continue
+		}
+		name, ok := tags[tag]
+		if !ok {
+			continue
+		}
+		size := exifSizes[typ] * n
+		if size == 0 || n > 1024 {
+			continue
+		}
+		value := tiff[e+8 : e+12]
+		if size > 4 {
+			o := int(order.Uint32(tiff[e+8:]))
+			if o+size > len(tiff) {
+				continue
+			}
+			value = tiff[o : o+size]
+		}
+		fields[name] = exifValue(order, typ, n, value)
+	}
+	return pointers
+}
+
+func exifValue(order binary.ByteOrder, typ uint16, n int, value []byte) string {
+	switch typ {
+	case 2: // ASCII
+		return strings.TrimSpace(strings.TrimRight(string(value[:n]), "\x00"))
+	case 3: // SHORT
+		return fmt.Sprintf("%d", order.Uint16(value))
+	case 4, 9: // LONG
+		return fmt.Sprintf("%d", order.Uint32(value))
+	case 5, 10: // RATIONAL (GPS has 3)
+		parts := make([]string, 0, n)
+		for i := 0; i < n; i++ {
+			num := order.Uint32(value[i*8:])
+			den := order.Uint32(value[i*8+4:])
+			switch {
+			case den == 0:
+				parts = append(parts, "0")
+			case den == 1:
+				parts = append(parts, fmt.Sprintf("%d", num))
+			case num < den: // as exposure times, 1/250
+				parts = append(parts, fmt.Sprintf("%d/%d", num, den))
+			default:
+				parts = append(parts, fmt.Sprintf("%.2f", float64(num)/float64(den)))
+			}
+		}
+		return strings.Join(parts, " ")
+	}
+	return fmt.Sprintf("%x", value)
+}
@@ -0,0 +1,147 @@
+package app
+
+import (
+	"context"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"snap/fileutil"
+	"strings"
+)
+
+/*
+
+  File:    files.go
+  Author:  Bob Shofner
+
+  MIT License - https://opensource.org/license/mit/
+
+  This permission notice shall be included in all copies
+    or substantial portions of the Software.
+
+*/
+/*
+  Description: The files of a directory, with their thumbnails,
+    shared by all the kinds of output.
+*/
+
+// Entry is one file (or directory) to be shown.
+type Entry struct {
+	Name  string // base name
+	Path  string
+	Thumb Thumbnail
+	Meta  *FileMeta `json:"-"` // kept once, in the Build's Metadata
+	Group string    // the sub-header it is under, if grouped
+	Dupe  int       `json:"-"` // the DupeGroup it is in, found each time
+}
+
+// getAllFiles gets the names of the directories and regular files
+// allowed by the filter.
+func getAllFiles(path string, filter Filter) ([]string, error) {
+	files := make([]string, 0)
+	entries, err := os.ReadDir(path)
+	for _, entry := range entries {
+		if !entry.IsDir() && entry.Type() != 0 {
+			continue
+		}
+		info, e := entry.Info()
+		if e != nil || !filter.Allow(filepath.Join(path, entry.Name()), info) {
+			continue
+		}
+		files = append(files, entry.Name())
+	}
+	return files, err
+}
+
+// SubDirs are dir and all of the directories under it, but the hidden ones.
+func SubDirs(dir string, filter Filter) ([]string, error) {
+	dirs := make([]string, 0)
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() {
+			return nil
+		}
+		if path != dir && fileutil.IsHidden(filter.Hidden, path) {
+			return filepath.SkipDir
+		}
+		dirs = append(dirs, path)
+		return nil
+	})
+	return dirs, err
+}
+
+// ExpandPath has the environment variables and ~ expanded, relative to
+// dir (if not "").
+func ExpandPath(path, dir string) string {
+	path = os.ExpandEnv(strings.TrimSpace(path))
+	if path == "~" || strings.HasPrefix(path, "~/") || strings.HasPrefix(path, `~\`) {
+		path = filepath.Join(UserHomeDir(), path[1:])
+	}
+	if path != "" && dir != "" && !filepath.IsAbs(path) {
+		path = filepath.Join(dir, path)
+	}
+	return path
+}
+
+// AllDirs are the paths, and if recursive, the directories under them.
+func AllDirs(paths []string, recursive bool, filter Filter) []string {
+	if !recursive {
+		return paths
+	}
+	dirs := make([]string, 0, len(paths))
+	for _, p := range paths {
+		sub, err := SubDirs(p, filter)
+		if err != nil {
+			dirs = append(dirs, p) // its issue is in the Summary
+			continue
+		}
+		dirs = append(dirs, sub...)
+	}
+	return dirs
+}
+
+// prepareDir gets the sorted (and grouped) Entries of a directory.
+// Files without a thumbnail are left out, and noted in the Summary.
+// This is the one pass over the files, the metadata is collected here too.
+func prepareDir(ctx context.Context, dir string, options Options, summary *Summary) []Entry {
+	names, err := getAllFiles(dir, options.Filter)
+	if err != nil {
+		summary.AddIssue(dir, IssueRead, err)
+	}
+	summary.Directories++
+	entries := make([]Entry, 0, len(names))
+	for _, s := range names {
+		file := filepath.Join(dir, s)
+		summary.Files++
+		content := SniffContent(file)
+		if contentMismatch(file, content) {
+			summary.Mismatches = append(summary.Mismatches, Mismatch{Path: file, Content: content.Name})
+		}
+		meta := collectMeta(file, content, options.Manifest != "")
+		summary.Metadata = append(summary.Metadata, meta)
+		// get the image from the first willing provider
+		thumb, err := ThumbnailFor(ctx, file, content, 80)
+		if err != nil {
+			log.Println("Got ThumbnailFor error ", s, err)
+			summary.AddIssue(file, IssueThumbnail, err)
+			continue
+		}
+		if !options.Captions {
+			thumb.Caption = nil
+		}
+		if options.Dupes && content.Image != "" {
+			meta.DHash, meta.PHash, _ = imageHashes(thumb.Path)
+		}
+		entries = append(entries, Entry{Name: s, Path: file, Thumb: thumb, Meta: meta})
+	}
+	sortEntries(entries, options.Sort, options.Descending, options.Group)
+	if options.Group != "" {
+		for i := range entries {
+			entries[i].Group = groupOf(entries[i], options.Group)
+		}
+	}
+	return entries
+}
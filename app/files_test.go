@@ -0,0 +1,30 @@
+package app
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestExpandPath(t *testing.T) {
+	t.Setenv("SNAP_TEST_DIR", "/data")
+	home := UserHomeDir()
+	tests := []struct {
+		path string
+		dir  string
+		want string
+	}{
+		{"", "/jobs", ""},
+		{"  ~  ", "", home},
+		{"~/scans", "/jobs", filepath.Join(home, "scans")},
+		{"$SNAP_TEST_DIR/scans", "", filepath.Join("/data", "scans")},
+		{"scans", "/jobs", filepath.Join("/jobs", "scans")},
+		{"scans", "", "scans"},
+		{"/abs/scans", "/jobs", "/abs/scans"},
+		{"~user/scans", "/jobs", filepath.Join("/jobs", "~user/scans")}, // only the user's own ~
+	}
+	for _, tt := range tests {
+		if got := ExpandPath(tt.path, tt.dir); got != tt.want {
+			t.Errorf("ExpandPath(%q, %q) = %q, want %q", tt.path, tt.dir, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,310 @@
+package app
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"regexp"
+	"snap/fileutil"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+/*
+
+  File:    filter.go
+  Author:  Bob Shofner
+
+  MIT License - https://opensource.org/license/mit/
+
+  This permission notice shall be included in all copies
+    or substantial portions of the Software.
+
+*/
+/*
+  Description: Which files of a directory are shown.
+
+  Name globs, regular expressions, sizes, dates and types apply to files.
+  Directories are shown unless hidden, or SkipFolders is set.
+*/
+
+// FilterDate is the layout of the After and Before dates.
+const FilterDate = "2006-01-02"
+
+type Filter struct {
+	Globs       []string `json:"globs,omitempty"`   // "*.jpg" to include, "!*_thumb*" to exclude
+	Match       string   `json:"match,omitempty"`   // regexp the names must match
+	Hidden      string   `json:"hidden"`            // regexp of names never shown
+	MinSize     int64    `json:"minSize,omitempty"` // bytes
+	MaxSize     int64    `json:"maxSize,omitempty"`
+	After       string   `json:"after,omitempty"` // modified on or after FilterDate
+	Before      string   `json:"before,omitempty"`
+	Types       []string `json:"types,omitempty"` // categories shown, "images" for all of them
+	SkipFolders bool     `json:"skipFolders,omitempty"`
+}
+
+// the categories with pictures
+var imageTypes = []string{BitmapExt, CameraExt, AppleExt}
+
+// typeNames are the types a Filter can have, in lower case: "images",
+// the categories with an icon, and those of types.json.
+func typeNames() []string {
+	names := []string{"images"}
+	add := func(category string) {
+		if category = strings.ToLower(category); !contains(names, category) {
+			names = append(names, category)
+		}
+	}
+	for category := range imageResourceMap {
+		add(category)
+	}
+	for _, category := range GetFileTypes().Extensions {
+		add(category)
+	}
+	sort.Strings(names[1:])
+	return names
+}
+
+func DefaultFilter() Filter {
+	return Filter{Hidden: fileutil.DefaultHiddenFiles}
+}
+
+// Allow is true if the file (or directory) is to be shown.
+func (f Filter) Allow(path string, info fs.FileInfo) bool {
+	if fileutil.IsHidden(f.Hidden, path) {
+		return false
+	}
+	if info.IsDir() {
+		return !f.SkipFolders
+	}
+	name := filepath.Base(path)
+	if !f.globsAllow(name) {
+		return false
+	}
+	if f.Match != "" {
+		if match, err := regexp.MatchString(f.Match, name); !match || err != nil {
+			return false
+		}
+	}
+	if f.MinSize > 0 && info.Size() < f.MinSize || f.MaxSize > 0 && info.Size() > f.MaxSize {
+		return false
+	}
+	if t, err := time.ParseInLocation(FilterDate, f.After, time.Local); err == nil && info.ModTime().Before(t) {
+		return false
+	}
+	// before the start of the day
+	if t, err := time.ParseInLocation(FilterDate, f.Before, time.Local); err == nil && !info.ModTime().Before(t) {
+		return false
+	}
+	if len(f.Types) > 0 {
+		category := strings.ToLower(categoryOf(path, info))
+		for _, t := range f.types() {
+			if strings.ToLower(t) == category {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// globsAllow: excluded by any "!" glob, and if there are
+// any others, included by one of them.
+func (f Filter) globsAllow(name string) bool {
+	name = strings.ToLower(name)
+	included, includes := false, false
+	for _, g := range f.Globs {
+		exclude := strings.HasPrefix(g, "!")
+		g = strings.ToLower(strings.TrimPrefix(g, "!"))
+		match, _ := filepath.Match(g, name)
+		if exclude && match {
+			return false
+		}
+		if !exclude {
+			includes = true
+			included = included || match
+		}
+	}
+	return included || !includes
+}
+
+// types has "images" expanded.
+func (f Filter) types() []string {
+	types := make([]string, 0, len(f.Types))
+	for _, t := range f.Types {
+		if strings.ToLower(t) == "images" {
+			types = append(types, imageTypes...)
+			continue
+		}
+		types = append(types, t)
+	}
+	return types
+}
+
+// Set changes one setting of the Filter, as typed after "filter":
+//
+//	include *.jpg !*_thumb*   (globs, replacing the old ones)
+//	match <regexp>            hidden <regexp>
+//	size <min> [max]          (as 100, 10k, 5m or 1g, 0 for no limit)
+//	after <yyyy-mm-dd>        before <yyyy-mm-dd>
+//	type images audio ...     folders on|off
+//	clear
+func (f *Filter) Set(args []string) error {
+	if len(args) == 0 {
+		return nil
+	}
+	values := args[1:]
+	value := strings.Join(values, " ")
+	switch strings.ToLower(args[0]) {
+	case "include", "glob", "globs":
+		for _, g := range values {
+			if _, err := filepath.Match(strings.TrimPrefix(g, "!"), ""); err != nil {
+				return errors.New(fmt.Sprintf("bad glob %q", g))
+			}
+		}
+		f.Globs = values
+	case "match":
+		if _, err := regexp.Compile(value); err != nil {
+			return err
+		}
+		f.Match = value
+	case "hidden":
+		if _, err := regexp.Compile(value); err != nil {
+			return err
+		}
+		f.Hidden = value
+	case "size":
+		sizes := make([]int64, 2)
+		for i := 0; i < len(values) && i < 2; i++ {
+			n, err := parseSize(values[i])
+			if err != nil {
+				return err
+			}
+			sizes[i] = n
+		}
+		f.MinSize, f.MaxSize = sizes[0], sizes[1]
+	case "after", "before":
+		if value != "" {
+			if _, err := time.Parse(FilterDate, value); err != nil {
+				return errors.New(fmt.Sprintf("date %q is not yyyy-mm-dd", value))
+			}
+		}
+		if strings.ToLower(args[0]) == "after" {
+			f.After = value
+		} else {
+			f.Before = value
+		}
+	case "type", "types":
+		names := typeNames()
+		for _, t := range values {
+			if !contains(names, strings.ToLower(t)) {
+				return errors.New(fmt.Sprintf("type %q is not one of %s", t, strings.Join(names, ", ")))
+			}
+		}
+		f.Types = values
+	case "folders":
+		f.SkipFolders = strings.ToLower(value) == "off"
+	case "clear":
+		*f = DefaultFilter()
+	default:
+		return errors.New(fmt.Sprintf("unknown filter %q", args[0]))
+	}
+	return nil
+}
+
+// Check is an error for a setting (read from a file) that Set wouldn't take.
+func (f Filter) Check() error {
+	c := DefaultFilter()
+	for _, args := range [][]string{
+		append([]string{"include"}, f.Globs...),
+		{"match", f.Match},
+		{"hidden", f.Hidden},
+		{"after", f.After},
+		{"before", f.Before},
+		append([]string{"types"}, f.Types...),
+	} {
+		if err := c.Set(args); err != nil {
+			return err
+		}
+	}
+	if f.MaxSize > 0 && f.MinSize > f.MaxSize {
+		return errors.New(fmt.Sprintf("size %s is more than %s", sizeText(f.MinSize), sizeText(f.MaxSize)))
+	}
+	return nil
+}
+
+// Lines describes the Filter.
+func (f Filter) Lines() []string {
+	lines := []string{fmt.Sprintf("  hidden: %s", f.Hidden)}
+	if len(f.Globs) > 0 {
+		lines = append(lines, fmt.Sprintf("  include: %s", strings.Join(f.Globs, " ")))
+	}
+	if f.Match != "" {
+		lines = append(lines, fmt.Sprintf("  match: %s", f.Match))
+	}
+	if f.MinSize > 0 || f.MaxSize > 0 {
+		lines = append(lines, fmt.Sprintf("  size: %s to %s", sizeText(f.MinSize), sizeText(f.MaxSize)))
+	}
+	if f.After != "" {
+		lines = append(lines, fmt.Sprintf("  after: %s", f.After))
+	}
+	if f.Before != "" {
+		lines = append(lines, fmt.Sprintf("  before: %s", f.Before))
+	}
+	if len(f.Types) > 0 {
+		lines = append(lines, fmt.Sprintf("  types: %s", strings.Join(f.Types, " ")))
+	}
+	if f.SkipFolders {
+		lines = append(lines, "  folders: off")
+	}
+	return lines
+}
+
+func (f Filter) String() string {
+	b, _ := json.Marshal(f)
+	return string(b)
+}
+
+// parseFilter reads a Filter saved by String.
+func parseFilter(s string) Filter {
+	f := DefaultFilter()
+	if s != "" {
+		_ = json.Unmarshal([]byte(s), &f)
+	}
+	return f
+}
+
+var sizeUnits = map[string]int64{"": 1, "b": 1, "k": 1 << 10, "m": 1 << 20, "g": 1 << 30}
+
+// parseSize reads 100, 10k, 5m or 1g (or kb, mb, gb).
+func parseSize(s string) (int64, error) {
+	s = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(s)), "b")
+	i := strings.IndexFunc(s, func(r rune) bool { return r < '0' || r > '9' })
+	if i < 0 {
+		i = len(s)
+	}
+	n, err := strconv.ParseInt(s[:i], 10, 64)
+	unit, ok := sizeUnits[s[i:]]
+	if err != nil || !ok {
+		return 0, errors.New(fmt.Sprintf("size %q is not like 100, 10k, 5m or 1g", s))
+	}
+	return n * unit, nil
+}
+
+func sizeText(n int64) string {
+	switch {
+	case n == 0:
+		return "any"
+	case n%(1<<30) == 0:
+		return fmt.Sprintf("%dg", n>>30)
+	case n%(1<<20) == 0:
+		return fmt.Sprintf("%dm", n>>20)
+	case n%(1<<10) == 0:
+		return fmt.Sprintf("%dk", n>>10)
+	}
+	return fmt.Sprintf("%d", n)
+}
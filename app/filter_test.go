@@ -0,0 +1,156 @@
+package app
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestFilterSet(t *testing.T) {
+	defaultTypes()
+	tests := []struct {
+		args  []string
+		check func(f Filter) bool
+		err   bool
+	}{
+		{[]string{"include", "*.jpg", "!*_thumb*"}, func(f Filter) bool { return len(f.Globs) == 2 && f.Globs[1] == "!*_thumb*" }, false},
+		{[]string{"include"}, func(f Filter) bool { return len(f.Globs) == 0 }, false},
+		{[]string{"include", "[a-"}, nil, true},
+		{[]string{"match", "^IMG_", "\\d+"}, func(f Filter) bool { return f.Match == "^IMG_ \\d+" }, false},
+		{[]string{"match", "("}, nil, true},
+		{[]string{"hidden", "^\\."}, func(f Filter) bool { return f.Hidden == "^\\." }, false},
+		{[]string{"hidden", "[z-a]"}, nil, true},
+		{[]string{"size", "10k"}, func(f Filter) bool { return f.MinSize == 10<<10 && f.MaxSize == 0 }, false},
+		{[]string{"SIZE", "100", "5MB"}, func(f Filter) bool { return f.MinSize == 100 && f.MaxSize == 5<<20 }, false},
+		{[]string{"size"}, func(f Filter) bool { return f.MinSize == 0 && f.MaxSize == 0 }, false},
+		{[]string{"size", "10x"}, nil, true},
+		{[]string{"size", "k"}, nil, true},
+		{[]string{"after", "2024-01-31"}, func(f Filter) bool { return f.After == "2024-01-31" }, false},
+		{[]string{"before", "2024-02-01"}, func(f Filter) bool { return f.Before == "2024-02-01" }, false},
+		{[]string{"after"}, func(f Filter) bool { return f.After == "" }, false},
+		{[]string{"after", "31/01/2024"}, nil, true},
+		{[]string{"type", "images", "audio"}, func(f Filter) bool { return len(f.types()) == len(imageTypes)+1 }, false},
+		{[]string{"types", "Camera", "sheet"}, func(f Filter) bool { return len(f.Types) == 2 }, false},
+		{[]string{"type", "image"}, nil, true},
+		{[]string{"type", "audio", "imgs"}, nil, true},
+		{[]string{"folders", "off"}, func(f Filter) bool { return f.SkipFolders }, false},
+		{[]string{"folders", "on"}, func(f Filter) bool { return !f.SkipFolders }, false},
+		{[]string{"clear"}, func(f Filter) bool { return len(f.Globs) == 0 && f.MinSize == 0 && f.Hidden == DefaultFilter().Hidden }, false},
+		{[]string{"colour", "red"}, nil, true},
+		{nil, func(f Filter) bool { return true }, false},
+	}
+	for _, tt := range tests {
+		f := DefaultFilter()
+		f.Globs, f.MinSize = []string{"*.png"}, 5 // for clear
+		before := f.String()
+		err := f.Set(tt.args)
+		if (err != nil) != tt.err {
+			t.Errorf("Set(%q) error = %v, want an error %v", tt.args, err, tt.err)
+			continue
+		}
+		if err != nil {
+			if f.String() != before {
+				t.Errorf("Set(%q) failed, but changed the Filter to %s", tt.args, f)
+			}
+			continue
+		}
+		if !tt.check(f) {
+			t.Errorf("Set(%q) = %s", tt.args, f)
+		}
+	}
+}
+
+func TestFilterCheck(t *testing.T) {
+	defaultTypes()
+	tests := []struct {
+		name string
+		f    Filter
+		err  bool
+	}{
+		{"default", DefaultFilter(), false},
+		{"all set", Filter{Globs: []string{"*.jpg", "!x*"}, Match: "^a", Hidden: "^\\.", MinSize: 1, MaxSize: 2,
+			After: "2024-01-01", Before: "2024-12-31", Types: []string{"images"}, SkipFolders: true}, false},
+		{"bad glob", Filter{Globs: []string{"!["}}, true},
+		{"bad match", Filter{Match: "a("}, true},
+		{"bad hidden", Filter{Hidden: "*"}, true},
+		{"bad after", Filter{After: "2024-13-01"}, true},
+		{"bad before", Filter{Before: "tomorrow"}, true},
+		{"bad type", Filter{Types: []string{"imgs"}}, true},
+		{"sizes reversed", Filter{MinSize: 2 << 20, MaxSize: 1 << 20}, true},
+		{"no max size", Filter{MinSize: 2 << 20}, false},
+	}
+	for _, tt := range tests {
+		if err := tt.f.Check(); (err != nil) != tt.err {
+			t.Errorf("%s: Check() = %v, want an error %v", tt.name, err, tt.err)
+		}
+	}
+}
+
+func TestFilterAllow(t *testing.T) {
+	defaultTypes()
+	dir := t.TempDir()
+	day := time.Date(2024, 3, 10, 12, 0, 0, 0, time.Local)
+	write := func(name string, size int, modified time.Time) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, make([]byte, size), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chtimes(path, modified, modified); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+	photo := write("IMG_1.JPG", 100, day)
+	thumb := write("IMG_1_thumb.jpg", 10, day)
+	song := write("song.mp3", 2000, day.AddDate(0, 0, 1))
+	hidden := write(".secret.jpg", 100, day)
+	sub := filepath.Join(dir, "sub")
+	if err := os.Mkdir(sub, 0755); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name string
+		set  [][]string
+		want map[string]bool
+	}{
+		{"default", nil, map[string]bool{photo: true, thumb: true, song: true, hidden: false, sub: true}},
+		{"globs", [][]string{{"include", "*.jpg", "!*_thumb*"}},
+			map[string]bool{photo: true, thumb: false, song: false, sub: true}},
+		{"exclude only", [][]string{{"include", "!*.mp3"}}, map[string]bool{photo: true, song: false}},
+		{"match", [][]string{{"match", "^IMG_\\d+\\."}}, map[string]bool{photo: true, thumb: false, song: false}},
+		{"size", [][]string{{"size", "50", "1k"}}, map[string]bool{photo: true, thumb: false, song: false}},
+		{"after", [][]string{{"after", "2024-03-11"}}, map[string]bool{photo: false, song: true}},
+		{"before", [][]string{{"before", "2024-03-11"}}, map[string]bool{photo: true, song: false}},
+		{"types", [][]string{{"type", "images"}}, map[string]bool{photo: true, song: false, sub: true}},
+		{"no folders", [][]string{{"folders", "off"}}, map[string]bool{photo: true, sub: false}},
+	}
+	for _, tt := range tests {
+		f := DefaultFilter()
+		for _, args := range tt.set {
+			if err := f.Set(args); err != nil {
+				t.Fatal(err)
+			}
+		}
+		for path, want := range tt.want {
+			info, err := os.Stat(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := f.Allow(path, info); got != want {
+				t.Errorf("%s: Allow(%s) = %v, want %v", tt.name, filepath.Base(path), got, want)
+			}
+		}
+	}
+}
+
+func TestParseFilter(t *testing.T) {
+	f := DefaultFilter()
+	f.Globs, f.MaxSize, f.Types = []string{"*.png"}, 1<<20, []string{"audio"}
+	if got := parseFilter(f.String()); got.String() != f.String() {
+		t.Errorf("parseFilter(%s) = %s", f, got)
+	}
+	if got := parseFilter(""); got.Hidden != DefaultFilter().Hidden {
+		t.Errorf("parseFilter of nothing = %s, want the default", got)
+	}
+}
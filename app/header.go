@@ -0,0 +1,79 @@
+package app
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+/*
+
+  File:    header.go
+  Author:  Bob Shofner
+
+  MIT License - https://opensource.org/license/mit/
+
+  This permission notice shall be included in all copies
+    or substantial portions of the Software.
+
+*/
+/*
+  Description: The page headers and footers, from templates.
+
+  In the templates:
+    {page}    the page number     {pages}  the number of pages
+    {date}    when generated      {host}   the computer's name
+    {title}   the document title  {section} the directory
+*/
+
+const DefaultHeader = "{section}"
+const DefaultFooter = "{title} - {date} - page {page} of {pages}"
+
+const footerSize = 7.0
+
+// StampDate is the layout of {date}.
+const StampDate = "2006-01-02 15:04"
+
+// documentTitle is the chosen title, else the name of the output file.
+func documentTitle(file string, options Options) string {
+	if options.Title != "" {
+		return options.Title
+	}
+	return strings.TrimSuffix(filepath.Base(file), filepath.Ext(file))
+}
+
+// decorate sets the header and footer of every page, but the cover.
+func (d *Document) decorate(options Options, now time.Time) {
+	host, _ := os.Hostname()
+	pages := d.PageCount()
+	replacer := func(page int, section string) *strings.Replacer {
+		return strings.NewReplacer(
+			"{page}", strconv.Itoa(page),
+			"{pages}", strconv.Itoa(pages),
+			"{date}", now.Format(StampDate),
+			"{host}", host,
+			"{title}", d.Title,
+			"{section}", section,
+		)
+	}
+	set := func(page *Page, section string) {
+		r := replacer(page.Number, section)
+		page.Header.Text = r.Replace(options.Header)
+		if options.Footer != "" {
+			page.Footer = TextLine{Text: r.Replace(options.Footer), Y: pageHeight - pageMargin - 4, Size: footerSize, Center: true}
+		}
+	}
+	for i := range d.Sections {
+		for j := range d.Sections[i].Pages {
+			set(&d.Sections[i].Pages[j], d.Sections[i].Title)
+		}
+	}
+	for i := range d.Summary {
+		set(&d.Summary[i], "Summary")
+	}
+	for i := range d.Issues {
+		set(&d.Issues[i], "Issues")
+	}
+}
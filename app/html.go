@@ -0,0 +1,277 @@
+package app
+
+import (
+	"fmt"
+	"html/template"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+/*
+
+  File:    html.go
+  Author:  Bob Shofner
+
+  MIT License - https://opensource.org/license/mit/
+
+  This permission notice shall be included in all copies
+    or substantial portions of the Software.
+
+*/
+/*
+  Description: Create a static HTML gallery from list of paths.
+
+  file is the index page. the page for each directory, and the
+  thumbnails, are in the "<name>_files" folder next to it. Those of the
+  last time are removed first, so it only has the files shown now.
+*/
+
+const htmlThumbSize = 240
+
+// htmlAsset is the name of a file Render writes in the folder.
+var htmlAsset = regexp.MustCompile(`^(\d{3,}-\d{4,}\.(jpg|png)|dir-\d{3,}\.html)$`)
+
+type htmlCell struct {
+	ID       string
+	Name     string
+	Caption  []string
+	Thumb    template.URL
+	Large    template.URL // lightbox image
+	Original template.URL
+	Group    string // the sub-header before it
+	Dupe     int    // DupeGroup, and its color
+	Color    string
+	file     string // the saved thumbnail
+}
+
+type htmlPage struct {
+	Title string
+	Stats string
+	Index template.URL
+	Cells []htmlCell
+}
+
+type htmlSection struct {
+	Title string
+	Stats string
+	Page  template.URL
+	Cover template.URL
+	Count int
+}
+
+type htmlIndex struct {
+	Title    string
+	Totals   string
+	Sections []htmlSection
+}
+
+type htmlRenderer struct{}
+
+// Render writes the gallery, a page for each Section.
+func (htmlRenderer) Render(doc *Document, file string, options Options, summary *Summary) error {
+	base := strings.TrimSuffix(filepath.Base(file), filepath.Ext(file))
+	assets := filepath.Join(filepath.Dir(file), base+"_files")
+	if err := os.MkdirAll(assets, 0755); err != nil {
+		summary.AddIssue(assets, IssueWrite, err)
+		return err
+	}
+	if err := clearAssets(assets); err != nil {
+		summary.AddIssue(assets, IssueWrite, err)
+		return err
+	}
+	index := htmlIndex{Title: doc.Title, Sections: make([]htmlSection, 0)}
+	if len(doc.Summary) > 0 {
+		index.Totals = doc.Totals().String()
+	}
+	for i, s := range doc.Sections {
+		cells := s.Cells()
+		page := htmlPage{
+			Title: s.Title,
+			Stats: statsText(s.Stats),
+			Index: relativeURL(assets, file),
+			Cells: make([]htmlCell, 0, len(cells)),
+		}
+		group := ""
+		for j, c := range cells {
+			cell, err := htmlThumbnail(assets, fmt.Sprintf("%03d-%04d", i+1, j+1), c.Entry)
+			if err != nil {
+				summary.AddIssue(c.Entry.Path, IssueImage, err)
+				continue
+			}
+			if c.Mark != nil {
+				cell.Dupe = c.Entry.Dupe
+				cell.Color = fmt.Sprintf("#%02x%02x%02x", c.Mark.Color.R, c.Mark.Color.G, c.Mark.Color.B)
+			}
+			if c.Entry.Group != group {
+				group = c.Entry.Group
+				cell.Group = group
+			}
+			page.Cells = append(page.Cells, cell)
+			if c.Entry.Meta != nil { // the page of the directory, and place in its grid
+				c.Entry.Meta.Page, c.Entry.Meta.Row, c.Entry.Meta.Col = i+1, 1, len(page.Cells)
+			}
+		}
+		name := fmt.Sprintf("dir-%03d.html", i+1)
+		if err := writeTemplate(htmlPageTemplate, filepath.Join(assets, name), page); err != nil {
+			summary.AddIssue(s.Title, IssueWrite, err)
+			continue
+		}
+		section := htmlSection{
+			Title: s.Title,
+			Stats: statsText(s.Stats),
+			Page:  relativeURL(filepath.Dir(file), filepath.Join(assets, name)),
+			Count: len(page.Cells),
+		}
+		if len(page.Cells) > 0 {
+			section.Cover = relativeURL(filepath.Dir(file), page.Cells[0].file)
+		}
+		index.Sections = append(index.Sections, section)
+	}
+	err := writeTemplate(htmlIndexTemplate, file, index)
+	if err != nil {
+		summary.AddIssue(file, IssueWrite, err)
+		return err
+	}
+	summary.Written = append(summary.Written, file)
+	return nil
+}
+
+// clearAssets removes the pages and thumbnails of the last time, any
+// other file in the folder is left.
+func clearAssets(assets string) error {
+	entries, err := os.ReadDir(assets)
+	if err != nil {
+		return err
+	}
+	for _, e := range entries {
+		if e.Type().IsRegular() && htmlAsset.MatchString(e.Name()) {
+			if err = os.Remove(filepath.Join(assets, e.Name())); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func statsText(stats *DirStats) string {
+	if stats == nil {
+		return ""
+	}
+	return stats.String()
+}
+
+// htmlThumbnail saves a small copy of the thumbnail with the pages.
+func htmlThumbnail(assets, id string, entry Entry) (htmlCell, error) {
+	img, err := loadImage(entry.Thumb.Path)
+	if err != nil {
+		return htmlCell{}, err
+	}
+	ext := ".jpg"
+	if entry.Thumb.Type != "jpg" {
+		ext = ".png"
+	}
+	thumb := filepath.Join(assets, id+ext)
+	if err = saveImage(scaleImage(img, htmlThumbSize), thumb, ext == ".png"); err != nil {
+		return htmlCell{}, err
+	}
+	cell := htmlCell{
+		ID:       "f" + id,
+		Name:     entry.Name,
+		Caption:  entry.Thumb.Caption,
+		Thumb:    relativeURL(assets, thumb),
+		Original: relativeURL(assets, entry.Path),
+		file:     thumb,
+	}
+	cell.Large = cell.Thumb
+	if entry.Thumb.Path == entry.Path { // the file is the image
+		cell.Large = cell.Original
+	}
+	return cell, nil
+}
+
+// relativeURL is the link from a page in dir to path.
+func relativeURL(dir, path string) template.URL {
+	rel, err := filepath.Rel(dir, path)
+	if err != nil { // a different drive
+		return template.URL(fileURL(path))
+	}
+	parts := strings.Split(filepath.ToSlash(rel), "/")
+	for i, p := range parts {
+		parts[i] = url.PathEscape(p)
+	}
+	return template.URL(strings.Join(parts, "/"))
+}
+
+func writeTemplate(t *template.Template, path string, data interface{}) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	err = t.Execute(f, data)
+	if e := f.Close(); err == nil {
+		err = e
+	}
+	return err
+}
+
+const htmlStyle = `<style>
+body { font-family: sans-serif; margin: 0; background: #f4f4f4; color: #222; }
+header { padding: 12px 16px; background: #333; color: #fff; }
+header a { color: #ccc; }
+h1 { font-size: 1.1em; margin: 4px 0; word-break: break-all; }
+h2 { grid-column: 1 / -1; font-size: 1em; margin: 8px 0 0; border-bottom: 1px solid #ccc; }
+.grid { display: grid; grid-template-columns: repeat(auto-fill, minmax(160px, 1fr)); gap: 12px; padding: 16px; }
+figure { margin: 0; background: #fff; padding: 8px; text-align: center; box-shadow: 0 1px 3px rgba(0,0,0,.2); }
+.grid img { max-width: 100%; height: 140px; object-fit: contain; }
+figcaption { font-size: .8em; word-break: break-all; }
+.dupe { position: relative; border: 3px solid; }
+.badge { position: absolute; top: 0; right: 0; color: #fff; font-size: .7em; font-weight: bold; padding: 1px 4px; }
+.lightbox { display: none; position: fixed; inset: 0; background: rgba(0,0,0,.85); z-index: 10;
+  align-items: center; justify-content: center; }
+.lightbox:target { display: flex; }
+.lightbox .close { position: absolute; inset: 0; }
+.lightbox figure { position: relative; background: none; box-shadow: none; }
+.lightbox img { max-width: 95vw; max-height: 85vh; }
+.lightbox figcaption a { color: #fff; }
+</style>`
+
+const htmlHead = `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>{{.Title}}</title>
+` + htmlStyle + `
+</head>
+`
+
+var htmlPageTemplate = template.Must(template.New("page").Parse(htmlHead + `<body>
+<header><a href="{{.Index}}">Index</a><h1>{{.Title}}</h1>{{if .Stats}}<small>{{.Stats}}</small>{{end}}</header>
+<main class="grid">
+{{range .Cells}}{{if .Group}}<h2>{{.Group}}</h2>
+{{end}}<figure{{if .Dupe}} class="dupe" style="border-color: {{.Color}}"{{end}}>
+{{if .Dupe}}<span class="badge" style="background: {{.Color}}">D{{.Dupe}}</span>{{end}}<a href="#{{.ID}}"><img src="{{.Thumb}}" alt="{{.Name}}" loading="lazy"></a>
+<figcaption><a href="{{.Original}}">{{.Name}}</a>{{range .Caption}}<br><small>{{.}}</small>{{end}}</figcaption>
+</figure>
+{{end}}</main>
+{{range .Cells}}<div class="lightbox" id="{{.ID}}"><a class="close" href="#"></a>
+<figure><img src="{{.Large}}" alt="{{.Name}}" loading="lazy"><figcaption><a href="{{.Original}}">{{.Name}}</a></figcaption></figure>
+</div>
+{{end}}</body>
+</html>
+`))
+
+var htmlIndexTemplate = template.Must(template.New("index").Parse(htmlHead + `<body>
+<header><h1>{{.Title}}</h1>{{if .Totals}}<small>{{.Totals}}</small>{{end}}</header>
+<main class="grid">
+{{range .Sections}}<figure>
+<a href="{{.Page}}">{{if .Cover}}<img src="{{.Cover}}" alt="{{.Title}}">{{end}}</a>
+<figcaption><a href="{{.Page}}">{{.Title}}</a><br><small>{{if .Stats}}{{.Stats}}{{else}}{{.Count}} files{{end}}</small></figcaption>
+</figure>
+{{end}}</main>
+</body>
+</html>
+`))
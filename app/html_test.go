@@ -0,0 +1,94 @@
+package app
+
+import (
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+)
+
+// testPNG writes a small image, for thumbnails.
+func testPNG(t *testing.T, path string) {
+	t.Helper()
+	img := image.NewRGBA(image.Rect(0, 0, 16, 16))
+	for x := 0; x < 16; x++ {
+		img.Set(x, x, color.RGBA{R: 0xFF, A: 0xFF})
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	if err = png.Encode(f, img); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func assetNames(t *testing.T, assets string) []string {
+	t.Helper()
+	entries, err := os.ReadDir(assets)
+	if err != nil {
+		t.Fatal(err)
+	}
+	names := make([]string, 0, len(entries))
+	for _, e := range entries {
+		names = append(names, e.Name())
+	}
+	sort.Strings(names)
+	return names
+}
+
+func TestHTMLRemovesLastAssets(t *testing.T) {
+	dir := t.TempDir()
+	thumb := filepath.Join(dir, "thumb.png")
+	testPNG(t, thumb)
+	render := func(n int) {
+		entries := make([]Entry, 0, n)
+		for i := 0; i < n; i++ {
+			entries = append(entries, Entry{Name: "f.png", Path: thumb, Thumb: Thumbnail{Path: thumb, Type: "png"}})
+		}
+		doc := &Document{Title: "t", Sections: []Section{NewLayout(0, 0).Section(dir, entries, 1)}}
+		if err := (htmlRenderer{}).Render(doc, filepath.Join(dir, "gallery.html"), Options{}, NewSummary()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	assets := filepath.Join(dir, "gallery_files")
+	render(3)
+	if err := os.WriteFile(filepath.Join(assets, "notes.txt"), []byte("mine"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	render(1)
+	got := assetNames(t, assets)
+	want := []string{"001-0001.png", "dir-001.html", "notes.txt"}
+	if len(got) != len(want) {
+		t.Fatalf("assets = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("assets = %v, want %v", got, want)
+		}
+	}
+}
+
+func TestHTMLAsset(t *testing.T) {
+	for name, want := range map[string]bool{
+		"001-0001.jpg":  true,
+		"012-1234.png":  true,
+		"1000-0001.png": true,
+		"dir-001.html":  true,
+		"dir-1000.html": true,
+		"001-0001.gif":  false,
+		"cover.jpg":     false,
+		"dir-01.html":   false,
+		"index.html":    false,
+	} {
+		if got := htmlAsset.MatchString(name); got != want {
+			t.Errorf("htmlAsset(%s) = %v, want %v", name, got, want)
+		}
+	}
+}
@@ -50,6 +50,16 @@ var resourcePdfPng = &fyne.StaticResource{
 	StaticContent: []byte(
 		"\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x021\x00\x00\x02\x80\b\x06\x00\x00\x00m\xf9)$\x00\x01'\x1aIDATx\xda\xec\xbd{\xb0+\xd9U\xe6\xf9\xad\xb53S:\x8f\xfb\xaa\xba\xb7\xaa\xec\xaa[\xb7\\v\x97\xc1\x06\xdbU@\x81\rn\f\x14\x05\xc3`\xcc\xf4\x18\x86\x80\x9e`\xe8\tfbp\xcc\xc0Ц\xbb1\xc4@c\x9ai0A\xc3\x04of\x80\b\xe8\x06\x9a\xa1\x01\xf3n`\xc0m\xf30\r6\x8d\xc1\xb8\xfc\xa8\xaa\xfb\xbe\xe7}\x8eޏ̽\xe6\x8f̔RRJ\xcaL\xa5\xa4\x94\xb47T\xf8\xea\x9c<:\xda:\x99\xb9\x7f\xfa\xd6\xda\xdfG0\xc3\f3\xcc0\xc3\f3\n7\xb4\xd6\xcc\xcc\xfa?\xbf\xefW\xbe\xf0\xbf\xfc\xe5\xef\xbc˲엋H\x19\xa0\xaa\xd6\xee\xf5\a\x1ez\xf9'\xca[\xbb\x1f}\xe0%\x8f\xff\xe5C\x8f<\xf1g\x0f\xbe\xe4\x15w\xa3?/Z+\x11!\x10<f%\xeb\xf8\x1e\x919M\xcc0\xc3\f3\xcc0\xa3XC\xb4&b\x16\x00\xf8\xd1\xef\xfd\xea3\xdb.\x9d\xb7\x9c-@\xb4\xff}\x11t:-x^\a\xae\xeb\xc2R\x16v\xcf_\xfe\xc0K\x1e~\xe2w\xaf>\xfe\xa9\xbf\xf9ɟ\xfa\xa6?\x1bx>\x11%Z3\x11\xb9\xe1\xf3\x1a\x881\xc3\f3\xcc0\xc3\f3\xe6:~\xfc\xfb\xbf\xf6/,\xcb\xfa\f\xa5J.\xa0-\x00\x02@\x98Y\a\xcb8k\xedq\xb7\xdbF\xbbU\x87\xdbm\x83\x95\xc2\x03\x0f>\xfe\xef_\xf1I\x9f\xf9S\x9f\xf1Ư\xf8\x83\xe8\xf3i-6\xb4\xe7\xb1ei\x031f\x98a\x86\x19f\x98a\xc6\\\x86t^x\xe6#\x1f\xf8\x8f\xbf\xfd\a\x7f\xf4>{wwW\x00\x19\xb7n\v\xc0\x1e+\x12\x80\x94\xd6\x1ewZ5\xb4\x9au\xb0b\xb9\xffʵ_y\xc5'\xbd\xfe'\x9e~\xe3[{@#\"$Z[ \xb8\xabZn2\x10c\x86\x19f\x98a\x86\x19E\x02\x17ъ\x88\xbd\xce\xe9\x87\xdeܮ\xfc\xfd\xbb\xb7w\x1f\xc0\x1f\xff\xf1\x7f\xc2\xdf}䣸p\xe1<\xb4N$\xa0\b\x98]&b\xad\xb5j\xb7jh5j(m\xed4\x1ez\xf8\x89\x1f\xfb\xd4'\xbf\xf0\a^\xf1ɯ\xbf\x13\x01\x1a%\x9e\x16\xb6\xd4J\xa93\x06b\xcc0\xc3\f3\xcc0\xa38\x00CD,\xbas\xac\x1a\xfb\xff\xa9%\xe4X\x10r\x99\x95\xf5\x87\xefy\x0fn\\\xbf\x81\xdd\xdd]\x88\xa4\x12NB\xa0\xb1\\\xb7K\xadF\x15\x9e\xdb\xc6\xf6\ue97fy\xf5\xeb\xbe\xe0\xfb_\xff\xa6\xaf\xfe\xb9\b̐h\xad\x88\xc8[\x85\xde\x19\x031f\x98a\x86\x19f\x98Q\x18\x88\x11\x8b\x88\xdc\xd6\xc1\xfb\xfe\x95\xdb>z\x87\x16\xdbu]\xd7\"\"\xb0Rx\xcf{߇\x1b7nf\x01\x99p\xd9\xd7\xcc\xec\t\xc1\ued9bh5*\x10\x00\x8f\xbe\xec\xb5?\xf5\xaa\xd7~\xfew<\xf1\xea\xcf\xee\xedpҞg\x83\xc4e\xb6\n\v3\x06b\xcc0\xc3\f3\xcc0\xa3\x10\x00\x13\xa80\xedC\xa7\xb1\xff\u07b6\xd0\x16<\xcf\x15Ok\xf2\\\x17D\ffƟ\xfc\xf9\xfbq\xe3\xc6\r\xec\xec\xec\xcc\xf4\xeb\x82\x1e\x1a\xd2Z\xabf\xed\x14\xedv\x03\x0f\xbc\xf4\xe5\xef\xfd\a\x9f\xf4\x86o{\xfa\x8do}o\xef@-\x96\xc0\xd3\xcc\xc5k\x046\x10c\x86\x19f\x98a\x86\x19\x85\x80\x98\xa8\ns\xfc\x0e-\x96\xeby\x9e\xe5i\x0f\x9e\xa7\xe1\xb9\x1e@\x04\xa5,\xfc݇?\x8c\x8f<\xf7\x1c\xca\xe52\x989\xa3*\xd3\xff\xd5\xcc\xca\x15\x82\xddn\xd6Ь\x9da\xe7\xfc\xa5\xbb/{ŧ\x7f\xe73o~\xdbO\xf6\x0e\xf2\xb4\x12\x12a.Nߌ\x81\x183\xcc0\xc3\f3\xccX:\xc0\f\xab0ex\x9e'\x9e֤=\x0f\x9e\xd6>\xc8x\x1eD\v\x1c\xc7\xc1\xf5\x9b7\xf1\xa1\xbf\xfd[\x88\xf8\x8fg\x04\x19\x04\xbd3\x1e\x13+\xb7ۢF\xed\fZ4^\xf1ʧ\xbf\xfb\xf5\x9f\xf7\x8f\xbf\xe3\xbe\xcb\x0f\xeb\x00fXDP\x84&`\x031f\x98a\x86\x19f\x98\xb1t\x88\xe9\xa90\xdf㶏\xbfuX\x85ў\x0e@\xc6\x7f\xecz.JN\t'''\xf8\x9b\xbf\xfd[4\x1a\r8\x8e\x03\xa2\xbc\x96u֬H<\xadU\xb3z\fOw\xf1\xf8\x13\x9f\xf9Co\xf8\xbc\x7f\xfc\x8e\xfb.?\xdc\b^3\x8b\xd6`\xb5<\x981\x10c\x86\x19f\x98a\x86\x19K\x05\x98\x9e\nSj쿷5I\x85\xd1\u1ff5\a\xd7\xf5`Y\x16\xba\x9d\x0e>\xfe\x89\xe7qo\xef\x1e,\xcb\x02\xb3\n\xfc\xf0r\x19\x9a\x95Ҟ\xd6V\xb3z\f-\x1aW\x1f{\xcdϼ굟\xff\xf6'^\xfd\xd9\xc7=\x98\x11\x8de\x94\x99\fĘa\x86\x19f\x98a\xc6R!\xa6\xa7\xc2\xfc\x9fn\xfb\xf8_\xf4U\x98\x00\\\x86T\x18O\a_\xf34\\\xed\x01\"`V888\xc0\v/\xbe\x00\xcfsaYv\xee/\x93\x95r\xb5\xd6v\xd8\x04|\xe5\xa1\xc7~\xf9\xe97\xfewo{\xe2Uo8@Xf\"Y(\xcc\x18\x881\xc3\f3\xcc0Ì\xa5\x01L6\x15\xc6\v F\xf7\xfe\xedA)\v\xcdV\x137n\\G\xa5R\x81R\n̜?\xcc\xf4\x9a\x80\xabhTO\xf1\xd0#\xaf\xfc\xd9/\xfe\xf2ozۥ\xb0\xcc\xe4iKH\x16\x12:i \xc6\f3\xcc0\xc3\f3\x96\x061=\x15\xe6{\xdd\xf6\xd1?\xd3b'Vaz\xc7\x04\xff\xebz\x9e\xbf\xac\v\xe1\xe8\xf8\x00\xfb\xfb{\xf0<\xbf\xe44\x8f\x97\x0ef\x97\x00\xbbY?C\xb7\xd3\xc2\xcb^\xf9\xf4\x0f\xbd\xe1M_\xfd\xcd\xf7_\xb9\x1a6\x00+\x10\xf4<M\xf3\fĘa\x86\x19f\x98a\xc6R\x00\xa6\xa7\u0094\x1b\xfb\xefmfWa\xa2p\xe3\x7f\x8d\x99\xd1jwpt\xb8\x8fj\xad\x02f\x9e\x87*\x13\xc0\x8crE<\xbbY=\x81\xa7\xbbx\xd9\x13\x9f\xf1/\xdf\xfc\x15\xdf\xfa\x9d\xe1\x01\xda\xf3\x14+\xe5\x19\x881\xc3\f3\xcc0Ì\xb5\x81\x98\xfcT\x98\xf0k\xda뗗4\x00\xdbR\xa8\xd7k8<\xdcG\xbb݁e\xdb\xe0<\xdb~#\xd3a\xa5<\xbf\x01\xf8\x04\xddn\x13\xaf\xf9\xf4/\xf9\x9f>\xffK\xfe\xe7\x9fB?\u0380\xf2\xde\xc9d \xc6\f3\xcc0\xc3\f3\x16\x0e0ST\x98\x11PI\xa8\xc2\x04\xc7jσ'\x02E\x04۱\x01\b\x8e\x8f\x8ept|\x04\x11\x81m\xdby\xf8ʌ\x83\x19-\x9e\xa7\xaa\xd5#X\x96}\xeb\xd3^\xff\x8f\xbe\xea\xe97\xbe\xf5O\xe0\xbb\xff*\x81\xce\xcd0\xcf@\x8c\x19f\x98a\x86\x19f,\x1cbz*\xcc\xf7\xb9\xed\xa3oɢ\xc2\f(/\xe1\xbf\x03\x88q=\x0fL\x04f\x80\x88\xa0\x88a\x97ltZ-\xdc\xdb\xdf\xc7\xd9Y\x15̘'\xcchR\nn\xa7\xc5\xf5\xea\t\xee\x7f\xe0\xea\x1f?\xfd9_\xf9UO\xbc\xfa\xb3\xf7\x10\xf4ː\xe2\x99KL\x06b\xcc0\xc3\f3\xcc0c\xa1\x00\xa3\x99\x88\xb5n\x1fn7\xf6\xdf[\x9f\x87\n#\" &0\x11\x88\b\x14\xc0\x8c\xa5\x14\x1c\xc7F\xa5R\xc5ݻwQ\xab\xd7a\xdb6\x94Rs\x82\x19v\x89\xa1\xda\xcd\x1a5\xea'x\xfc\x95\xaf\xff\xe1\xb7|\xd5;\xfe\xd7\x1e\xe9h\x8fgQe\fĘa\x86\x19f\x98a\xc6B!\xa6\xa7¼\xcbm\x1f\xbd}\xde*L\b2\xcc\x04\"\xffk\x8ec\x83\x89pxt\x84;w\xf7\xd0\xeet|\x98\x99=\x87i\f˰GD\xaa~v\b\x81v\x9f\xfa\xac/\x7f\xeb\x1b>\xefk~\x1d\x00Dk\x06 Yv1\x19\x881\xc3\f3\xcc0Ì\xa5\xab0\x1e\xc57\xed\xe6\xa3\xc2ā\f+B\xc9q\xe0\xb9.\xee\xdc\xdd\xc3\xfe\xc1!\xba\xae\aǶ\xf3\b\x95\x8c\x9d>+\xe5y\x9eg\x9d\x1d\xdfŅK\x0f\xbe\xe7ٷ|\xe3\x97=r\xed\xd5\x15d\xdc\xc5\xc4\xe6\x942\xc3\f3\xcc0ÌE\rb\x00\xe8T>\xf2] \x86\x88\xb8\"B\"\x80\x16\x81D\xfe\xd3Z\xa0EC\xb4\xf8\xff\xf5\xbe&\x90\xe0\xeb\xc3?\xe3\x89\x00\xe4\x83\n\b>\xb0 \x80\x99@\xb6\xa0\x10n\x04\xe8v\xba f<\xfe\xb2kx\xf2\xb5\xaf\xc2\xc3/y\x00\x9e\xe7\xa1\xddn\xc3\x7f\xaa\\\xb5\x0eҞg\x11\xa0\xef\x7fસn\xfbs\xffߟ}\xc7\xd9\x1f\xfd\xf6O|\x13\x00\xb0R\x9ehQF\x891\xa3\xc0\x9fB\x84\x89Hk_>TkpCZ\x93\xfb\xaa97\xe7wқ\xb7\xc0\x8c\xbe\x10\xc1\xccm\xdd>\xdci쿷\xb6,\x15\x86\x89\x06\x8f\tKL\x96\x82]\xb2\xd1h6q\xfd\xfa]\xec\xed\x1fAC\xa3\xec8`\"輕\x19b\x0f\xa2U\xadz\x04\xa5\xecO\xbc\xe9\xbf\xfa\xfa/x\xd5k>\xefz\x9a\xed\xd8\xe6\xd6eƢ\xe0\xc5\x02\xa0\x89H\x9bw\xc3\f3\xcc\xd8\xe4\xd1>|\xdf\xf7u\x9bGߢaw=ϳ\x17\xd9\v\xe3\xff\x9b\xc0\x84\x01\x90\t\x7f\x86\x008\x8e\r۱P\xad\xd6\xf1\u008b\xb7p\xef\xe0\x04\x80\xa0\x1c\xa4d\xe7\\f\x12RJ\xbbݶ:=\xba\x8b\xd7=\xfd_\xff\xef\xcf|\xe97\xfc \xfc^\x19\x8b\x98]\x031f,Uy\x81/_j\x00\xa8\xb7;\x9f\xbcSr\xfe\xfe\xf9\x1bw\xaf\x9d\x9e\xd5\xff[K\xa93@x\xf0L\xe4ș)\xa0\xa1\xaa'\r\x9c\xb92t<Ş\xd8D1\xa7\xbc\xdfJ6\xf6b\xa0\xa1\xef\xfb/\x83\x06>a\x0f\xbel\x1a|<F\x86\xed\xbft\xea\x15t\xfb\xe6S\x14{Ur\xf0N\f\xbf\xfc\xe8\xf1\x12\xf3\x9b\xa2/Y\x86\x1eǽ2\x19\x98&ż\xdf\xf1\xb7\x0f\"\x1e\x90\x1d&)\xd02\xf0b)\xb9\x8812\xc1\xf4<,\xa0\xf8\x9f\x93\x94\xe2I\xe2{8A\x12\xbeN=\xf2ܒ\xec\x86-S\x9e/\xc1\x02\xa0gz3\"g\x8f$\xff\xabdZ\x06ǜ\x10\xb1\x7fU\x99\xf4\x143.\u0092~\x0e\"BZ,\xd7\xe16?\xea\xfc\xe5\x8fu\xb5eMUa\xa2*˼T\x981\xfd2D@\xd9q`\xdb\n''\x15<\x7f\xfd6\xf6\x0eNAаm\a\xccy\xc3\f{\xacHUN\xf6\xb0s\xee\xd2\xfb\xbf\xf0\xcd\xdf\xf4\x05W_\xf6꺈\x10D0\xae\xe9\xd7@\x8c\x19\v\x81\x97f\xa7{\xe5\xb4\xde\xfeE\x80>\xff\xe4\xf0\xe8ɏ\xbdp\xfb/m\xa5\x14\x11CH\"\x8b2\x03$\xbd\x9b\x03\xc7>\xee߈\xc2Ǡ`\xf9\x95`\x01%\x89.\xf3\xa3\x8bro\xa5\xa5\xfe\rx\xe0\xf1\xd0\xcf\fC\x10\a\xbf\"\xba\x903\x05_\xf3__\xf4q\b9\xbd\x9f\x90$\x8f\a\xd0&\xfaK\xfb\x8fY\xfa\xb8\"\x18x/\xfa\f\x16}\x1c\xde\xee\x19=r\xa4x\x80\x99\xfcx\xf8\xbd\x89\x02\r\x8d\xbd\xb1H\xf8\x9a\xf4\xf8%\x80\xc2\xe3&.H\x92\xe4[#\x8b:\xc5}S⎛p\x90$\x83\x04\x02\x12\xc9\xef\x03 \x91\x00`\xf4T\n\xf0\xdf=IH\x0e2\xf0\xaeǬВd\xb1\x0e\xde݄k\xda\xe04ur\xd0\xd1ыQ\x0f\xcd\x18\x13\xff\x90\x14@\xac\x8c\xfb\xeb\xe9IoS\xe4wF\x88)\xd1t\t\x10\xed\xff\xe6\x96[\xc6\xcb/\xdd\xc6c\x17\xf7\xd0\xecZ\x11\x15e\x06\x15&\xf8w\x1e*L\b8\xbdc\x82\xef;\x8e\x02\x88pvRŋ7\xf7\xb0\x7fx\x04\xad\xf5<v3\t\xb1%\x9dv\x8dk\x95c|\xda\xeb\xdf\xf2\xd6\xcf\xfd\xa2\xff\xf1W\xe07\xfdr\\y\xc9@\x8c\x19y\xc3\v\x01PD\xe4\x06\xf0\xb2}\xd6h\xffh\xa7\xe3}-3ò\x18\x1f\xfa\xd0s-\x02\x95-\xcb\xea\xf6\xd7Q\x89W`&>\x06 \xfd\x85\x1c\xe0`\xfd\x0eo\xc2\xc12=\xfc}H\xb0\x1a\a\x90#:\x00\x88\xf8\xc7\x10\r\x1az\xec\xab.\xec\xdf̈́\x00fP\xef\xceF\x10fp\xe41\x98!\xd0}\xac\x12\x86\xb0\xeekL\xa2\x86\x1eS\xc8d\xfe\xd7&=\x16\xea\x03\f\x03\"\x11\xedj\xd2\xe3\xde\xcf\xf8\x8b\x0fG\x1f\xeb(w\xf5\x97~\x0e\xbe\x0f\x1d\xdc\xc0\x83\x1b\xe0\xf0c\xd11\vz\x88S2\xfd\xf6\xaf\x87Ŷ1K\xdb\bp\xa4\x01\x18\x8e\xbc\xa8\x89\x00\x139g4\xa0%\xc1͓\x01\xad\x01\x12\x99z\x97ա\xa0\xa6#\x7f\x93\x98\x89\xe8({\x0f\x1c;\xfaZ\xf50k\xea\x04\xef3$\xe6\x8f5\xe9=\x89\xfc\x9c\x1e\xe2\x8bi\x7f\xdb\xded$\xd99\xa0Gd\xcc\xf1\x7f3\x1d\xbf\xba\xf5\x8f\x931o촹\xca\xc0\x13%]\xb6E\xfb瀧\x19\x16\v\x9e|\xe0\xc3p\xbb\x9e\xd5\xf5\x84\xc6\xc7\b,O\x85\x19|\xec\x7fMk\r-\x02K)(ŨT\xeb\xb8u{\x0f{\a\xc7p\xbb\x1a\xb6\xed\x7f=/\x96afOk\xad\xaag\a\xb8\xff\xc1G\x7f\xfck\xbe\xfe\xdf\xfc/\xfe{\xa9\x15\xf1\xa0A\x9e\x81\x183\xf2\x84\x17\x8b\x88\xba\xe1\u05ce*\xcdw5ڝ\xb7\x131\x98\x00\x81x\x96\xa5\xd4\xc7?v]\x1a\xf56\x94̓\xfa\xc8\xc0\xa7\xa64\x8f\xe3\xd5\x12\x8a+1\xc5\xd4FF\x95\x84ɏ\x11t\xfbG\x0f\x18.#ŕ\x95h\xf0\t\xa6>\x8e{oF\x1f\xd3ЕLCS\x1c\xf3xl\xc9(\xd9\xe3Pq\x19\x9cf\x82\xd2P\x02\x80\xc9[\x81\x91\xc9\x12Ĕ\x1f\x1fT`R\x96\x0f2\xa8\x12\x89^\xc9D\x15&\x9d\x023\xf9͐$\xaf>\xc5\xfb\x92\xf4\x1c\x98<_A\xc2S$\xf5y \t\xebG\x92\xf2\x1c\xf0U\x98\x12^~\xdf-<\xbc}\x17\xf5N\x1a\x15&^\x99\x19Pa\\\x0f\xcc\xf9\xab0aɨ\xbf[*\x84\x19\x86bF\xad\xd1\u009d{\x87888A\xbb݅R\n\xb6\xcd\xc1\xdcsQeP\xaf\x1e\xd2\xf6\xd6\xf9\xbfx\xe6-\xdf\xf8YW\x1f{\xb5\x88\xf6\x14q\x7f\x1b\xb6\x053̘\x1d`\x14\x11y\x00\xba\x01\xbc\xfc\xb3f\xb7\xfb\xbd\xcdNǗ\x1a\x81\xae@l\x11(!\x02+\v\x82&\x819\xf8D\x96?\xc0$\x1d\x06`\xb2\x01L\xeasd%\x01f\xa6k\xc2\x00\f\xb2\xbf\xc7\xeb\x040\x00\xe0i\x85\xb2\xdd\xc6C[Ghu\x15$\x80\x01\xd1\xfdm\xd2Z\xeb>0\x84_\x8bn\x9f֑-\xd8\xc1χ\xcf\xe1\x83H\xb0\x95:\xbc\xa7\fl\xa7\x0e*\xcda\x89h\xe0\x18\x1f\\\xc2[\vE\xb6`\x870\xe2\xff\xd7/<v\xbb\x1e\xda\xe2²\x14\x1e\xbf\xf6R<\xfc\xd0\xfd88:\xc3\xde\xfe\t\x1a\x8d\x16\x98\x01˲@4\x13̐h\x17;\xe7/\xebv\xa3\xfa\xf4\xaf\xfcܷU\xae\x7f\xe2\x03O\x12\xab\x8f\x8b\xf6\x98\x02\x97_\x031f\xccr\xa3\xb6\x00x\x01\xc0\xe0\xa8\xd2\xfc\xbaf\xbb\xfb\xe3\xcdN\xc7\xf1#\xdf\xc9\x15\x88\x02`K\xd0\xf0GB\xb0,E:\x17\x05f\x1a\x9cLS\x12\f\xc0d\x05\x944*L\xe1\x00&\xcbR*\xd9\x16/\x030cO\x86\x8d\x01\x18\x82\xa0\xab-<z\xf1\x1e\x14u\xd0\x12\xcb\xf7x\x11\x19\xef\v#\xa1/\x8c\x8e|m\xc8\x17&\xf0\x8d\xf1\xb4\xf4\x14\x97I\xbe0\xbd\xfb\xd3\xc01\x88\x00\x0e\xf5\xfde\x82ۗ?\x8f~\x8f\x95\f),\x9e\xe7\xa1\xdbu\xc1Lx\xe8\x81K\xb8|\xff\x05\x9cU\xea\xd8\xdf?A\xa5Z\x87@\xa0\x98\xe1\xaf\a\x19\xafB\xcf\xe5ry\xd7U\xb6\xbd\xfb\xeb\xff\xee\xbb?v\xfd\xe3\x1f|\x8aX}0\x04\x19\x031fd\x81\x97\xb0i\xd7\x05\x80J\xa3\xfd\xa6Z\xab\xf3\xef\x1a\x9d\xceK\x94b\x00\xe4A\xc0\x02\xb1\xa27:\x12\x01\xa0aY\n$~/\x87\xce\x00(\x03T\x91\xb1\x8c\xb4\xb1\x00\x93\xe4\x1dͱ\x8c\xb4,\x80I#\t\xe4YF\xda\f\x80Y\x86\x12\xb7\x9a\x00\x13\xaa0[#*\x8c.\xa6\nC\x13T\x98\xe0\xbf\xf0\xff\xa2\x17\x86\x0f3\xfe\x83\xf3\xe7\xb6q\xfe\xdc6\xea\xb5&\x0e\x8f+\xa8T\xea\xe8t; R\xb0\xacl0\xa3ų,\xe5x\xdb\xe7/\xa9_\xff\x85w~\xe0\xfa\xc7?\xf8i\xc4\xea\x03\xda\xf3,\x031f\xa4\x81\x97\x81\xa6\xddV\xa7\xfbh\xa5\xd1\xfdųF\xeb\xf5\xcc\fŬ\x83\xf3Z\r\x7fR\v/>O\x00\xdbV\xa0\xde\xee!=aA\x9dO\x1f\xccZ\x02L*\xee[L\x1f\xcc\xdc\x01f\xeas.\xb6\x0ff\xa5\x01&-\x92̡\x0ff\x1d\x01&Ta\xae\x8d\xa80\x18\x01\x95B\xa80H\xa0\xc2D\xc0F\x86\xf6\x85\x89\b:]\x17\"\x02\xa7d㑇\xefG\xe7\x81\v\xa8V\x9b89\xad\xa1\xd9l\xc1\xf34\x94\xf2ՙ4\xe5&\x11\xad,U\xd2\xdb\xe7\xef\xe3_\xfd\x85\xef\xfa\xab\x17?\xf1\xc1ײR\x7fc ƌ\xa4\xf0\xc2A\xd9\xc8\xedt=\xfb\xa4\xde\xfa\xa9ó\xe6ײ\"X\xcc\"\x80\a\x81\x15\xde8\xa2'fxak\xe2`\x17\v\x83\xc0\xd0\x11\x80YT#\xef*\x03L\x02Q\xaa0\x8d\xbc\xf9\x00L:E\xc5\x00\xcc\xec\xeaԲ\x1ay\xd7\x01`\xe2F\xa8\xc2<8F\x85\x91D*\x8c\x14V\x85\x89|+\xf2\xde\xf9_\xf0\xb4F\xd7\x15\x10\tΟ\xdf¹s[h\xb5\xbb\xa8\xd5\x1a\xa8T\x1bh\xb5\xba\x90\xa0I\x98\x99\xc1\xc1\x8e\xbe) Ör\xbc\xdd\xdd\xfbջ\x7f\xf1\xbb\xdf{\xfb\xc6\xdf\x7f\x92\x81\x183\xa6ݜ\xad@y\xf1\x00\xe0\xb8\xda\xfcփJ\xe3{\x00\x81R\x04\x01\\\x11X\x00,\x19V_\x82+\xa1w~\a\x17\x9d\xeby\xe8x.\xca\xec\xc0\xd5\xda\x00Lb\x11e5v\"\xa5ف\x92\x94R\x8a\xbc\x13\xa9P\x00\x93\x88\xf7\x8a\xb5\x13\xa9\xc8\x00\x93\xf2^\x19\xa3\xc2\xd8}\x15FgUa\xa4\xe0*L\xb4\xaf\xa7\x7f\xedH\xef\xb6\xdf\a5\xc7Vx\xf0\xf2E\\\xb9|\x1e\xadV\a\xb5Z\vg\x95\x06Z\xad\x8eo\xf3\x10\xd8p\x84\xaf/\x0ejD\xb4\xb2\xec\x92k\xd9\xe5\xf3\x7f\xf5\xa7\xbf\xfa]\x06b\xcc\x18wA\x06\xdeh~\xe9\xa8\xdah\x7fy\xb5\xd5\xf9\xb9F\xa7\xbb\xab\xfc\xab\xc6\x13@E\u0557\xc1\xf2Q\xe4F+\xfd\xcb\xc1R\n\x95z\vG'5\xdc\x7f\xe9<\xba\x8d\x0eHm\x02\xc0$\x11RV\x7f+u>[h\r\xc0̪\xa8\x18\x80\xc9\x020\xd9E\xad\xb8\xf3\xa0\xab\x15\xb6\xec&\x1e\xdc:\xf0U\x18\x89Wa\xf4\x1a\xa90\x12\x00M\xefgdP\x95\xd7Z\xd0\x15\xbf\x11x{\xa7\x8c\v\xe7\xb7\xf1҇\xeeC\xab\xd5\xc6i\xb5\x81\xb33_\xa1q]/\xd8\xe2ͽ>\x9a~o0\x01\x10\xb5\xb3{\x11\xfb\xf7\x9e\xffJ\x031f\f_\x8c\xd1\xd2\x11j\xcdΧT\x1b\x9d_\xaa4ۯR\xcc`\xdf\xeb\x8b\x02\x80\xc1\xa4\xf2\x91\f,\b\xd2\xfb\x04b\xdb6^\xbc{\x80W?\xf1(@\xed\xc8\x02\xba\xa2\x00\x93\x14S\xd6\xdc\v\xc6\x00\xccl\x00\x93fi]\a/\x98\x85\x01LZd\x99\xb1\x0f&Ta<m\xe3\xe1\x8b\xf7\xa0ȝ\xa8\xc2Ț\xaa0\x12)-\x89H\xff\xf7\x06\xbf\\{\x1a\x1d\xad\xc1L\xd8\xd9-\xe1\u0085-\xe8\x87\xefG\xa7\xd3E\xa3\xd9\xc6\xd9Y\x13\x95j\v\xedv7\xf0Ñ\xfeJA\xa0V\xe3\x04\x97\x1f\xbc\xfa!\x031fD/\xc6\xd0\xef\xc5븞sZk\xfd\xfcY\xa3\xf5\x15\x8a\x19\n,\"\"\x02p\xff\"\x9fR>\x92\xfe\xedֿ\xce\x19\xae\xabq߅\x1d\xbcps\x0f\x9eֽ\xadw\x85\x05\x98D|b\xcc\xec\f\xc0\xe4p\xfd%\x92\x00\f\xc0\xcc\xf2\xc6λ\x91ר0Q\x15&\xf8'\x06_W\xf4\xf7r\x00P\xae\xeb\x9b\xf81\x13J%\x1b\xbb\xbb%<\xf4\xe0E\x88ht:.:\x1d\x0f\xedN\x17\xcdz\x1b\xae&\x11]\xa7\xfb\x1e\xfa\x9co~\xf9'}ޏ\x19\x881#Z:\n\xfa^Z\xdfxx\xda\xf8A(\ts1\\\xbf祗\xf1\x96\xa8|\x84\x01\x80\xf1Oڮ\xdbť\x8b\xe7qpR\xc5\xfe\xd1\x19\xee\xbft\x0e\xadf\a\xa4h\xe1\x00\x93\x14P\x8c\x1b\xaf\x01\x98\xac\x00c\xdcx\xb3\x03LzNY\xeeN\xa4|U\x18\x99\x93\nC\xb9\xa90\x92R\x85\t\xefPD\x18zm\x83\xa1\x93ZktZ\x1a\xa4|\xc7`Ƕ\xb0\xb5U\x82\xa5\x00f\x82R6\xb9\x9d\x03<\xf2\xc9\xcf\xfc\x96\xbd\xf5H\x8ba\xc6F\x97\x8eD\x84\x89H\x13\x91\xd4Z\x9d\xa7\xef\x9eTo\xb5:\x9d\x1fdE`\xb0\x17\\\xa8V\\iH\x06.f\x89-\x1f\x85\xd7yx\x8c\xa7\x05\xe5\x92\r\xa5\x14\x9e{\xfe\x16v\xca偛F\x9eq\x02\xc9\x04\x14\xe3\xc6;k\x9c@>J\xc3\xea\xc4\t\x18\x80\x99\rd3\x9d/(\xf6V\xea\xfcU\x18='\x15\x06\tT\x18I\xa4\xc2`F\x15\x86\x86\x00\xaa\xaf\x12\x01\xa4\xfa@\xa6\xb5\x86\xdbq\xd1j\xb9h\xb6]i6\xdbh\xd4\xdbh\xb7:\x87\xe8'ڙ\xb1\x81\x00\xa3\x88H\x88Hw\\og\xff\xac\xfe\xee\xb3z\xeb\xfd\x04z\x98}\xbf\x17\x11\x11\x85(\x89\xf7j\xa0\xfd\v^$\xe2\x14\x10\xd3#3|L(\x19\xdewq\x17\x1fz\xee\x06,\xc5\x03\x94\x9e\x1c`\xd2\x01\x8aq\xe35q\x029]7\x06`ƞ\x03\xb3\x80\xec\xeaz\xc1Ī0\xe7\x0e\xa1ȅ\xa7\xa9\xa7TăJ\x18?\x90\\\x85\x01ƫ0\x94\x8b\n\x83\x85\xab0\x88\xf4\xea\x84\xf7\xb3\x01\x85F\x01\xac\b\x8a\xc8#\xe9\xc2ٺ\xf8\xe1\xddK\x8f\x1f\x8b\b\x19\x88\xd9\xc0ґ\x88PX::\xa9\xb6\xfe\xf9a\xa5Q\xf3\\y\xb3\xf2\xfbS\\\x11a\x81P\xd4bZ\"\x14\xde\a\x1b\x19('\xc5A\xce\xf01Z\v\x88\b/\xb9r?\x9e{\xfe\x0e\xea\xcd6\xac\xd0s1\x8b[/\x8c\x1bob\x80I\x04\x7f+\x1c'\x90\x98X\x8c\x1bo^\x00\x93\xe6\x0f\xb0\xee\x00\x93^\x85A\xffk9\xa90X\a\x15f\xe0X\xeaݟ\xfd\xc7\xca\xeaz5\\\xbc\xf2Է\xfb\xbf^\xb3\x81\x98́\x17\n<_\xfc\xd2Q\xb3\xfd\x0f\xef\x9eT\xf7[\x9dοV~$\xbb\x87~\x1e\xd2\xc8\xd6\xe8\x89\xe5#\xc1h\xf9(\x06`\x00\x1f\x8dD\v\x1e\xbcr\x11G\xa7\x15\\\xbf\xb3\x8f\xad\x92\xed\xc7\xd5#\xa9\nc\x00f\x04`\x12s\xdf\x1a\xc7\t$z\n\x030\xb9\xdeW\xb0\xb9n\xbc\xe3U\x98\xfdA\x15fl\xb9\xa8\xc0*\x8c,_\x85\x89BOp\xbc\x86t`Y[\xcf_\xb9\xf6E\xbf\x1a\xbcxm f\xb3JGn\xabݽ\xb8\x7fV\xff\xfdJ\xb3\xf3\x1e\x06]af\x1d\x88(\xaa\xb75:\x8f\xf2\x91\xc4@\x0e\xfc\x94\x81\xaeָtq\x17\x1a\x84\x0f\x7f\xfc6ʎ\r1q\x02S\x00&;\xa0\x988\x01\x030\x89\xdf]\xe3ƛ\xf9\x1c\xf0z*\xcc\xf1\xa0\n\x13\x01\x91\xb1*\x8c.\x98\n\x83\xe9*\x8c,^\x85\xe1\xae[ť\a>\xe3\x9f\xfa\"\x8cg\x11\xb1\x18\x88Y\x7f\xf5%Z:\xfa\xce\xe3z\xebD{\xf2\x8c\"\x12\x01\\\x01X\x04\xd4;]s.\x1f\r@\x8e\x00B\x04\xcfuqaw\a\xdbe\x1bϽp\x1b\xed\xae\v\xbf\x94e\xe2\x04&Q\x8a\x89\x130\x00\x93U\xb02fv\xc85N N\x85\xe9\xf4T\x98nz\x15FVO\x85\xc1rT\x98\x8f_\xb9\xf6E\xbf\x06\x00\xc4ʅi\xec\xdd\b\xf5Ej\xcd\xce\xd3\xf7Nj\a\xadn\xf7;\x94\xbf5\xcf\x13\xff\x1c\xb1\xe2v\x16ILxc\xe6\xf2\xd1\x10\xe4\x10\x04\xae\xd68\x7fn\a岃{\a'\xb8wx\x8a\xadR\tZk\x13'\x90\xb6\x8cTt\x80\x99r\x84q\xe3M\b0YPŸ\xf1fy32\x9d\a]\xa3\xc2,J\x85\xf9\x96P\x85\t\xdf{\x031\xeb\a/\x1cl\x9b\xf6\x00\xe0\xa8\xd2\xf8\xb7\x95f\xfb\xfdD\xb8\xccL\xe15\xe4\xbb\xedNp֕\xa1\v8s\xf9h\xa0\xaf\xc6\xff!\xd7\xd5\xd8\xda.c\xb7\\F\xb3\xd3\xc5s/\xdcE\xa9\xe4\f\xd7FL\x9c\xc0:(0ƍwve\n\xc6̮Hq\x02q\xcf\xe6j\x1b\x8f\x18\x15f\xde*\xcc'\x86U\x18\x031\xebW:\n\x1bw\xf5Y\xa3\xfd\xdf\xdc=\xaaz]-_\xad\x98\x00\x81+\x02\x06@a)H\x86\xfb_dZ\xffK\x86\xf2QxL\xf83D\xf0\xba\x1ev\xb6Jp\xca6,f|\xe4\xf9[\x00\xf9\x06N&N`\x9d\x13\xa9M\x9c\xc0\xb4g4n\xbc)\u0381\xb4\xc82\x87>\x98\x85\xab0\xb4\x8a*\f\xe5\xa5\xc2|\xf3\xb0\nc f\xfdJGn\xc7u\xcf\xef\x9f\xd5\xff\xb8\xd9\xea\xfc\a\xa5\x98\t\xe2\x06碅1\xc6t\x12\xe7\xbe;\xa4\xacȐ\xfbn\xd2\xf2\xd1\xf01\xac\x81\xae\xf6\xb0\xb3U\x86c9pl\x1b7\xef\x1e\xe2褂\x92\xe3@\xeb\xc5\x01\xcct>1fv\xa6\x84\x94\xc3\xf59K\x19\xc9\xc4\t$\xfae\xcbh\xe4\r\x9f\xd1\xd36\xae.J\x85\xc1*\xaa0\xc8C\x85\xf9ؕk_\xf4\xeea\x15\xc6@\xccz\xa8/a\xde\x11Nk\xad\xff\xed\xb8\xda:\x13\x0f\x9f\xcb\xcc\"\"Z$\u2d9b\xb8|\x94\xc0}7\x16`\x86N\xee\xb8c\xd87\xbcsJ\x0e\xb6\xb6\x1d(\x054[\x1d<\x7fs\x0f\xe5\x92\xe3o_\xca\x05`\x92\x96z\xb2\x96\x91\f\xc0$'\x1a\x13'`\x00f\x16\x00,\xdeN\xa4\xf0\xf9\\\xad\xb0m5\xf1\x80Qa\xe6\xa3\xc2\xf0莤ῃ\x81\x98\xd5W_\xbcV\xbb\xfb\xf8\xdeY\xed\xb9V\xa7\xfbCL\x04\xb0x\"B\x12\xfc}\xf3,\x1f\x8d\x1c#1\xe5\xa3H\xff\x8b\xc4A\x8e\x00J\x11.\xecnCk\x80\x15\xe3\xef?q\vJ\x11|\xfb\xc5i\x00\x93\x12Q\x8c\x1boj\x80\xc9Oi0q\x02\x1b\a0\xd9\x19w\xa5\x00\x86\xc2^\x98\xf3F\x85\x99\x9b\n\xa3;\xb0\xac\xad\x8f^\xb9\xf6E\xbf\x11\xa7\xc2\x18\x88YMx\x19tܭ\xb7\xdeu\xd2h}\x82\x84\x9e\xf0=_\xc4\xf7|\x99\xa0\xac\xe4U>\x1a\xdb#3\xb0-\xbb\x0fP\xe1\xef\xf4\xb4\a\x02\xe3¹\x1dt]\x0f;e\a\xcf\xdf\xdcC\xa3т\xa5\xecT\x80b\xdcx\xb3\x01\xcc\xfc?}/\x19`\xd2_W\x06`\xe6\xa2ĭ\x97\x17L\xf4\xf9\\Ϩ0\vRa\xde>N\x851\x10\xb3\x9a\xea\x8b&\"\xa9\xb7:o\xd8;\xad\x1d\xb7;ݷ+b\b\xe0\x89\bC@Iv\x16\xe5U>J\xd2#\x83ȉ\x1e^\xe0J1\xce\xed\xee\xc0s]\x94K\x0eNNk\xb8~\xf7\x10[e\xc7\xdfj\r\xe3ƛ\x0e`ҍ\xf5\x8c\x13\xc0\\\xcbH\x9b\x1b'\x90\x95Y\xd6\x17`\xfa*\xcc\xc1ʪ0\xb2\x12*L\xf9\xb9I*\x8c\x81\x98Ձ\x17\x8an\x9b>\xae6~\xa9\xd2l\xff\t\x81.1\x85ꋨ\xc12O\x8e\xee\xbbS\xcaG\xbd\x13=\x06r\x10\xb3][\xc3\xcfP\xba\xb0\xbb\r\x01\x81\x89\xa1\x01|\xe4\xf9\xdb(\xd9\n0\x003\x9c\x83\x99X\x9dZ\xd58\x81ٷǚ8\x81\xdc\xc1ĸ\xf1\x8e=\a\xba\x9e¶\xdd\xc0\x83[G+\xab\xc2`%T\x98Ϛ\xa8\xc2\x00\xc1\x8e\x153\n\xaf\xbex\x00\xa4\xdah\x7fY\xbd\xdd\xf9\xb5\xae+\xa4\xfc\xe6\x11-\x10\xc6\x04_\x17\x8c+\x1fa\x14:\x90@Y\x99~L\xe4\x99ǀ\x11i\xc0\xd3.\xce_\xd8\x01\x11\xa0\xa1\xb1]r\xf0\xd1\x17\xee\xa2\xd3u\xa1\x14o\x14\xc0d\x05\x94u\x8a\x13H\xb8\x8a\x1b\x80\xc9\x040ƍw\xd6s`\xf0j\x14xZ\xe1\x91K\xb7\xc1\xd4EW[\x80\xe8\x9cT\x18\rO\xfbv\x13\x1b\xae°em?w\xe5\xda\x17\xfe\xe6$\x15\xc6(1+\xa4\xbe\x1cT\xea\xbf\xd6hw~\x9d\x99)p\xdcEP>\x9ahL\x97\xaf\xfbn\xc6\xf2\x91\f\xde\x1c5\x03\x9e'\xb8\xb0\xbb\xd3\xfb\xd9R\xc9\xc1\xfe\xb1\xef\xde[\x0e\xdc{\xd7\x06`\x92 \xcaZx\xc1L\x01\x18\xe3ƛ{\x9c\x80\x01\x18\xcc5N V\x85\xd1\nۥ\x16\x1e\xdc:A\xab\xab@\xb9\xaa0\x92@\x85\xa1\rPa*\x13w$\x19\x88Y\r\xf5E\x88Hך\x9d/\xbewR\xedj\xc1[\x88XC\xa0\x05\xa20R\xe6\x19_>\x8anyF\x8e\xee\xbbI\xcbG2\xd0<,`\x01\\O\xe3\xfc\xf9\x1d0\x11\xb4\x16\xd86\xa1\xdb\xd1\xf8\xe8\x8bwQ.\xd9\x10Y\x11\x80I^\xe9Ys3;\x030\x99\x00&\x8bBe\xdcxs\x01\x98,\xe7AO\x85\xd9\xdd\x0fT\x98<{at\xc2^\x18\xac\xa5\nC\x03\xbd0;\x1f\xb9r\xed\xd9ߚ\xa6\xc2\x18\x88)\xb8\xfar\\m\xfc\xfbz\xab\xfd;\x8a\xd9\"\x81\a\x80G\xcbGCۧ\xa7\x847\xca,\xe1\x8d@̶l\x8ci\f\x1e\x06\xa6\xd1\x1dJ[e'\x88\x1b\x10@\x18NI\xe1#\xcf߆\x88@)Z>\xc0\xa4\x00\x94\xcdv\xe3\x9d\xd2\x03c\xe2\x04f\xa8\xa0\x19\x80\x91\xf9\xdck3\x9d\a]ͱ*\x8c^A\x15F\n\xa6\xc2 \x83\n\x03\xd3\x13S8\xf5\xc5\x03 \xf5V\xf7M\xb5V\xfbw\xbb\xda+13D\xa0\x05P\x88-\r\x8d*+H\x91}4\xad|\x04Iy\fF\xcbG\xc3۵\x01B\xb7\xeba\xab\\F\xb9d\xc1\xf5\x04\x8a\x04[%\a7\xef\x1d\xe2\xf8\xac\x86\xedR\t\xad\x8eۻ\xa8\xe7\x010I\x00Ř\xd9ͧ\a\xc6\xc4\t\x18\x80)z\x9c\xc0🟠\xe1\xe92\x1eٽ3\xd2\v#\v\xeb\x85\xc9O\x85A1U\x18\xb6\xac\x9d\x0f_\xb9\xf6\xeco'Qa\x8c\x12S\x1c\xf5EEԗ\x9f\xad6[\x7f\xc4D%\x12\xf6\x02Մ{\x04\x8c\xb4\xee\xbby\x94\x8f0\xb5|4\xd2\xff\x12)\x1faH%\"\xf2]{\xb7\xb7Kp\x1cǿ\x110`+\x1b\xcdV\v/\xdc\xdcG\xb9\xec@\xa0g\a\x98$U\x1e\xe3ƛ\x11`ҫ\f+\x030i\x8f5n\xbc\xa9\x00&\xe9/+\xc2N\xa4\xf0\x1f~/Ls.*\x8c6*LT\x85\xf9\x96\xa4*\x8c\x81\x98\xe5\x03\f\x87\xae\xbb\xedn\xf7\x93\xf7Nk\x87]W\xbeV1\x8b\b4\x00\x15\xb7\xb3h\xf1ፒ\xaa|\x14\xe7\xd0ۻ\x81\xf4\xcaI\x1a\xb6\xa5\xb0\xbd]\x86\xa75X\x18Ā\"\x85\xbf\x7f\xfe\xa6\xff\x89\x04S\x00&\x11\xa9\x187ެ\vW\x9a\xd5f\xed\U00090319]j\x80I\xff\xf6\x16\x7f+u\xf8\x0f\n2\x92\xe2za\xa4\a\":\xb3\n\xa3\x17\xdc\v\xd3\x17T\xa4h\xbd0\xa9T\x18\x031\xcb\a\x18\r?\xf3\xe8\xdbNj\xed\x0f3\xd1\xfdDp\xc57\xac\xe3\xa9;\x8b\x16\x1c\xde\xd8#x\x19\xaf\xec\xc4\xf5\xbf\xc4\xf6\xc8x\x02V\x84s;\xdb\xd0^\x10\x90\xa0\x05岃\x17o\xed\xa1\xdel\xc1\xb6\xad\x04\xe5\x1e\xe3\xc6k\xe2\x04\x90\xef|\r\xc0̮La\xf5\xbd`\xa2\xffH\xa2\xc2\xf4KJ\x13T\x18]4\x15\x06+\xdb\vc f\xb9\xe5#\"\"\xddq\xdds\xfb\xa7\xf5\x0f\xb4\xbb\xeew+&\x04\xea\x8b\x15UV\n\x15ޘ\xb2|\xd4\xdf\xc94\n9\x1a\x1aD\n\xe7v\xb7\xe1i\x0f\f\x82&\xa0\xe4\xd88\xa94p\xeb\xde\x11\xb6\xca\xe3\xb7Z\x1b\x80\xc9\x06,&N\xc0\x00\x8c\x89\x13Hw\x0e\xe4\xaa\xc2\xc8z\xaa0\x94\x8f\n\xf3wW\xae=\xfb\xbbiT\x18\x031\x8b\a\x98p\xeb\xb4T\x9b\xed\xaf8\xae\xb6*Dx\x92\x99\xbc\x80\x198\xbf\xf2\x11\xe6\x13ޘ\xa6|\x14\x99K_\xd9\t\\{5@L8\xb7[\x0e\x94\x18\xff$W쟒\xcf=\x7f\x1b\xb6\xa5\f\xc0\xa4,\x9f\x998\x01\x030\xb9+S\x1b\f0\xa1\n\xb3S\xea\xbb\xf3\xa6Wat\xee*\f\x15L\x85\xc1\x92T\x18\x98\xddI\v\a\x18\x0f\x00\x8e*\x8d_n\xb4\xbaoUL\x10\xc0\x83@a\xac1ݴ\xddG\xf1\x90\x81\x05\xb9\xefFw\x1f%:&\xfa\x9aE\xe3\xdc\xceN\xb0N\xf7\x8caP.Y\xf8\xd8u߽\xd7R\fYs\x80I\x04'X\xe58\x81Y\x9aj\x8d\x1bo>Ş\xbc\u0383\xcd\x02\x980#\xe9\xea\xee\x1d0y\xb1;\x92\xa6\xab0\x98\xa8\xc2dّ\x84\xf5Raزv\xfe\xf6ʵg\x7f/\xad\nc\x94\x98\xc5\xc0K/u\xba\xdd\xed\xber\xef\xb4v\xe2z\xf2Vf\xf2\x9bw\x83\xc4\xe9h\xd3+\x8a\x1c\xde8\xf23\xc9\xcaG\x88\x98+\x85?庂\xdd\xdd\xed\xfe\x05\x01@k\x8d\xadR\t\xfbGg88<E)\xe2\u07bb\x92\x00\x93\x01PV\xd2\v&\xed\x12k\xdcxg\x04\x98\f\xef\x8b\x01\x98ԣ\xab\x15vKM\\I\xa4\u0088Qa\xb2\xab0S3\x92\f\xc4,O}\xd1D$\xd5F\xfb\x1bNj\xed\x8f0\xd1Ef\xbfy\xb7_>J\uef8b%\x877ʘ\xe6\xe1i\xe5\xa3\xe1c\x10\x00˅s;\x03\v0\x11A1\xa3\xdbu\xf1\xb1\x1b\xf7|\xf7^\xacf\x9c\x00\xb0!fv\x13\x17.\x13'0k\x05-\x8f2\x92\x89\x13Ȩ\u0088\x8d\xab\xe7\xf6\x03\x15fZ/\x8cLUat\x0e\xbd0\vWad\xee\xbd0\x99U\x18\x031\v*\x1f\x1dW\x1a\xbf\xd9hw\x7f$(\x1fi\x11\xbf\x8c7\xb5\xffe\x8a\xfb.R\xb9\xef\x8e9&\xf2\xbcY\xddw{\xc7\f5\"ǖ\xa6\"\xf3\xf5\\\x8d\x9d\xed2\x94\x7f\xd5\xf5\x17c\x02\x1c\xc7\xc2s/\xdc\xeeɭX\x12\xc0\xa4\x02\x94Mt\xe3M[B\xda`\x80I\xfaf\x187^,<N \xee\xb7t\xb5®\xddĕ\xed\xa8\n#3\xa90\x92D\x85\xa1\x82\xa90(f/L8LO\xcc\x1c\xcaG\x00\x84\x88\xbcf\xb7\xfb\xcaJ\xbd\xfdg]O.1\x93\xee\xab/\xfd\x95\xbd\x88\xee\xbb\xd1ek\x9a\xfbnTYI|L0\x17W\xbb(o9\xb0K6td\xbd\xd6Z\xb0Uvp\xfb\xde\x11N*5\x94\xcb%tۮ\x8f\xdc9\x01L:!Ÿ\xf1\xe6mb\x86u\x8f\x13\x98:}\x030E\x8a\x13\x18~5\x04AWl\xfc\x83\xf3\xb7\xc1\x12\xed\x85ѹ\xa90c{a\xb01*\f[\xd6·\xae\\{\xf6?fUa\x8c\x123\xe7\xf2љ_>\xba\xc4\f-\xe2/\xc1i\xcbG\xf9\xbb\xef&/\x1f\xf5{a&\x97\x86\x92\x94\x8f\xe2\xe6\xeby\x82r\xa9\x84\xb2cC{z\xe0ӆm)\xd4[m\xbcpk\x0f\xdb%\a\x1a:\xbf8\x01\x183;\x988\x01\x13'\xb0h\x80I\x8b{Kh\xe4\xed\xab06v\xed\x06\xae\x94\x8f\x8d\nS`\x15\xc6@\xcc\xfc\xcaG\xbf1T>\xe2Y\xcaG\xf9\xba\xefF\xa1'C\xf9Hb\xcaGHV>\x92\b\xe4\x101<Oc\xab\xec\xc0\xb6-\x88h0s\x84\xf6\x19\x16\x13\x9e{\xe16\x98\b\x046q\x02\v\x03\x98t\x8a\xcaj\x02̬\xef\x8d\x01\x98Y\xa4\xa8\"\xeeD\x1aTa\x14\x1e9?\xdc\v\xa3\xe7\xdf\v\x83\x8d\xea\x85\xf9/W\xae=\xfb\xfb\xb3\xa80\xa6\x9c\x94c\t\x89\x88\xbc\x8e\xeb>xRk\x7f\xb0\xeb\xc9KF\xcaGk\x12ޘ\xb8|\x04L\x9d\x8b\xa7=\x94-\a\xdb\xdb[h\xb5: ?Y)\xf8\x01\x8dR\xb9\x84\xeb\xb7\x0ePo\xb6a۪\xbfK\tƍw\x96\x85+\xcdj\xb3vq\x02\xc9_\x89\x01\x989)qE\x06\x98\xa8\n\xf3`\xf9\x04\xad\xae\x05\x12\rϨ0\xf9\xaa0\x9d\x13\\y\xe9\x9bz\x19I\xb3@\x8cQbf\x84\x97\xe0\x0f\xack\xcdγ\xc7\xd5\xd6=&\xbc\x84\x19\xdeH\xf9hM\xc2\x1b\x13\x97\x8f&\xce7`}\xcf7\xbc\xdb\xdd.\xc3\xf34@ܻ 4\be\xdb\xc6i\xb5\x86\x9b{G\xd8*9\x91\xad\xd6\x06`\x90\xd7\xf2m\xe2\x04\f\xc0,P\x89+:\xc0\x00\x82\x8e(<z~\x1fL.\xba\x11(\xd9x\x15\x86\x8a\xa7\xc2\x18\x88\x99\xbd|\x14f\x1f}G\xad\xd5\xf9=\xc5\x04\x00\x9e\x8c5\xaf[px#\xe6\x13ޘ\xa5|48_\xff\xb1\x86\x06\x13cgg\vZt\xff\x02\x0e.\bf\x82\b\xe1\xe3/܆m\xab`\xab\xb5\x89\x13\x98\xdf\xe2\xb5\\\x80Iy\xfd\x19\x80\x99\x8b\x12\xb7Yfvq*\xcc9\xbb\x81+=\x15Ƈ\x12\xa3\xc2D\xeep\x05\xe9\x851\xe5\xa4\x1c\xcaG\xf0\xddw\x7f\xa7\xddu\xbf8\x92}\xa4\xb2\x97\x8f\x90\xd9}7q\xf9\b\xf9\xb9\xef\xa6-\x1f\r\xcf7\xac\xb7\xed\xeel\xc3\xd3\xe2\v1^\xff\x82\x81P\xe0\xde{\a\x9d\xae\v\xa5\x94\x89\x13X\x1b\x80I\xfc\x01\xdf\x00̼\x94)l\xb6\x1b\xef\xf0q\xbe/LT\x85QsڑD\xf9\xa90\xb4r*\f[\xd6\xce__\xb9\xf6\xec\x1f\xe6\xa1\xc2\x18%&;\xc0\xe8\x8e\xeb>\xb4wZ\xbb\xebz\xf2\xc5A\xff\x8b\f\x98\xd7\x155\xbc1G\xf7\xdd\xd4\xe5\xa3\xe1\xf9\n\xa0!\xd8\xd9.\x83\x11\x91Y\x83\x8b@Cc\xab\xe4\xe0\U000382bd\xa3\xb3\x91\x92\xd2J\x00\xcc48\xc1\x9ay\xc1$\x06\x18\xe3ƛ'\xc0\x187\xde\xd9\x14\x98P\x85\xd9u\x86U\x98y\xf4\xc2 ?\x15\x06\tT\x18)\x98\n\xf3P~*\x8c\x81\x98t\xf0\xd2K\x9f\xae5;\x9f\x7f\\m\xdde\xa2\x87F\xfb_V0\xbcqF\xf7ݤ\xe5\xa3\xe8\\\xc2\x7fkW\a\xd1\x03<\xb2h\x13\x00\xa5\x14:\xae\x8bOܸ\x87\xb2c\xf5JJ\xc5\x01\x98Ĕ\xb2\xfefv\x06`r\x00\x98\x8cʊ\x01\x98\x99\xde??#I\xe1\xd1\xdd\xe1^\x98\xbc\xddy\x97\xa0\u00a0\x10*\x8c\x1b\xf4\xc2\xfc\xf5\x95\xab\xcf\xfe\x7fy\xa90\x06bR\x00L$}\xfa\xebk\xad\xce\x1f\x86\xe1\x8d21\xbcqԣe\xa4|$\xc3*I>\xee\xbb\xe3\x9duG\x95\x95<\xdcw\x87\xc1H\xc6\x1d#\x83[\xca]\xd18\xb7\xbb\x15\xf6\xf4\x0e\x00Lx\x159\x8e\xc2\xc7^\xbc\r-\x00\a)\u05cb\x01\x98d\x94b\xdcx\r\xc0d\xdf\xcf3\x9b\nc\x00&\x9f\xf3\xa0\xe7\v\xb3\xb5\x18\x15\x866O\x85\xb1\xe6\xa1\xc2\x18\x88I^>\x12\xf8\xfe/\xefj\xb4\xba?\xd9\xeb\x7f\x99wx#P\xcc\xf0Ʃ\xf3Mn\xd6\xe7{Ŕ\xc1ĽcB\x80\xa1\xa0of\xabT\u009d\xbd#\x9cTkpl\x1bг\x03L*@1n\xbc\xa9\x00&\xd1+Z\xeb8\x01,\xa4\x8cd\xf2\x90\xf29\x0fz*\xcc\xf9ũ0\xd8L\x15\xe6\x83y\xab0\x06b\xa6\xdf<{;\x90\x8e*\x8d_\xebz\xde\xdb\xfd\x1d3C\xfd/\xf3\no\xcc\xd1}7\xef\xf2\xd1\xe4\xf9\x8e/\x1f\r\xbf'\xda\xd3(\x95\x1d8\x8e\x03\x84.1\xd1\v\x15\x80\xad\x14\xea\xcd6^\xbc\xb5\x8frف\x84!\x05\x13\x01&A\x95Ǹ\xf1\xe6\x93H\x9d\xe8X\x13'`\x00f\xa6{\xf1\xdc\u0383\xae\xb7\xca*\x8cl\xb4\nc f:\xc0x\x9d\x8eK\xfb\xa7\xb5\xbf\xf1D\xdeB\xcca\x03/--\xbc1O\xf7\xdd\x19\xcbG\xd3\xe7;\by2\x049D\x8c\xae\xf6\xb0\xe58pl\x05\xed\xc9\x10\xc0\x84\x17\x01\xc3b\xc6G_\xb8\r\x8bR`\x8aq\xe3\xcd-N a\x1deu\x00f\xca3\x1a7\xde9*q\x05؉Իޤ\xbf#\tIT\x18]4\x15\x06\xab\xa2\xc2|\xe0\xca\xd5g\xff(o\x15\xc6@\xcc4\x80\xe9z\x0f\x9e4Z\x87D\xf4\xa9\x10x\x10\xb0\x00\x14_N\x99\xd6\xff\"1\xfe+9\x95\x8f\xc2\x13V\x92o\x8dλ|$\xd3\xcaG1\xf3\xf5\r\xef4\xec\x92\x03۱\xa1E\xa0x\b`\x88\x00\x01\xb6\xb6\x1cܸ}\x80z\xa3\r˲\x8d\x1bo&\x80\xc9$\xa1\xacM\x9c@\xb2\x85\xd7\x00L~\xaaT\xb1\x01\x06\xe2\xf7\u009c\x0fT\x98N\x12\x15F\x8c\n3M\x85\xa1\x05\xaa0\x06b\xe2/\x1a&\"\xaf\xde\xea|\xcaI\xbdy\x8f\x89\xee\x13\x81\xeb\xfb\xbf$)\x1fa\xa9\xe1\x8dsq\xdfͱ|4|\x8c\xa7\x05\xb6R\xd8.\x97!:\fy\xa4\x81E]\xa0\xe1\xd86N\xabu\xdc\xda?\xc2֖\xe3\x1fk\x00&\xf3\x12c\xe2\x04\f\xc0lZ\x9c\xc0\xf0[\x11\xfa\xc2<r~\x0f \x0f]-\xd0F\x85\x99Y\x85A\xbc\n\xf3\xc7\xf3Pa\f\xc4\xc4+0\xba\xdal\xbf\xbe\xd2l\x7f\x88\x89 \x02\x0f\x80\x95\xbc|4'\xf7\xdd\f\xe5#\x89\ro\xcc\xc9}7C\xf9hx\xbe\x02@\x8b\x7f\x16\xee씠\xc5\x0f~\x8c.\xea\xe1\x85\x12\x86C~\xfc\xc5;p,\x05\xd9$\x80ɲ|\x9b8\x01\x030&N`\xe2\xfb\xd2WaN\xd1\xe9*Ј\xca2N\x85\xd1+\xa8\xc2\xc8\x12U\x98Ϝ\x9b\n\x03\xe3\xd8;p\xd1\x10\x11y\xd5F\xfb\xd9Z\xab\xf3{\x163\x02\xd0Vy\x867\"O\xf7\xdd%\x867f\v\xab\x8c\x01A큉\xb0\xbd]\x86\xa7u\xff\x02\x8f\x00\x8c\x7fM\b\x1c\xc7\xc1\xf37\xef\xf9\uef6c6\"N\x00X\xfd8\x01Iw\x1d\x1a\x80\x99\x8b\x12\xb7\xd9n\xbc\xc3\x0fFU\x18N\xd1\v#\x99\xdcy\x97\xab\xc2`\x19*\x8ceY;\x7fu\xe5\xea3sSa\x8c\x123\b0Rm\xb6\xdfZkw~O\xf5\x00F8\xef\xf0\xc6\xe8\x96\xe7\x99\xddw\x97\x19ޘp\x8b\xf5@\xf9HƘ\xf5\x11c\xab\xbc\x05\x11/\x16`\b\x80\xd6\xc0V\xc9\xc6\xc1\xf1\x19\xf6\x8e\xceP.\x8d\x96\x94\x8a\t0\x93\xc7\xfay\xc1\x98<\xa4<\x01&3\xa6\x187މoC!T\x182*\x8c\x81\x98<\x01\xa6\xd1\xfe\xbaZ\xab\xf3ˊ\x18\xe8\x01\xcc|\xc3\x1b%\xd2@\xbbjፘ\xd8\xff\x82\xc9\xf3\x1dzO\xb4\xe7agw\v\f\xf6k\xd54\\*\xf2/\x10\xc5\x04\xd7\xf3\xf0\xfc\xcd{(\x97\xac\x81\x92\xd2r\x00&\xc9\xd8$3\xbbQ\x05Ƹ\xf1\xce\x060\xc6\xcc.\xfb90\xfe\x8a\x9c\xa5\x17f\x92\n\xa3\xd3\xf5\xc2`\xba\nC\xab\xab\xc2\xc0\xb6v\xfe\xf3\x95\xabϼg\x9e*\x8c\x81\x18\xff\x8f \xd5f\xfb\x1bk\xad\xceO+\xdf6VF\x01f\x16\xf7\xddd\xc6tsu\xdf\x1dӘ+\x91\x9dE\xb3\xba\xef\xca\xd8\xf2\xd5\xf4\xddVZk\xecn\x95\"\xd9I\xa3\x00\x13\xe0=lK\xe1\xe3/ށh\x80\x89\x87Pa\x81q\x02\x80q\xe35%$\x13'\xb0`\x80\xc9C\x99\xf2U\x98:\xael\x1d\x8f\xa80z&\x15\x06\xb9\xab0X\xb0\nC9\xaa0\x17\x17\xa0\xc2l4Ĉ\b\x01\xc0Y\xb3\xfd\xcfk\xcd\xce\x0f*f\xff\xcf,B2\x0f\xf7ݙ\xcaG\xf9\xbb\xef\xe6[>\x1a7_L\x99\xaf\xff\x03\xae\x16lm\x97\x01\x96\xb1\x00CD\xd0Z\xfb\xee\xbd\a\xc78\xae\xd4\xe0864e\x03\x98$\x80b\xdcx3\x00L\xfa\xebpu\x01&\xed\x92j\xdcxg\x06\x98Y\xcf\x03\x02\xe0\n\xe3\xea\x85}\x80dD\x85\x91$*\xcc\x10謓\n\x83|U\x98\xf7\xce[\x85\xd9X\x88\tKHg\x8d\xf674\x9a\x9d\x7f\x1d\x02\f\x044P>\x92\x98\xf2\x11Lx#\x12n\x9f\x1e\x04#\x19\xbb\r]k\x8d\xad\xad2\x14)\x88\xe8X\x80\t/\x1d˶\xd0l\xb6q\xe3\x8e\xef\xde\v\xadc\x00&\xa1\x86b\xdcxS\x01L\x8a\xb5z-\x00&\xe9\v3n\xbc(d\x9c@\xdc!]\xcd8o\xd7py{\x06\x15&z\xacQaƩ0\u07fc\b\x15f#!&\xccB:k\xb4\xbf\xa6\xd1\xea\xfcH<\xc0L(\x1f\xe5龻\xc2\xe1\x8d\xd3\xcaGS\xb7X\x87\x8f\x89\xe1y\x1a\x8em\xc1q,\xf8V1\xf1\x00\x13^8\x96R\xf8\xd8\xf5;\xe0ȅ6\x82(ƍw\xb6\x85+\xf1:\xbfZyH\x99\xcb\x11ƍ7Y\xa8g\x81\x958\xbf\x17\x86q\xf5\xc2! F\x85\x99\xa3\n\xf3\x17W\xae>\xf3\xbeE\xa80\x1b\a1\xa1\x0fL\xa5\xd1~s\xa3\xd5\xf9y\x7f\x17R\x1c\xc0\xa4,\x1f\xe5龻B\xe1\x8dI\xcbG\x93zd\x18\x02O{p\x1c\xc7w\xe2\x8d\xdcy\xe2\x00FDP.ٸq\xfb\x00\x8df߽\x17&N \xf5\u0095\x86X\xd6#N\x00ƍw\x9eJ\\Aw\"\r\xaa0\xcd\\T\x18\xbd\x82*\x8c\xacY/\xcc\xc6AL\xe8\xc4[i\xb4\xffa\xad\xd5y\xb7\x1f\xe4(Z\x10\x00\x8c\to\x9c\xd9}wR\xf9h4\x96 \x029\x9e\x86\xed\u0601\x12\xa3{\x9fD\x86\x01&\xbc\xda\x1d\xc7F\xa5\xde\xc0\xed\xfd#\x94ˎ\xff3\x1b\r0\xb3\xc8\U000eb747\x94|\xe15q\x02\xb3\x00\xe0\xaan\xa5\x1eTa\x14\xae^8\x18\xa3\xc2\xe8T*\x8c$Qa\xa8X*\f\xd6P\x85\xd9\x18\x88\t\x15\x98\xb3F\xfb\xa9Z\xab\xf3\x1e\xc5\x14l\xa3\x06c\x83\xc3\x1b\x91\xb3\xfbn\xa2\xf2\x91\f\x86GB\x00O\x00\xa5\xd8O\xa8\x16\rf\x8e\x05\x98\xf0\xa2a\x7f\x1b<>q\xfd\x0elK\xad}\t)\xcd\x12\xb3\xbeq\x02\xb3\xc0\x9d\x89\x13H\x8d(k\xe0\x05\x13\xfdbW[ST\x18\xe4\xaf\xc2`#U\x98\x85\xf5\xc2l\fĄ\nL\xbb\xdb}\xa2\xde\xea\xfc\xd5(\xc0lnxc\x92-\xd6\xe3\xe7\x9bd\xfb\xf4\x94\x1dY\xbdr\x99\a\"`{\xab\x8cпn\x1c\xc0\x10\xfc@\xc8r\xc9\xc2\v7\xf7Ѝ\xb8\xf7\xae&\xc0̺P\x9b8\x81\xcd\x06\x98\xec2\xca:\x9b\xd9E\xbfH\x10t\x85rWat\xa0\xc2`\x85T\x18\x99\x9b\nӅm\xed\xbe\xff\xca\xd5g\xfed\x91*\xcc\xdaCL\x000\xba\xdd\xf5v\x8fk\xad\xbfV\xecg!\x05\xad\x183\xbb\xef\xe6]>Zfx\xa3\xa4-\x1fŹ\xefb\x8a\x8f\xce\x18%\xc9\xdf`\xc4(\x97K\x90\xa0\x9c4\x16`\x82@ȒS\xc2\xe1I\x05\aǾ{\xaf_RZ\r\x80I#\xe8\x1b/\x18\x030i\xce\x1f\xe3\xc6;\xf8E\x06\xd0\xd16\xce%Rat*\x15F\x02\x15\x06+\xa4\xc2 \x89\nC\x19T\x98\xce).<\xf0\xe9\v\xed\x85Y{\x88\t\x01\x06\x00\x8e+\x8d\xbfb\xd0V\x10\xe6\xa8\xe6ᾋ9\x847\xa2\xe0\xe1\x8d\xd3\xcae\x83`4y\xb7\x15\x81\xb0\xb5U\xeaK\x9dc\x00&\xbc\xb0\x94b\xb8\xae\x87\x17n\xee\xc1q\xach\xf1{\xe1\x00\x93i\xd9\xd9\x103\xbb\xdeM\xd8\x00L撏q\xe3ͮLi\x00\xae\x10\xae]\xd8O\xa0\u0088Qaz\xe5\xa4i*L\xf0}&W\xbb-\x94\xb7\xae\xfc\xf9\x03מ]\xb8\n\xb3\xb6\x10\x13\xf8\xc0h\x0088\xab\xff\x1e@O\bB\x80IX>\xca\xd3}7c\xf9HF\xc0h\x8e\ueef9\x94\x8f\xc6\xcd\x17S\xe6\xeb\xdf\x14\xb6\xb7\xb7\"\xe02\x1e`\xc2@H۶\xf0\xf1\x1bw\x00\x01\x98T\xf4sF.\x00\x93t\x187\xde\xd9\x16\xaeM\x03\x9845E\x030\xb3)T]m\xe1\x82\xdd\xc4\xe5\xedHF\x926*\xcc\xec*L\xf8\\ly^\r\x97\xaf=\xb3\x14\x15f\xed\xcbI{\xa7\xb5\x1f\xec\xba\u07b3\xc4А\x10`f\voĒ\xc2\x1b\xa5\x88\xe1\x8d\x18u\xdfM\xe2\xd0\x1b\xd7,\xadE\xa3\\*\x81\x82\xf0\xa4I\x00C \x88\xf8\x81\x90\xf7\xf6OqZ\xa9\xa3\xe4XФ\xd3\x01\xcc48\x81q\xe35=0s\x9a\xaf\x01\x989(S\xa3_t\x85|w^\xd1}\x15F\x8c\n\x93\x8f\n\xa3\\\xed6\xb1\xb5\xfd\xd2?=\x7f\xffk\xfet\x19*\xccZBL\xcf̮\xde\xfa\x86\xae\xa7\xbf\x91\x99E\x04\x9cW\xf9h\xae\ueeeb\x16\xde8\xb6|\x84\xf11\fc\x8eў`k\xbb\x04\x80\xa7\x02Lx!\xdaJ\xa1\xd9n\xe3\xfa\xdd}8%\x1b\xa2)C\xf4\x91q\xe3M\n0)\xaf\xc3\xd5\x05\x98\xb4Hb\xdcxgV\xe2\x90\xdbyПiW[\xb8Pj\xe2\x8aQa\xe6\xa4\xc2\xc0\xf2\xbc\x1a\xee\x7f\xe4\x8dKSa\xd6\x0eb\u00adԧ\xb5\xd63\xb5V\xe7G,\xdf\xccnn\xe1\x8db\xc2\x1b3\x96\xcbdh\xbe\x04O{(9\x0e,\xc5Т\xa7\x02\x8c\xbfך\xa1\x14\xe3\xf9\x1bw\xc0L\xc9\x1b{\r\xc0dZ\xc2M\x9c\x80\x01\x98b+q\xfd\x99R\xa8\u009cۇx\xcbSa(W\x15F\x8a\xa5\xc2xu\xec\xec\xbe\xec/\xcf_~\xed\x9f/K\x85Y+\x88\t\x00\xc6ku\xba/\xaf\xb7;\xbf\xdfs㍚ٍ-\x1f\x99\xf0Ƭ\xe1\x8dY\xcbG\xd1\xf9\x12ix\x9e\xc0qlض\xf2\xbb\xf1h2\xc0\x10\x01\xa25\xb6J6n\xde=D\xa3\xde\xf6=c\f\xc0L^\xb8\xb2\x88\xf6\xab\x9a\x87\x94\bƌ\x1boR\x80)\xb6\x1278\xd3\x01\x15\xc6[\x9e\n\x83\\U\x18\x14F\x85a\x88\x88\xe7\xc2;\xf7\xb97\xe1\xe7\xdf\xd9\xcbZ\xfb\xd7\x02b\x82F^\x0f\x00\x8e*\xad\xf7\a\x00\xe3\x01\xa0\xe2\x847b-\xc3\x1b\xb3\x96\x8f\x06 \x0f\f\xad}\xd7^˲\xa0!\xe1g\x80\xfe\xa7\x99!\x80\te\x1a۶Q\xa96p\xf7\xe0\x04\xe5\xb2\r\xad\xb5\x89\x13H!\xa7\xac~\x1eR:\xe9\xc8\x00L\xf6*a\x91\xb7RG\xbf\x15\xaa0\x8f\x1a\x15f.*\fHAQ\x13-\xba\x8a\x06]\xa5e\xaf\xff+\x0f1a\"5\x00\xdc;\xa9\xfd\x01\x11\xee\x17\x11Wz[\xa9\x8b\x12\xde8?\xf7\xdd|\xcaG\xd9\xdcwGKCI\xcaG\xa3 \xe8i\r\xcbR\xb0\x1d\v\x10\r\x10\x0f|*\x18\x06\x98\x10\x1e\x14\x13\x04\xc0\xf37\xef\xc0\xb6\xac\xb5\x05\x98\xf4k\xf7\x9a\xc5\t`\xf62\x92\x01\x98\xec5\xb5U\x02\x98P\x85\xb9\x9c\x8b\n\xa3\x8d\n3\xac\u0090\x80H\xa3\xa2\x9e\x04\xc1\x93\xc8\xcd\xd5@L\xc6A\xbe\x02\xd3|g\xd7\xf3\xbe\x80\bZ\x00˄7\xa6-\x1f\xe5\xe3\xbe\vI\xe1\xa33\x00F\x02fF\xa9T\xf2\x93\xacY\xa6\x02\f\x05\xaf\xa0\xec\xd8x\xf1\xd6\x1e\xda]\x0f\x8ay5\x01&\xed\xa7`\x13'`\x00fFuj\x1d\xcc\xec\xe2\xbe\xe5\xea<U\x18\x18\x15fH\x85a4ЦGѢ\x87a\xa1\xb9t\x00Xi\x88\t\x1byO\xea\xad/iv\xba\xdf\xee7\xf2\x06n\xbc&\xbc1\xd7\xf0Ƥ\xee\xbb2q\xbe2f\xbb8|p!B\xa9\xec\x04N\x9b<\x19`\x82\xbb\x85h\xa0T\xb2qtZ\xc1qĽw\xe5\xf2\x90\x8c\x17\x8c\x01\x98\x9cR>7ō7\xee[]m\xe1b9\x81\n\xa3WO\x85\x91\xb4*\x8c\xccG\x85a\x12T\xd5S \xf1\n\xc1\x01+\v1\xfdF^\xf7\xe1Z\xa3\xfd[~*u\xffO\x9bO\xf9\b&\xbc1\xba}Z\x92\xb9\xefNܑ5v\xbe\x1a``\xabT\x82\xf6d:\xc0D>\xe9(V\xe8\xba\x1e^\xb8}\x17\x8ec\xf5v',\x1a`\xf2S\x1a\f\xc0l\x02\xc0\xe4ĸ\x06`\"\x97\xba\xab\x83\x1dI\xd3|a$\x81\n\xa3\x8b\xd5\v\x83\xb4*\ffSah\x8c\n\xd3\xe2GѤ\x87\xc1hBc\xe9-1\xab\t1\xd1F\xde\xc3J\xe3OX1\xe0g\"Q\xbe\xe1\x8dR\xf0\xf0F,%\xbcQdJihh\xbeS\x8f\x89\xd0N\xa9䌤\xacN\x02\x98\xf0\x93\x85m\xdbx\xe1\xc6\x1e\xb4\x00*t\xef5q\x02K\a\x18\xd9X\x80\x99\x05dM\x9c@Z1*셹\xe8$p\xe7M\xaa\xc2DKJ\x1b\xa8\xc2 F\x85Q$\xa8ғ`\xb8\xd1;\xa7\x81\x98\xb4#\xd2\xc8\xfb\xab\x00]#\x1f`\xd4\xe6\x857\xce\xd3}7a\xf9Hb\xcaGS݆\xe3\x8f\xd1Z\xb0\xb5U\x06A\x06\x14\x8fi\x00#Z\xb0\xe5ظ{p\x84J\xa5\x0eǶ\xa0\xc3}\xda&N`\xa9\n\f6\b`\x12\xfd\xc1\f\xc0\xe4\xd2p\x1c\xf7\f\xae\xeb\xbb\xf3N\xed\x85Ye\x15F\x96\xab\xc24\xf8\x1aZ\xf40\x18\xed\xc2\xe0\xc3\xcaA\x8c\x88(\x008\xae5\xbf\xa9\xe3z_\xce\x04-\x10\xb5\xe9\xe1\x8d\xf1\xf3]Lx\xe3H\xf9Hb\xcae\xd3|t\x82\xbe\x98R\xc9\x011'\x06\x98\xf0R\xb4m\x85f\xab\x8b\x9b\xf7\xf6Q*Y\x13\xef\x93\xc6\xcc.;\xc0\xcc\xc6-&N\xc0\x00L\xd6\xebcr\x19\xa9\xeb\xf5{aZ^\x9e*\x8c\x14K\x85\xc1\xb2T\x18\x0f\x8a\x045z\x1d\bޜ\xae\xa0\r\x80\x98\xb0\x0f\xa6\xd6\uef26\xd6\xe8\xfc\x1b\x8b\x19\x02\xa1<\xdcw\xe7\x11ވ%\x857ʂ\xc3\x1b\x13\x95\x8fd\xb4|4\xf8\x1e\x11\xb4vQ\xderz\xefWR\x80\xf1\xbf\xcfP\x96\xc2\xf3\xd7\uf049{\xa7\xb6q\xe3\xcd\x17`\xd6!\x91:1\x92\x187\xde\xfc\x948\xccz\x1e\xc8\xc4o\x85\x19I\xe2\xe9\x18\x10\x99E\x85\x91\xe9*\fm\x82\n\xd3D\x83\x1fE\x8b\x1e\x01\xa3U\x882R8,\xacȈ\xf6\xc1\x9cTZ\xbfϊ!\x10-\xd2\a\xb1i壑c\x12\xec,\xcatL\x8cj\x82$\xee\xbb\x03\xf7\xcfɹFH\x91}\x14\xfb\x9e\f=NR>\x1a{\xccĹ$<F4\xb4\x06,\xcb\x01\x0f(1I\x00\x86 \xa4\xb1\xe5X\xb8\xb5w\x80F\xbb\r\xcbR\x10\xad\r\xc0\xe4{\r\x16\x1a`\xf2)\xf4\x18\x80)\x9e\x127\x1e`H\x06{a\x9a\x1d\x05\x04\xeaJ6\x15Fҫ0H\xa2\xc2\xd0\n\xab0\x02E\x18Ra\x8a\x031+\xa3Ą}0w\x8fk\xbf\f\xc8\x03\x04qC\x80Y\x85\xf0F\x99V\x1a\x1aW>\x9awxc\x9e\xee\xbb)\xcbG\xd1c\x88\x19\x9e\xa7a\xdb\n\xb6c\x03ZO\x06\x98\xa1X\x02h\xbf\xb9\xb7Rk\xe0\xee\xde\t\xb6J6\xb4h\x13'\x90\x93\nc\xe2\x04\xd6\x17`\x8a\r\xb22\xed[9\xab0zN*\fVX\x85\xa9\a\xbd0W\xc1h\x16\x0e\x1bV\x02b\xc2>\x98\xa3J\xf3\xeb:\x9e\xfbV&\x82\x88\xaf\"\xcd\x12\xde\b\x13ޘ\xaf\xfb\xee\x84\xf9N\xf3\xd1\xf1\x7fFö-XJ\x05\xac\x1f\xf9\x843\f0\xd1\v1\xb8\xe8\x98\x19\x10\xe0\xc5[waYV\xe2\x92\x12L\x9c@\x01\x01&\x9d\x94b\x00f\xfd\xe3\x04\x86\xbf5\xbc#\xa9\xe5)\xff\xfe4U\x85\xd1Т\x83\xef\x1b\x15fz/\fP\xa3'c{a\x8a\x00\x10\x85\x87\x18\x11a\"\xf2\xea\xcd\xce\xe3\xb5f\xfb\xa7\xfd>\x98p=\x9d-\xbcq\xf2\xae\x99\r\x0eo\x94\x98\xf0F\xa4pߍ\x99\xaf\x8c\xb8\xfa\x8e\xce\xd7\xd3\x1aJ)ض\x82\x16\x80{\xa1\xd4\xe3\x01&\xac)Q\U0003c952\x83\x17o\xef\xa3\xebv\x83dk\x13'P$\x80I3[\xe3\xc6;G%nE\xbd`\x86\xbf\x98M\x85\xc1\xc0\xd7bU\x18\xed\xab0\xb4\x82*\f\xe5\xde\vs-\xe8\x85\x19Taft\xa4\xd8\f\x88\t\xfa`4\xfc\xddH~\x1f\x8c@\v@y\x877\x8a\to\x9c|L\n\xf7\xdd\xe9\xf3\xc5\xd8\xf9\x123\x1c\xa7\x04@C\x98\x92\x03L\xf0\x1aJ\x8e\x8d\xe3\xd33\x1c\x1cU\xb1\xe58S\xb7Z\x9b8\x81\xc5\x02\x8cq\xe3\xcd\x0e0)\xaa\x84k\x0f0\xbd\x8c\xa4\x89*\x8cdWa\xc4Wa\xb0\x82*\frWa\x9e\x02\xc1\x1d\xf8\x8bP\xd0\x16S\x84=J\x85\x86\x98~\x1fL\xf5\xff\x11\x91\xc7\t\xf0\xfcX\x01\x13ވ\x02\x857\xc6\xcfwz\xb9ld\x8bu\xf0\xa9\xc5)9\x10\xedG\x0f$\x05\x98^ \xa4b\xb8Z\xe3Ɲ=8\x8e5p\xd33q\x02\x06`\x8a\x060y+q\x9b\x000Q\x15\xe6щ*\x8cLUa\xf4\x88\n#F\x85\x19Qa\x1e.d/L\xe1!FD,\xf8}0on\xbb\xde?a\xe6\xa07Ƅ7\xe6龛Kxc\x8c\xfb\xee\xa0\xfa\x83\xb1 80_\xcf\x03@(9\x96\xff\xf3)\x01&\xbc0\x1de\xe1\xc5\xdbw\x03(R\xb1ڧ\x89\x130\x00\xb3\x10\x80Y\xa0\x12\xb7)\x00\xc3\x00:\xda\xc2\x05\xa7>\xb3\n##*\x8c^=\x15\x061*\f\xcdQ\x85)\x189\x14\x12b\x82>\x18\xb7\xd9q˕f\xfb?(\x1f`\xb4\to\x9c\xe6\xbe+\xb3\xb9\xef\xe6T>\x1a9f\xea|\xfb\xc7h\x02J\xe52@2\x15`\x88F\x01FD\xa3Trpo\xff\f\xa7U߽\x17\x91\x92Rf01n\xbc\x06`fV\xe2L\x9c@b\x99e\xc2\xd0\x00\\a<z\xe1 \xa3\n\xa3Ǩ0+\xda\v#1*\f\x12\xa80\x94^\x85)J\x1fL\xe1!&\xec\x839\xaa6ޭ\b\x16D\xbc\x80\x8dMxc\x92\xf2Q\x9e\xee\xbb3\x96\x8f\x06{o\xc6\xcd7rqz\x02Ǳ\x01\xe1\xde\x0e\x04P<\xc0\xf4\xf0&\xfa\xc9\b\x80\xad,\xb4\xbbmܾw\x80r\xa8\xeaLP]\x8c\x99]\xba\x85+?ej5\x00&\x9f\xf9\x1a\x80ɤL\xc5|\xb1\xad-\\rj\xb8\xbc}\x96Q\x85\x911*\xcc|{a\xa2\xbfg\xae\xbd0IU\x18\x8cWa(F\x85)\"\xc0\x14\x12b\xc2\xedԇg\x8d\xafw]\xfd\x85D$\x02(\x13\xde8c\xf9(O\xf7\xdd\xcc\xe5\xa3\xc9\xe52\x12\x82\a\r\xa7\xe4\x80H@,#e\xa3x\x80\x89\xd6x\t`?\x04\xf2\xc5\x1b\xf7\xfc\b\x03b\x030\xf3X\xa8M\x9c\x80\x01\x18\xc9z\xf2\xa4/#\x85\x0f\xb50\xae\xae\xa0\n\x13\x9d\xc3\\{afTa\x14\x9ah\xf3c\x03*LQ\x01\xa6p\x10\x13n\xa7n\xb4\xbb\x0fW\x9a\xed\x9fTL&\xbcq\x0e\xe1\x8d\x03Q\xee9\x857f-\x1fE\xe7\xabIC\xbb\xbe\x12\xc3 \x7f\x1b\xda\x10lL\x06\x18\xf4\x02!K%\v\xb7\xf6\x0e\xd1h\xb5a)e\x00\xa6p\xe5\x83\xfc\x01&\xf1*j\xdcx\xe7\xa0\xc4Ϳ\x91\x17\x00\\m\xe1R9?\x15F\xe7\xa8\xc2\xd0ڨ0\x84*=\xd9Sa\x8a\f0\x85\x82\x98\xe8v\xea\xfd\xd3\xfao*\xc5\x10\xc0\x83\x80\xd6:\xbc1O\xf7\xdd\f\xe5#\xc9)\xbcq\xea{2\xae|\x14\x9d\xaff\x88\b,\xdb\x02+\x95\t`\xc2>\x1a۶Q\xaf5\xb1wp\x8c\x92c\x8fܠM\x9c\xc0\xaa\x02L\xdae\xd4\x00Lq\x95\xb8t\n\x8c\x04\xbd0\xd7\xce\xe5\xa7\xc2H\x8e*\f\xd6D\x85iEza(\xd8!Z\xe4\xc1E{-{'\xd5\xffCC^\x17l\xa7Vk\x1fޘ\xa7\xfb\xee\\\xcaG\xe9\xddw\x93l\xb1\xee\xf7\xd5\xf4\xcbe\xc4\b\f\xef,(\x9b\xa1u6\x80!\x00\xcc\fM\x82\x17o\xdd\v\x9a{W\x14`\xb2|\x12^\xb38\x81$Dg\x00f6\x80)\xf2V\xea0\xa9'Ta\xeeK\xa3\xc2\xe8UTa\xa4\x10*\fA\n\x0f0\x85\x81\x980\x9d\xba\xd2h\xbf\xa6\xd6v\xff\xa5E\f?\x17)\xb9\xfb\xeehF\xd1l\xe5\xa3Ż\xef\xca@\xff\x8b\xe4律\x18\xf7\xddT\xe5\xa3l\xee\xbb#ۧ\x93\x86U\x8a\xc0\xb2\x15,\xb6\x02G\xa5)\x00C1[\xb1\xc9/C\x96m\a7\xef\x1d\xa0\xebz\xbdPI\x13'\xb0l\x80I'\xa5\x187\xde\xecq\x02\xeb\x020\x98E\x85\x91UTa\xb0t\x15F\xa19\xd0Kh fz\x19\xc9\x03\x80\xa3j\xf37\x94\x1f+\xa0\x01\xa1\"\x857b\xd9\xe1\x8dy\xba\xefΫ|\x14;_I0\xdf>\x88yZC\xb1\x05e+\xff\xc6\x12\xf9\x04\x14\v0\x18\x05\x18\x00\x80\xd6(\x95l\x1c\x1dWpx|ꗔ\xe6\xbapM\x01\x98\xa9Ϲ\xda[\xa9\x93\x03\x8cq㝫\x12\xb7Ffv\x12Y\xa4:\xda¥rը0\xa9T\x18ʠ\xc2<\x05\x86\xdb\xfb\x009i\x90\xc9N\x1a|\r\xf7N\xaa\xef\x12\x91G\t\xf0\x00ᢅ7\n\x10\xb3KȄ7N-\x1f\t\x86\xcaZ\xd3@\xd0\xf7\xb4v\x1c\x1b\x02\x013\x0f)1\x93\x01&\xfc4BA \xa4\xa7\x057\xee\x1c\xa0\xe4\u0600H~\vW\x0e*\x83\x01\x18\x030)\xab\x84\x1b\a0\b|a\xb4\x00\xd7\xce\x1d\x1a\x15&\x95\n\x83T*L\x93\x1fC\x8b^\n\xa6\xe6\xaadC/\x1fb\xc22R\xb5\xd1~m\xad\xe5\xbe\xddb\x82\x88\xa8\xa4\xe1\x8dXRx\xe3\xb0[b\xf1\xc2\x1b\xb1\xf0\xf0\xc6$\xe5#\x99:\xdf`\x86D\xb0m\xbb\xff\t&\x05\xc0\f\x94\x8cD`[\x16\xae߾\xe7_\xf8̙\x17\xae\xe9\v\xeff\x9a\xd9\x19\x80\x99\x1dq\x8d\x1b\xef\xf8\x87\xbe\n\xa3p\xa9\\7*̜U\x98\x1a=\t&7\xd1Y\xd4\xff\xb8\xb8\xc1\x10\x13-#\x1dV\x9b\xbff+\x82\x16\xe84\xe5\xa3e\xba\xef\"j\x1f\x9d\x97\xfbnn\xe1\x8d\xf9\xb9\xefΧ|\x84\xb1\xcdҞh\x90\x10l\xc7\xf1O\xd0^/KJ\x80\t\xc2\xc9J%\v\xfbG\xa78\xad\xd4a[\x16\xb4\xceAH1\x00c\x00&;\xe3\x1a\x80I\xf1\xa6j\btQza\"\x99D\xeb\xa6´\xe81\xb4饉2\x92(\x8a\x0e\x1b\x9eb헑\x8e\xabߣE?&\x02\x0f\x00g-\x1fI\x91\xc2\x1b\xf3t\xdf]\x91\xf0\xc6t\xe5#\x89\xdfb\x1d\xa4@j\x02l\xc7\x02\x89ߠ\x9b\x05`z[\xad-\x85V\xbb\x83;\xf7\x0eQv\xc2\b\x82\xbc>}o.\xc0\xa4Y\xceM\x9c\x80\x01\x98,o+A\xd0ьK\xe5*.oW\x96\xaf\u0084v\x9ak\xa3\xc2P\xbf\x17F=\tJ\xa0\xc2P\xef\x13\xa2\xf6\u07fb\x02\xc4X/\x05b\xfae\xa4֧T[\xddo\xb5\xfc.h5\xdf\xf0F,7\xbc1O\xf7\xdd\"\x867f,\x1fI\xdc|=\xed+1$\xe0 \xf3}\"\xc0\x04\x9f\x9c\x86\x01\x86\x82\xd63\xa5\x18\xd7o큈{W\xb4\x01\x98Y\xd1$\xc9:e\xe2\x04L\x9c@r\xbe\x91\x98o\x8bX\xb8v\xfe\x10\x9e\xa7\xe1\x19\x15&g\x15\x86\x83\x1dI\xc9T\x18\x8aJ/\x05\x01\x18\x00\xb0\x96YF:\xa84\x7f\xd5R\f\rh\x88\xb0\x8cܠ\xc7+\rI\xcbG\x18w\xcc\xf0\r/\x81\xfa2VY\x88\xdb*\x9cd;q\\\x8f\xcc\xd0\xe3dፘ2\xdf\xd1\xf9'\x9b\xef\x98cb~&\xfd|c\x8e\xf1\x9d\r\xa1\xe1\x9b\xd5\xf9\x9fh0\x1d`\xc6)1\x80\x1f\bi;\xb8sp\x88F\xab\x05K)x\x9eNt\x1b\xde4\x80Y\xed8\x81\xf4wT\xe3ƛ\xa3\x12\x97c\x1fL\xa8´\xb5\x85\x8b\xe5\x1a.o\x9d\xa2\xdeQ\xa0\b\x94\x18\x15&G\x15\x86\x9f\x8cd$\xd1t\x801\x01\x90\xfe[p\xe7\xb8\xfaN-\xf2\n\x02\xbc(\xc0,<\xbc\x11E\to\x94B\x867N\x9f/Ɨ\x86\x92\x94\x8f\x86}l\x98!ZC\xd9j\xc0\xd3%\v\xc0\x84\x17\xb3c[\xa8\xd5[\xd8;<\x81\xe3X\xd0\xfd<\xf4\xb5\x06\x98LJ\xc3*\xe7!\x193\xbb\x02)q\xd9\x01\xa6\xafF3\xae\x9d?\x80\xe7\xc1\xa80KTa\x8a\f0\v\x87\x98 \x1bIW\x9b\xadW\xd6[\xddo\xb7\x98!C\x00\xb3\xf0\xf0\xc6<\xddw\xd70\xbc1\xc9{\x12羋1ۧc\xcbGCϫ\xb5\x86\xa5,\xb0\xc53\x03\fQ\xe8\xd9D\xb8q{\x0f\x16\xab\x81\xbe\x98\xe2\x03\xccL\xd7\xdb\n\x01̌K\xaa\x01\x98\x02\x81\xecl\x00C\x81/L\xa8´<\x052\xbd0\x99T\x18J\xa5¬\x1e\xc0,\xbc\x9c\xd4\xcbF:k\xfe\x92\"\x82\x16q\x01X\x93\xca\x1e\xd1\x1b\xf1\xb4\xf2\xd1\xc81YJ%\xe3\xcaGcT\x04\xa4q\xa3ͩ|4\xf6\x98\x99绀\xf2\xd1Ĺ\xf8\xff\xd2\"`K\xf9\x1e13\x02\f\x00\x900\x1c\xc7\u00ad;\a\xe8v\xddގ\xa7\"\x01L\xa2\xa5\xd4\xc4\t\x18\x80Y\xb38\x81q?+\xc2x4\xa2\xc2H.*\x8c\xf4U\x18\x9e\x8f\n3P-(\x80\n\x83\xb1*L\r-~\x19\xda\xf4R(Tc\xf5\x8cU\x00\x98\x85*1\"b\x01\xc0\xdeY\xfdmZ\xeb\xd7\x06\xce\xf0\xd6\xe4\xf2Q\xfe\ueed9\xcbGy\xba\xef\xceX>Z\x88\xfb\xee\xbc\xcaG1\xf3\x1d\x06A\xad\x01V\x16,\xa5\xa0E\xcf\x060D\xd0\xd0(\xd9\x16N\xaa5\x1c\x9fUPrl蘽\xd6\v\x8d\x13H\xf4\x14k\x000b\xcc\xecL\x9c@r\x80\xa1\x9e;o2\x15F\xa7Ra\xf4D\x15\x866N\x85yj\xac\n\xb3*\x00\xb30\x88\t\xcaHn\xbdվxVk\xfep\x10-`\xc2\x1bs\bo\x94\x82\x847\xa6+\x1f\r\xed\x9c\x1a\x9e\xafxP\x8a\xc0J!\xdcc\x9d\x15`\xc2k\x90\x83\x86\xde[\xf7\x0ea[*?\x80\x99\xba8l\x82\x17\x8c\xa4\xda]k\x00&\x03ޮ\xa9\x99]\xdc\xf5%\x1e\xe3څ\x03\xe8\x04\xbd02Q\x85\xd1\xf1*̘^\x18\xacY/\f&\xf6\xc2<\x8e6\xbd$\xb6\x17f\x95\x00fa\x10\x13\x96\x91\xf6N\x1b\xbf\xc0\xfe\xbb\xec\x89\xf8vd3\x877\xa2x\xe1\x8d\xd8\xc0\xf0\xc68\xaf\x9d\xf8\xb9$yO\x00\x91\xc0\xb57\xa8\x91\xcf\x020\xe1\xcfڶ\u008d;\xfbAb6\xa7\\\xa8SI(\x06`\f\xc0\xa4\xa9\x12\x1a\x80\tU\x18/\xc8H*\x9f\x19\x15f\xae*\xcc\xeb&\xf6\xc2$Z\xd77\x05bDD\x01\xc0a\xa5\xf1\x8f:\xae\xfebf\x16\x11\xa8\xdc\xc2\x1b\xf3t\xdfͩ|4\xea6\xbc\x19\xe1\x8d\xe9\xcbG\xe3\xe7\v\"X\xb6\x05\xd2ß6\xb2\x01\x8c\x16Aɶqx|\x8aJ\xad\x01۲R\xaf6ƍ7!\xc0$\xe2\xbd5\x01\x98\x19\x958\x030C\n\x860\xae]:\x84\xf6$\xb1\n\xa3\x87\xbe\xdfWaĨ0sVa\x8a`\x153W\x88\t=aڮK\xc7\xd5\xd6\xcfي!\xc1\x8a;\xd7\xf0\xc6<\xddwg,\x1fɆ\x857f*\x1f\r\xcf%\xf8\x86\xb2-?M\xb5\x17=\x10\xb9٤\x00\x98\xf0\x7f-\xa5\xd0j\xbb\xb8\xb7\x7f\x14l\xb5\xd6ƍ73\xc0\xa4\xbb\xb3\xadK\x9c@\x9eJ\xdc&\xc7\t\xc4-D\x9dn6\x15F\x86\xbe\xef\xff\f\xfa0\xb3\x8a*L\xf4u-H\x85I\x030E\xaa2-\xa4\x9ct\xef\xa8\xf6\xd3\x04\xd9\x06\xc4\x15\x80\x97\x11ޘ\xab\xfbnT50\xe1\x8dc\x95\x95\xe4壡\b\t\xad\x01\x01,\xdb\xf6/H\x91\x01\x80A\x06\x80\t\xcfv\xcb\"ܸ\xb7\xef\xef$\x00\x19\x80\x99a\xf56q\x02\x06`$\xcb\xdb\x1a\xf3\x15-\x80&Ƶ\x8by\xa90!\xdc`5U\x18\xe97:OTa(\x1f\x15fU\x01f\xae\x10#\"\x16\x11\xc9Y\xa3\xf5\x99\x8dN\xf7\x7f`&\x88\xf4\xb7S/3\xbcQLx\xe3\\\xc2\x1b\xc7\xcd7Q\xf9(r\x8c&@\x8b\x86eY\x10\xa2~\xd6\xd8X\x80\xa1\xe9\x00C\x04Ҁc;\xb8wp\x82f\xb3\v+l\xf05q\x02\xf9\x94\x91L\x9c\xc0\x86\x00L:b\x99\x060,Ꭴ\rSa$\a\x15\x06\tT\x18\x9a\xbc#i\x95\x01fn\x10\x13\x94\x91\\\x00\xd8?i\xfc[\xcbR\x90HB\xf5\xdc\xdcwMx\xe3r\xc3\x1b\xc7)+#?\x83X\x10\x94\x9e\xf15C4|\xd7^\x10\xa0\xa7\x01Lx\x81\xd1x\x80A\x10,i+4\xeam\x1c\x1c\x05\uef5e^{\x80Y\xf98\x81,b\x8aq㝣\x12\x97_\x1f\f\x82\x98\x11-\x8c\xc7\xe2T\x18\xbd\x8a*\x8c$Sa\xb0 \x15\x06\xa1\n\xf3r\xb4\xe9\xc1\x01\x15ff\x80Y\xe3\x14k\x05\x00\xb7\x0e\xcf\xfe\xa9'\xfa\xe5\xec\x03\fO\xdb>-\xb3\xba\xef\x9a\xf0\xc6\x05\x867&qߍ\x9b\xafL\x9d/ \xd0\xd0P\xca\n>5QB\x80\xc1X\x80\t\x1b\x81\x99\x19D\x82[\xf7\x0e\x02\xf7\xde\xd5\x03\x98L\x1f\x8aW9N\x00\xc6̮8J\\\xbe\x00\xc3\x00\xda\xda\xc2}\xe3T\x18\x99\xae\xc2\xf4\x00\xa70*\f\n\xaa\xc2\f\xba\xf3\xce\x040\xb2\xac\xf8\xe8\x05@L\xe8\tSku\xce\xd7Z\x9d\ufdd8\xa1\x01JS>\x12\x19.\xa7L.\r%;\x06\xf9\x96\x8f$\x06r\x90\xae\x1f$\xef\xf2\xd1\xc81S\xe7;K\xf9h\x10\x98\xe2՟i\xe5\xa31\xf3\x15@<\x01+k\xc0]\xb7\xffi)\x1b\xc0\xf8\xff/\xb0\x1c\x1bw\xf6\x8f\xd1q\xbd\xc8\xf3/\x11`\xd2_c\xab\v0i\x97T\xe3\xc6[ %._\x80\x01\x00/ܑ4\x83\n#\xb2X\x15\x86VH\x85\xa11*̪\x97\x90\xe6\xad\xc4\b\x00\xec\x9d\xd4\x7f\x8eA\x10?\xe0\x91f.\x1f%\xdeNl\xc2\x1b\x97\x15\xde(\x91\xf2\x11\x12\x95\x8fƿ'\x1a\xbe\x97\x8bR\f@\xf7w$%\x04\x18\x8a\x05\x18\xdf\r\xb8d[\xa8Tj8=\xad±\xc3@\xc8\xf9\x00L\x8a\xb5z3\x00Ƹ\xf1\xce\f0\xcbQ\xe2\xf2\a\x18\x04\uef2b\xa6\xc2`\x85T\x18\"\x0fD<\xa0\xc2\xe4RB\xe2p\xa2\xcbG\x9c\\!F\x8b(\"\x92ó\xc63\xed\xae\xf7e\xcc\f\x88\xa8\xcd\toD\xe1\xc2\x1b\xb1\xd0\xf0\xc6\xc1\xf7D\xc6(YI\xcae\xa2=\x103X\xb1oN\x97\x12`\x10\x030\xe1\xcf2\x13<Ѹ{p\x04\xdb\xee\aB\xa6[\xb8f)\x83\xac\xa7\x99\x9d\x01\x984\x003\x93ڽ\xf2\x00#Q_\x98\x8b\xfb\x1b\xaa\xc2\xc8BT\x98&?\xdeSa(\xa4\xba5P`r\x87\x18\x11!&\xf2\x00\xe0\xa8\xd6\xf8y\x8b\x19ZD\xa7r\xdfͣ|\x94\xa3\xfbn\xfa\xf2\xd1<\xddw\xb3\x95\x8f\x16\xe7\xbe;\xa1|4q\xbecb'\x04~H[$\x042\x0f\x80\xf1\xff\x97aY\nw\xee\x1d@\xb4\x80\x83\x18\x8c\x19\xba\a\xb2\x95\x91\f\xc0\xac5\xc0$\xfaKm\x90\x99]t\xae\x04\xa0\xe3Z\xb8o\xab\x8a\xfb\xca\xd5\x18\x15F\xcfA\x85\xa1\x82\xa90\x98\xbb\n\x03b\xd4\x02_\x18\xea\xbd\xf33\x10\nE\xffQ\f\xcc\xc9S\x89!\x00\xb8yp\xf6N\xad\xe5A\"\xe95\xf3\x9a\xf0F\x14\"\xbcQ\x92\x96\x86f\boL\\>\x8aQ\x92\x86c'\x00\x82eYaq)'\x80\x01\xb4\xd6(\xd96\x8eN\xab\xa8\xd6\x1b\xb0,\x05\x9d\xf3\u2d79\x003u\xbd^y\x80\x99M\x853\x00ӻ'\x10\xe3څq*\x8c\xccA\x85\xc1t\x15\x86\xd6G\x85\xb1\"\xbe0*Ɲ7S\x19)\xa6\xaa\xb4\x16\x10\x134\xf3\xeaj\xb3\xf3`\xb5\xd9\xfev\xcb\xffdK\xb9\xb8\xef\xaeyx#\xd6-\xbc1c\xf9hXI\x12\xbf\x86\x04\xb6,@|\xf5$\x0f\x80\t\x9fCY\x16ڝ\x0e\xf6\x8eNa[\xb6\xdf,c\x00f2\xc0dY\xbaM\x9c\x80\x01\x18\x19]\xfc\xe6\xa2\xc2\xe8\x1cT\x18\xac\x8f\n#Ĩ\xf0\xeb@\xe8F\xf4\xaf<\xb6R\x17\xabД\v\xc4\xf4\x02\x1eOj\xff7\x93\xdf\xcc\xdb\x0fx\xcc\xdf}7\xdf\xf2\x11\x96\x1a\xde\x18?\xdfU\x0fo\xccP>\x1a9Ƈ\n\xa5\x14\x84\x00\"\x9d\x18`h\n\xc0\x80\x00\x86\xc0R\n\xb7\xf7\x0eA\x8c\xfeG,\x13'0C\x05mU\x00&\xbb\x8cb\x00&\xbbX5\xa8\xc2 Pa\x10\xa3\xc2 \x80\x14\x9dN\x85\x11\xa3\xc2\f\xeeHz\x1cݞ\n\xa3r\x05\x98\xb5\x8a\x1d\xd0\"\x16\x00\xec\x9d\xd5\xdf\xd4\xeez_\xca\xcc\xe8\x05<N+\x1f\x15\"\xbcq\x9e\xee\xbb\xe9\xcaG\x92\xaa\\\x86)\xf3\xcd1\xbcq\x06\xf7\xdd4\xe5\xa3\xc1\xf7\x88\xfd~\x15\xcb\xea_6\xc3\x00C\xf1\x00\x83)\x00C\x81\u0379\xed\xd88<<E\xabՁ\xa5\x94\x89\x13\x98\xa9\x8cT\xf08\x81\xe4\xaf\xdc\x00\xccl\x06\x00\x13σ\xbe\nS\x0fT\x18\x8eQa\"\x8a\x8bQaf\xe8\x85y\n\x8cn\xee\n\xccZ\xc5\x0e\x04ͼ.\x00\x9cT\x1b?\xa3|\x80ѫ\x1e\u07b8P\xf7ݩ\xe5\xa3y\xba\xef&,\x1f\xcdྋ\xc4\xe5\xa3Q\x10\xd4\x10\x90\xc5 \x89\\B\xc3[\xa7\xd17\x9fJ\n0\xe1s؊Qo\xb7qtR\x81c\xf9\x81\x90+\t0i\x8f5q\x02\x06`\x16\x10'\x107\xdfP\x85y\xec\xc2><\x0f\x01\xa8\x8c\xaa0\xba\xe8*\x8c\x14[\x85i\xf3\xcbѡ\a\xc1h!R[_;\x80\xc9C\x89Q\x00p\xe7\xa8\xf26\xcf\xc3cD\xd0\x02\xe1u\no\x14\x13\xde8a\xbe\x92`\xbe\xd3{d\xa2\xf3\x1dh\x1eրRV\xe4\x06\x13\x0f0\x18\xc8UJh\x86G\x00X\x81\x00\xdc\xd9;\x84\x1aq\xef]\xa1<\xa4\x15\x8e\x13H\rb\xc6\xccn\x8eJ\xdc\xfc\x1ay\xa3*\xcc\xe5\xad:.\x95\xabh{\x1c\\\xe7\xa3*\x8c\x14]\x85\xc1t\x15F\x96\xa4\xc2\x101*Qw\xde5\x06\x98\x99 &\xccGjv\xba\xe5\xa3j\xeb\xff\xb2\x14A \xb4\x88\xf0\xc6\xdc\xddwMxc>\xe5\xa3\xd8\xf9\x02\x89S\xcbGJL\x1a\xa4T\x04F\xa6\x01\f\x92\x03\f\b,\x1a\x8ema\xff\xf0\x14\xae\xe7\x0e\xb8\x03\x9b8\x81\xc5\xc4\t\x18\x80\xc9\tdgV\xe2\xe6\v0Q\x15\xe6\xd1\rQa\x90H\x85\xa1\xb9\xb8\xf3v\xe9!ߝ\x97x\xad\x01fV%\x86\x00\xe0\xf6a\xf5G\x98\xc1\x02\xb8\x90P\xf9\x9fox\xe3Xӵ\u00847bc\xc3\x1b\xc7\xcd7\xba\xc5\x1bIS\xcb5@āWL\xbe\x00C\xc1\xef\xb0-\x1bg\xf5:N+u8\xb6\xe5\xdfL\xf3\x02\x98\xf4\x1f\f6\x04`\x8c\x1bo\x1e\x00\xb3\n[\xa9\x8d\n3M\x85\xc1\x1c\xdcy\x9f\xf2w$\x91\xac=\xc0d\x86\x18\x1dl\xa9>\xad7_\xd5\xect\xff\x89\xc5\f\b\xace\x847J!\xc3\x1b\xf3wߝ\xa9|\x84Ņ7&\xdab\x1d;\xdfQ\xf0\xd5\xd0\x003\x94R}(I\n04\x19`z\xc7+\x86ւ{\aG\xb0\x945λ\xd7\xc4\t\x18\x80\x99;\xc0,G\x89\x9b?\xc0l\xb2\n#\vVa\x9aa/\fM\xf6\x85\x99\x19`\xc2\x00\xc8UM\xb1\xe6ޖ\xea\xc6\xcf(V\x10\x81;\x17\xf7]\x13ޘOxc\x9e\xee\xbb\x18\xfe\xfbf+\x1f\x8d\xcew\xb4\\&Z\xfc\x8b\x99\ta\xf8\xd60\xc0\x8c\\\xe0\xd1c\xa6\x00L\xb8\xd5\xdaV\n{\x87\xc7\xf0 `N\xb9\x88\xe4PF2\x00\xb3\x86\x00\xb3\x12J\xdcb\x00&\x8b\n\xa3\xd7D\x85\xc1\x02U\x98pG\x12Qw\xe2y\xb8*q\x02s\x83\x18-\xa2\x00\xe0\xdeI\xed+[\xdd\xee\xd3L\x10\x81X\xa3\xbb\x84\xe6\xe4\xbek\xc2\x1b\xf3\to\x94\xd1\xf0F \x87\xf0\xc6\f\xe5#\x19W.\x13\x00\xc4 \xf6\x1du\xe3\x00\x06H\x060\x14\x030\x04\x82րm+\x9c\x9c5Po4aY<{\x19\xc9\x00\xccZ\x03L\x9a?\xd8&\xba\xf1fVa\xf4\x90co$C)O\x15\x86VP\x85\xa1\xa9*L\x03-~\x05:\xdcO\xaaN\xdf\x1c\x92\xa2\x8c\xc4s\x92\x18\xe7\r1\xd1|\xa4\x83\xd3\xc6O8\x96\xe5\xff\xe9\x12\x95\x8f\xe6\xe8\xbek\xc2\x1bs)\x1f\xe5꾛\xa1|\x14\vF\x10\x90R`\xc2P@[:\x80A\f\xc0\x84_SJ\xa1\xebvqx|\x02kx\xab\xb5\x01\x98|\x8a\x1f+\x030YU8\xac\x87\x17L\xce\x00\xd3Waj\xe3U\x18\x1dq\xe8\x1dVa$\x7f\x15\x06+\xa8\xc2`\xaa\n\xa3PSOF\xdcy\xe7TF*\xa0\x94\x93V\x89!\x00xa\xef\xf4_\x01\xb8\b\x88+\xe2?\x87\to\x9cP\x1a\xcaX>Zfx\xa3\xcc\x12ޘ䘘\xf2\x11\xc6\xf4M\x113\x04C\x17\xf5\xac\x00\x13\x01\"f\x06+\xc2\xde\xc1\t\x18\xdc7\xd03q\x029\xa80\xab\x13'\x90\x84\xe6\f\xc0\xa4{s}\x15\x86\xf0\xe8\xc5\t*\x8c\x18\x15ff\x15F\xbd\"\xf0\x85\x19\xaf\xc2\xcc\x03`\x8a\xc03\x89!&\xccG\xaa4\xdaW*\xf5\xf6;,E\x10\x812\xe1\x8d\v\bo\xcc\xd3}wA\xe1\x8d\x13S\xcbǽ'\xb1\xe7\r\x81\x95\x82\x9f'\x9a#\xc0D\x9eCk\rǶqxZE\xab\xd3\xf6\x1b\x89M\x9c\xc0\n\x02Lv\x19\xc5\xc4\tdW\xe1&\xab0\n\x97\xb7\xaa\xb8T\xaa\xe5\xa0\xc2\xc8f\xab04A\x85\xe1\xa7@h\x8f\xfdk\xac+\xc0\xa4\x82\x980\x1f\xe9\xceq\xed\xa7\x15\xc3\xcfG\x02Ȅ7b\xfe\xe1\x8d\x12\xfd4 \xb3\xb9\xefΫ|4u\xbeSR\xcb\xc7A\x95\x16\x90\xe2X\x80\xa1i\x00C\xd3\x01&\x84 \x8b\x19\xadV\v\xc7g5XJ\r\x94\x94L\x9c@q\x00&\xcdrj\xdcx\x13\x02̜\x948\xf1\x9d\xc3&\xf7\u008cQat\xac\n#\xeb\xa9\xc2PB\x15\x06\x93T\x98\a\xc0h\xc7.\xe9\xeb\f0\x89!F\x82|\xa4\xbb'\xb5\xcfiw\xdc/e\xc5\x10@\x99\xf0F,\xdc}w\x10\x04sr\xdf\xcd5\xbcq\x8c\xfbn\x96\xd4r\b4\xb4\xef\x15C4\xe0\xc8K}\x89e\xe0\"\x1f\a+\x93\x00\x06\xfe\x8e;\x10\x18\xfb\a'P\x8a\x8b\x010i\x8f5q\x02\x06`\x96\x14'0\xba\xe6\t:\x9e\x85\xcb\xe5:.\x95ӫ0\xb22*\x8c̮\xc2`\x16\x15ƚ\xa8¬;\xc0\x00\x80\x95P\x85q\x01\xe0\xf0\xb4\xfe\xe3\xcab\x88\x86\x06\x84G>E\x0f\u07fcRd\x1fM<&\xf2\xcdi壩\xc7\xc4@V\x92\xddGS\x8f\xc9u\xbe2x\xa3H\x90}\x94d\xbeI\xcaGIKL\xf1s\x19\xaf\xacL<f\xda|\xc3\xc6^ \x1e`z7\x81(\xc8` \x82`\x12\xc0\x84\xc6w\x96\xc5889\x83\xe7j0\xb3\x89\x13X\x10\xc0\x183\xbby+q\x8bk\xe4\x1dP042\xf5\xc2LVa\xfcc\x81\xf9\xa90\x83\xa5\xf4i*\f\x12\xab0\xfdߓ\x97\nSEK\xbd\x12\x1dz\x00\n\x95\x11Mb\x13\x00&\x91\x12\x13n\xa9\xbe{\\\xfb*W\xf0j\x86\x9f\x8fd\xc2\x1bG\xcbGb\xc2\x1b\xb3\x95\x8fd\xd8kgp\xbe`\x86\x10\xcd\r`\xc2/ڶ\x85z\xa3\x81J\xb5\x1e\xecR\xca\a`f\xe3\x16\x030\x1b\v0\xb9(q\x8b\a\x18\x86\xa0ӵpyg\x1e*\x8c\xffo`\x85T\x18Y\xbc\n\xb3)\x003\x15b\x06\xb6T\x9f\xd5\x7f\xd8Q\xd4;\x81V%\xbc\x11E\bo\xcc\xd3}wU\xc2\x1b3\x96\x8fF\x9aõ\xdf\xd0\xcbD\t\x01\x86\x92\x03\xccp\x19\x8a\x14\xb4\x16쟞BY\f@Ϩ\xd3/\xb3|`\x00f\xe5\xf3\x90Vh+u\xf4@-\x80\x87y\xf4\xc2\xcc_\x85\x19P\x92\xf2Ra\xb0\xd8^\x98\xc5m\xa3\xe6\x1c2\xa4\xe7\xaf\xc4(\x00x\xfe\xee\xc9\xdb=-\xf7\x03\xd0\x10\xf0*\x857J\x9c\xd3\xec\xa2\xc3\x1b\xf3tߝKx#\xe6\x10\xde8\xa4\xacH\n\xf0\x8d\xbe'\"\xbe\xe1\x1dqB\x80Ar\x80\xc1\xe0\xcf24\x14+\x1c\x1e\x9e\x02\"\x03\x81\x901\xeb\xb5\x01\x18\x030\x05V\xe2\x96\x030\fAǵ\xf0@\xa8¸\xab\xab\xc2H\xe1U\x98'GT\x98y\xe5!Q\xecWd\xe8\x83^\xc1 &L\xa9n\xb4\xbb\xceY\xa3\xf5}\xb6Ő\xe1\x80ǌ\xe1\x8dXRx\xa3\x14)\xbc1O\xf7ݙ\xcbG\xf9\xb9\xef\xe6Q>\x1a\x01_&@\x11\xa0uj\x80\t?\x89\x8d\a\x18\xea5\xfdj\x00\xb6m\xe3\xb4\xd6@\xa3ق\xc5<\x18Ek\x00f6\xa2[\a\x80Y\t%n9\x00\x03\x01\xb4Р\n#IT\x18]H\x15\x06+\xa6\xc2\xe4V\xeeY\x912R\x12%\x86\x00\xe0\xeeq\xf5\a\bD\xf0\xf3\x91(\x8f\xf2\xd1\xfc\xddwW4\xbcQ\xd6'\xbc1s\xf9(\xcek\xa7\x17?\xc0\xe3\x01\x86\xe2\x01\xa6w2'Pq\b\x04\xa5\bn\xd7\xc5\xf1Y5ֽ\xd7\x00\xcclK\xbc\x89\x13X_\x80\x01\x80\xb6kၝZJ\x15F\x8c\n\x93S/\xccb\xfa`\x8a\x855\xb1\x10\x13\xa6T\x1fW\x1a/9\xa9\xb5\xdefY\xfe\x96\xea|\xcbGq&sE\no\x84\to\x9c\x83\xfbn\xa6h\n\x90\x0f0\"\xe3\x01\x06\xb3\x01L\xf8\xe3\f@)\xc2\xc1\xe1)\xc0\x91Oh\x9b\x020iW\xf4U\xceCJ\xcc3&N ɻ\x16\xe2\xfe\xb5\v\acT\x18I\xac\xc2肨0RX\x15\xe6\xcat\x15f\x03\x00\x06\xe3\xb6X\x87)\xd5\xf7N\x1b?j+\xf6S\xaa\x03\xaf\x98T[\x85S\x1c3\xbcX\"\xc1\x82:\xf1\x18\xc4m\xb9\x1e_>\x02汝x\xfa|g\xda>-\x83\vW\xa2\xad\xd1џIr̬ۧG\xe6;\xbd\xec82_\n\x94\x98\x04~0\x98\x01`\xc8\xf7n\x84mY8\xae\xd4\xd0\xe9t\xa1\x94\x05\xed\xe9\xb5\x01\x98\xfc\x94\x86U\x8b\x13\xc0le$\x030S'\xd1u-\\ީ\xe1b\xb9\x86F\x9b\x81\x00D\x86\xa1$\x89\n\x13\xfeܲU\x18\x14^\x85\xa1\xe5\x00\x8cߪXL%F\a\xb0rTm|z\xa3\xdd\xfdrf\xc6\x00\xc0lbxc\x9e\ueef9\x95\x8f\xe6辛\xb2|$\xb1\xe5\xa3q\xf3\x1dU\xbb\xa6\xf7Mi\x80#\x9f8\xe6\x040\xe1C\xa5\x14Z\xed\x0e\xce\xcej\xb0\x98\xe1i]Ѕk\xa6u\xdb\xc4\t\x18\x80\x99\xfdl\x92\xc1\xae1_\x85郋Ϊ\xc2\xc8x\x15\x86\x8c\n\xd3Sa\x96\xa6\xc0\x14\x04`b_\n\a\xc6vw\x8f\xab?\xea\xe7#\x89+IL\xd76-\xbc1G\xf7ݼ\xcbG\xf9\xb9\xef\xceR>\x92d\xe5\xa31\xe7\xcdp)J\x98#\xe0\x92\x13\xc0P\f\x10\x11\x81\xd9\x7f\u0383\x9330\xabD\x12\xea\\\x17\xae)\xcfh\xe2\x04\f\xc0,3س\x97T\x1d\xa80mW\xf5zad\xa2\n\x13\xf6\xbc\xe9T*\f\x92\xa80\xb4\xfe*\xcc\xd2\x00\x86\xfa\x7f\xf7\xc2AL\xa8\xc2\xdc<\xa8\xbc\xb9\xd1q?C1A\x82\x92S\xb2̞e\x877b\xb9\xe1\x8dR\x9c\xf0Ʊ\x81\x96\v\foL\xd57\x15{\xde\fzvsV\x80\x19S\x86\n\xf1f\xe0f\x01@\v`+\x1bǧU\xb8\xda\x033\xcdi\xe1\x9aMeX\x1d\x80I9E\xe3ƛ\x8f\x127\xe7F^\x19z\x96\xf4*L\x04^\xf2Va\xb0\x8e*\xcc\x13=\x15\x86⤐\x05\x01\f\x05\xe0 \x05\x81\x18+r\x01R/^\xa0R\xff\x91\xb2eAK\xe0\v\x13\xb7\bM+\x1dĝ\xec\xe3\x8eI\xb1\xd3f\xb2j0|!f\xe9\a\x19\x85\x8c$A\x85ɎI\xe6\xbe;:\x97\x84\xc7L\xed#\x9a^>\x8a\x9fo\x92\x18\x86\xf1p:)\x9a\"Q\x1f\x91\x0e?wd\x04\x18L\x06\x18DU\x9aವ,F\xa3\xd9B\xad\xde\xc4\xce\xf6\x16:\xae\x1b\xab\xa0f21C\x1e\x8b\xd7\xfa(0\x06`f;\x7f\x96\xb1\x13I\"\xd7J{\xa0\x17F\xf5zaB\x15F\x1b\x15&'\x15\xe6u \xb4\x83\xce=Z\x1a\xc0\x14mp\xe4\xefŁ\n\xf3\u07fb\xae\xbe\xda3\xb6[\x87\xf0\xc6T\xfd \xf3\vo\x9c\x8b\xfbn\x81\xc3\x1b\xb3\x96\x8f\xe2b'4s\x044\xe2\xbc_(1\xc0\xd0$\x80\t\x9f#p\xa7>:\xa9\xf8~1z6S\xa7M2\xb33\x00\x93RP[x\x1fL~\xe7\x81\x04\xabȵ\x8b\xe3U\x18Yw\x15F\xe6\xab\xc2X\x11\x15F\xc5%Uo\xc8.\xa4\x89\x103\x10/P\xa9\xff\x80\xedX\v(\x1f\xa5wߝZ\x1a\x1awL\x9e\xee\xbb9\x95\x8fF\x8c\xe8rsߝ\xa5|\x94\xbf\xfbn\xda\xf2Q<\bj\xffV\x16\xfd\xd45\x040\x83J\xccd\x80\xc14\x80!\x804`)\xc6\xf1i\x052ƽ\u05f8\xf1\x1a\x80٤8\x81\xe1\xf3\x80\x02w\xde\xcb[5\\,\xc5\xf7\xc2l\x84\n\x83\xf9\xaa0B\x16*\xfc\xba\xa0\x99W\x16\x000\xf1?W\xf4\xec$?^`\xef\xecm\x9e\xc6e\x12\xd1\xd2\x03\x9c\xc9e\x80\xd9\xc3\x1b\xb1\x9c\xf0\xc6<\xddw\x11\xd3\x0fb\xc2\x1b\x93\x81\xef\xb8\xf7$\x06ꄒ\x00\ff\x06\x98\xf0`۶P\xab5\xd0l\xb7G \xc6\x00\xccl\xc3\xc4\t\xac6\xc0 l-M\xab\xc2\xe8\x18\x15F\x1b\x15fZ/\x8c;\xe4\v\x93\t`\x90\x82XV\x04`\x00\x80u\xa4\x17\xe6\xe0\xb4\xf6\xbd\x8e\xa2\x11\xe8\x98ox\xe3<\xddwӕ\x8f\xfa\xf3\xcd\xc1}ׄ7\xce\xe0\xda,\xa3;\x94B'\xba\xbc\x01fR\x19\x8a\x18]\xadqR\xa9\xc1\x8e\xb8\xf7\x1a\x80\x99M\x85)2\xc0\xa4\x02\x93\rr㕑e.\xa3\n#1*\x8c\x18\x15fR/L5N\x85\xa1\\\xd8d\xe5\x01\x06a*\r\x00|\xf4\xd6\xd1;\x14\xd1\x0e\x01\x9eH\xa0\xc2\x14 \xbc\x11K\no\x14\x13\xde8{xc\n\xd7\xe6D\xe0+\x9c\x1d`h\f\xc0L(C1\v\x98\bǧ\xd5\xe0\x06\xc5\x1b\x17'\xb0\xde\x00\x93\xbdoe\x93\x01&\xad\n\xa3\x8d\n3\x83\n\xf3\xcax\x15\x06\v胡\xd5\xe8\x8ca\"r\x1b\xad\x8eu\xdah\xbdӲ\x18^\f\xc0L-\x03\xcc1\xbcq\xecV\xe1Xeń7\x16.\xbcq\x8a\xb7\xd0H\xf9h\x1c\xf8\x92\xef\x15\xc3\xd0\x03w\xa2\xc4\x00\x139\x9e\xa6\x94\x92\xc2\xe7К`\xdb\n\x95j\x1d\x9d\x8e\vf\xac.\xc0\xa4E\x925\x8a\x13\x98\xfa\x8a\x8c\x1b\xef\xd8s ~\xa9K\xa7\xc2\xf4\x03\x1de\xa1*\f\xad\x85\n\xf3\xdaь$2\x003\xd2\x13s\xeb\xf0\xec\x9d\x04b?\xe4Q\xa8p\xe1\x8d#}5\tJC\t\xcbG&\xbcq\xce\xe1\x8d\tzd\x06\xcaG\xe3\x9aõ\x0e\xb2Yx<|\xd0t\x80AB\x80\t?%)Vhw:8\xab\xd5a\xb1\x9a\xbaI\xc9\xc4\t,\x06`\x92\xfe2\x13'0\x9b\x127I\x85yYj\x15F\x16\xaa\xc2`\x1dU\x98\x82\x01L\x11@\x87?\xf4\x91\x17\xbf\xe2\xb8\xda\xfc\x17\xb6\xc5\xd0\x10\x95\xa8\x9c\"\xf3t\xdfMX>\x92\x98\xf2\x11Lx\xe3\xb4\xf2\xd1\xc2\xc3\x1b%\x05\xf8\x8e+\xa9\x89@\xc0Ac\xaf\x8c\a\x18\xe4\a0=S\xa7 \xb3\xe9\xe4\xb4\x02R\x04\x90,\f`\x90y\xb1\x91\x95\x04\x98\xf4\xf35q\x02\x89\x01&'%.Ta\xae\x18\x15&\x93\n\x13B\xd7T\x15\x86cT\x98\xa2\x01LAl{\xadJ\xc7\xfb\x99\xf3\xf7_\x80\xd7u\x85#\xafK<\r\"\x81\ue64di\xf8K\t\xf5\xfe잧\xc7\x1b\x9cap\xb1$\x00`Nf^\a\x13\u07b86\xe1\x8d\x13\xe0t\x1a\b\x0e\x9f\x03:\xfaig\x01\x00\x03\"\b4\x94e\xe1\xb4ր\xf64\x98(\xbf\x85+K\x7fƊm\xa5\x9ei\xbe&N\xa0pJ\\\xa8\xc2<vq\x1f]O\xe0I?\x03iV\x15F\x87*\f\xe5\xa9\xc2\xf4s\x94\x06?d\xe6\xa7\xc2P\n\x15\x06\x98\xa6\xc2\x10\x14\xeah\xf1\xab\xe1\xd2\x15(T|\x15\xa6`\x00#\xbd\x00\xc8\xe5S\x8c\xe5\xb5\xdb[\xa7\xf7\xee\t\x11\xd3\xff\xcf\xde{\x87ɒ\xdd\xf5\xdd\xdf\x13\xaa:N\xbewn\xbe\x1b\xeej\xa5\xd5&\xedj\x85$\xa4U\x06\f\"\xc82\"\x1b$\x1e\xfb\x05a\xbf\xc6`x\x1f\x82\xc1\x18l\x19d0\xe1\x05\x91\x8d\b\x8f\xb0\xf4\"\x81\x8c\r\x92\x81%H(\xa1\xc0j\x85\x84\xe2\xaeV7̝\xd43ӡ\xf29\xef\x1fU\x9dSUuUwu\xf7\xe9}\xf6\xb9\xd33==\xd5U\xa7\xea|\xeas~\x01\x94\x820\x06\"%(c\xad\xe8nP\x02\x8dk(\xe4u8\xae\a\xc68(\x05\b\xa5\xa0\x84B\"\x18\x80R\xc0\xff\xc7\x1fĞ\x90\x10\x9e\x80'\x83\xaf\x85\bH<\x98\x94\x84\x18y\xa7\xdf\xfe\xa7=Q\xf5U\x8c\x9d\xb8\x1am\xbc\xea\xbb\xfdU}3\\}wd\x15\xe3h\x9fw\xe2껡\xaa6\x0f\x026\xbf\x93t*\x003\xe4=Z'\tc0\f\v5\xc3D\xa9\x90\x87\xebz\xb1&\xae\xf0\x93\x91\\L\x80Q\xd5x\x93\x94Q),#\x8d\x1e\a\x04\x12\x96\xab\xe1t\xa9\x86\xf5\\\x1d5\x8b\x81t\x98\x95I-L\xe7~N\xce\xc2`\xbc\x85\x91Y\xb20\x02\xa0\x1a\xaaAu\xde\xd6\x1f\xc9\xd8\x12\x12mG\xa3\xcc\x1eb\xccz]J!hsG5?\x88\x1fD\xe9\xd7\xe6\xa0\x00\xf29\x1d\xbf\xff\x17\x1f\xc4{\x1f\xfd4Μ\xde\xc0J\xa9\x80R\xa9\x80|NG\xa1\x90C>\xaf#\x9fˡXȣ\x90ב\xcb\xeb\xc8\xe9:r9\r\xba\xaeC\xd79t\x8d\x83Q\x0e\xaaQPNA)\x05%~J\xb7\x10\x80\x94\x02\bb\x1f\x84\x90\xf0D0\xe8=\x0f^\x93\xe4\x05 \xe0\x05\xbf#\xba\xe31\x86\x18!\")Z\xb5\x87\xe5\x10\xb30\nNdtk \x11\xa6\r\xc3\xe0岡\xaf\x99\xb4\r\xc30\x932\x12\x8c\x86\x9b\x95\xe8\x9fw\xb4m\x1ad\x7f:?\x8b\x1b\x9c>\xb1\x01\xa6\xa3su\x18\x80i~\xcd\b`C\xe0褆\x95R\tB8\x03\x8b\xdfE\x9al\x14\xc0(\x80\x89\xbeX6\x93L\xa4a\x16&\xc9X\x98\x99Z\x18\x84\xb10r\xca\x16\xe6\x94oa\b\xcd\x14\xc0\xb4\x03Q\xb2\x13\xf6\xcb\t\xa5\x840\xd6\xfd\xd1:\xb3?\x82PJ\xcb\xf1\xb0\xb1^\xc6\xddw^\xc4\xce\xee\x11>\xf9\xf8>\fÂ\x94\x00\xe7\f9\xce\xc15\xe6\x87]\x06\a\x842\n\xc6\x18\x18\xa5`\x9cBc\x1c\xb9\xbc\x86\\NG^ס\xeb\x1ar:G>\x9f\xf7!(\xaf\xfb_\xe7\xf4\x00|\x18tM\x83\xa6k\xd0u\r\x1ac`\x9c\x02\x94\x83Q\xe2C\x10%\xfe\t\xe27\xb0D\xb3\xe1S\x13p\x84\xe7\x9fDͥ/A|bj\x9el\xa3\xac\x01\x01\x81\x90\xc2o\xb6E\xba\xa7\x97\xb4\x97\x8fB/1\xc5^.\v\xb3|\x14w\xb9l@y\xf2X \xd8\xfby\x9b1)t\x00|\x90\xae\x93q\x12\x80\xe9<\x0fH\xf0w9\xa58:\xaa\xe1\xe2\xd9\xd3]\x00\xa3\xaa\xf1*\x80I\xb6\xb1g6\x01\xa6eaʾ\x85\xa9v\xf6H\x12\xcb`a0}\v\xd3\x15t\x92\x8dv\x02\xa4\xeb-\xb2\xd1\x02\x92\xf7\x1a\x98^\x80\x01\b$\x01(#\xf8\xb2\xe7\xdf\x0f\xce\x18l\xc7E\xa5\xd6\xc0\xce\xee\x11\x9e\xbc\xb1\x87\xab;\x87\xd8=<B\xadn\xc1\xf5\x048g\xd09\x87\xa61P\xea\x1f(!|sRo\x98\xa8\u05cc\xa0=\xbb\x0f\x1e\xb2\xd9V\\\x12H\xe2?焂1\x1a,]Q0\x06h\xbc\r4\xba\xce}\xb0\xe1\x1a\xf2y\x1d\xb9\x9c\x8e\x9c\xae\x05\x06H\x83\xa6\xe9\xd08\a\xd7\x194M\x83\xc69\x18g\xe0\x84\x82p\x7f\xa9\x8cR\xbf\ue210\x00\x10,sABH\x04\xdb'\x02CԮ:\xd9:\x11\x83I\xd7_\x12k\x1eS?\x86\xa2=\x1f\xfb\x9f}T\x8cJ\x98\xe5\xa30\xf1/\x99m\xde(1\xb8\xc9g\x88@\xe4\xde\xd7x\x81\xc2\x1c\r0\x83\xea\xc1\xc4\a\x98\xe6\v9\xe7h\x98\x16,\xcb\x01\xe7\f^g\x9a\x92\x02\x98X\xfai\xe1\xda\t`zq0\xd3\x04\x18\xff\xea\x18X\x985\xdf\xc2\xc8N\v#\x95\x85I\xc2\xc2p\xd4QoZ\x18rұ\\\x93\x8dv\x02\xa4\x9fb2\x021#\x01\xa6c\x10{\x12\x87\xc75\x00\x04\x8cQ\x94r:\x9e~\xe5\x02\xee\xbd\xf32<)\xd0h\x98ح\x9c\xe0\xfaN\x05O\xee\xec\xe1\xc6\xee1*\xc75\x98\xb6\x03B\br\x1aG.\xc7Q\xd0s`\x9c\xb4'}\"\xfb&\x986\x13H\x04L\xd0:i\f\xc3D\xa3a\x06\xd0![)\xb9$\x18p\xfe\xa0%~\x15?F}\x1bD9(\xf7\xef\xa6u]\x87\xa61p\xae\xf9p\xa31\xe89\xcd7>\x9a\x16\xfc\x9cC\xd398\xe7`,\x801\xce\xc0\x19\x03c\x14\x84\x92\x8e\x13\x8e\xb6\x96\xb6Z\x94\xef\x7f\t\x01\x01\xe9uܑ\b\xe1CZ\xc7\t\"\x84\x04!>,5\a\xb5\x10\xc27\x00-\x95\xd8ch0:\xc8\x16C\x83\xa5C\x04BOa\xf9h\x94\xfd\x19\xb6\x14Fe\x10d\xde\x1a/\xa3\x01\xa6mb&\x03\x98\xce\v\xa3\xeb\xba8\xa9ձ}j\x1d\x8e\xe9\xfaFF\xb5\x13\b\xf9yU;\x81y\x05\x18?#Iö\xb20\xa9Z\x18I54\xe83@H3#\x89d\xa6\x9d@V\x01\xa6\x051a0\x8d\xc0\xaf\x99\xd1|nZ.\f\xcb\x01\x88\x9f\x86\xca\x19å\xb3\xa7q\xe5\xe2Y\x10\xf2tX\x8e\x83\xa3\x13\x037\xf6+\xb8zc\x1fWo\x1e`\xf7\xb0\x8a\xa3z\x1d\"\xb05\xb9\x00\"4\xceZ\xbbƧ\xf2`P\xc8\xf6`\xa4\xd4?\xa0\x9c\xd3Vѱ\xce\xe2A]$\x1e|\xb7}r\bH\xe9W[5M\x13\x8d\x06:j\x9f\xf8\x03\x9d\xb4\xd8\xda\x1fƔP\x7fɊsP\x00\x94\xfb\xcbb\\c`\x9c\xfbVHc\xe0\x8c\x83\a\x9f\x83k\xc1ל\x83i\f\x8c\xb2\xe0_\x1e\xc4\x00\x110\xc2[\xa9\xc2͓\x13MVk\xd5BA;e\x11\xfeŢ\xc9s\xcd\x13\xbf5\xe1\v\tI\xfc\xcf\bPH\xe1\xe7\xbeI\x84\xb0/\xa3\xe0$\xea\xf2\xd1\xd88\xa2\xb8\xcbe\xed\xbf\xefǃS0\x00\"1\x80\xe9^\x86\x1a\b0\xc1\x7f\x94\x10\x1cU\xeb\xd8>\xb5\x01\xb2D\x00\xa3\xda\t,/\xc04-\f\xa1\x04\xb7ĵ0BY\x98\U0005daab\xa8\xd3{\xe0\xd0S팤\xf8l\xb24\x00\xe3\xc7ČXF\xea\x04\x98\xde\xe7\x84vW\x0f\xf2\x97\x8a\fԚo\xcc\x18\xd6V\xf28\xbdy\x19\x0f>\xedVxR\xa2nX\xd8=8\xc2\xf5\x9bGxrg\x0f;{\xc78:\xa9\xa1Z7@(E\x8e\xfb\x06D\xe3\f\x8c4\xd3\xcaړ\x1aA{ه\x90&|\xf8q\x12D\x06\r\x02\x9bf\xa6+\x03\x85\x05\xe0C@\b\vb\x1a$H`:Ȑ\xa5\x87\xde\xe5\x12)%\x1cˁm90\xd0\xf0\v3\x11\xb4\xb7\x03mLo\xc6\x04QJ\xfcZ#\x94\x80R\x06\xce}\xf0a\xdc_*c\x9c\x82q\r\x8cQ\xdf\xfch܇\x1f\xce\xfc\xec\xaf`_\x10N[':e,\xd8\xd0\xfe\x9a6\xa2\xb38 $\x88'!\x9ak\xab\x1dK_㗏\xc2\xc4\xde$\x97>\x1dvI\xad\x19\x17\x938\xc0\xf4\x04\xf3\xf6\x8e\x05@\x823\x86j\xbd\x01\xdbu\xc1\b\x85\x90B\xb5\x13\xc8$\xc0\x84]\xe0Q\x003~\x02\x93p\\\r\xa7\xcb'X\v2\x92\"[\x98\x8e\xaf\x13\xb70d\x11,\x8c\v\t\r\r\xdeY\x17\x86d\xa7\x98]\x86\x01\xa6mb\"\x02L\xef^hN\x06\xb4\x05\x05\xfe\x0f\rӆaڭ%(\xce(.\x9d\xdf\xc6\xed\x97\u0381Q\xdf\xd6T\x8e\x1b\xb8\xb1W\xc1\xb5\x9d}\\\xdd=\xc4\xdea\x15\xd5 f\x86Q\x86\\NCN\xe3`\x8c\xb5,I\x1bjFg\xa5tv$\xee\xc2 !\x02\bj.\xdb\x10\x10\xe9\x1b\r\x12,q\xf5Uo\xa5\xed;\x01\x04\xe5\xef\xa5\xf4\xe1H\xfa\xfd\x1b\x82\x7f\x9b'\x90\xe8z\x0f\x04\xb15\xae\xe3µ]\xffo\x04'\x0f阌e\xc7]\t#$X\xba\n \x88\xf8f\x88Q\nJ\x19\b\xa7`\x94\x812\x1fz(\xa5 \x94\xf9ևQ\x80\xb0\xd6\xd2\x17%\xcc\xdf8\x1a\x04\xc6J\tP\xd2^\x86k\x99\x1d@\x12\xd1]\xac\xaf\x999\x96\xc4\xf2Q\xc8\xf8\x97A\xaf\xf1\x00\xb8\x00\xf4\xa0\r\xc1\xb4\x00\xa6\xf9\x1e\x8c\x11ض\x8bz\xcd\xc4\xeaJ\t\xae\xf0\x06\xde/\xa9v\x02)\x01LTdQ\xd5x\x130q\xfe\x8c}y}\x0f\xaeG&\xb402y\v\x83E\xb00u\xd4\xf9=p\xd0ia\x14\xc0\x84\x87\x98\x84\x00f\xd0sJi\xd7sד\xb0\xebF\xeb-8eX+\x17pzs\x15\x0f\xde}\x1b<O\xa0\xd60\xb1\x7fx\x82k\xbb\x87\xb8z\xe3\x00;\a\x15\x1c\x9f\x18\xb0\xdc\x06(!~F\x93\xc6\xc1\x19kg\x89tLx\x83\x00f\xd8dG\x06-C\x8d\xe9\x82\x1cP\x10$!}V\b\x04\x10\x92\x80@\xf6\xbf/\v\n\xe6\x13\x1f\x88 |8\xa1ԿxҠ\x10 \xa5~I\xc1`]\xa8\xfdY\x84\x7fRy\xae\vѳD\xd4^z\x93\xad\xcf\xd9:\xe9\x9b\x10\xe4\xeb3\x1f\x06\x9b\xdf\v\xbe\xf6\xe1\x87\x02$\xf8\x97\xf9\xd6G\x92v:\xb3\xa4\xd4\xdf\x10\x01Hڼ\x180\x80\xfa\xf6\x87@B\x04&\xcc_\xd1\x12\x10\x94\x80xr\x84}\xc1\xe0\xa0\xdf!f\a\x02\x10\xac\r\x8c\xd3\x04\x98\xce;\xbe\xe3j\x15\x1b\xab%\x10)zR U;\x81I\x01&\xec\x1fS\xd5x\xd37q\x04\x12\xb6\xe7[\x98u\xad\x86\x9a\xad,Lz\x16\xe6\x81\xc0\xc2(\x03\x13;&&i\x80\xe9\x7f\xee\x7f\xc1X;\xe2Z\x000\x1d\x1b\xa6mC\u009fL9\xa3\xb8|\xfe4n\xbf|\x0e \x04\xb6e\xe3\xa8V\xc7\xce^\x05_\xd89č\xddC\x1cT\xaa8\xa9\x1b\x90B\x80\x05\xb15z`k\xfc\xb1!\xfdL\xa3\xd0\x00C\xba\x0e\xda0\x80\xe9\x9b0\xd1Mރ\x7f\xb7\xe7=\x9a\xe1+\xa4{i̷C~\xd3A\x02\xd1\xde\xef\x1d\x10\xd42A\x9d\x93\xabOA\xfe\\*\xfdz\xca\xddr\xads\x12\x97\x90\x9e\v\xe1\x05\x9f\xd9\x11m+\xd3sB6O6\x1f\x18\xda1I\x84\xb1\xf6kh\xd3\x10Ѯ\xd7P\x10\b\x020\xcf\xff\xbe\xa4틬 \x04D\x04A\xcf ]wK\xad\xb8\x1ftV\xf7\xf7\xef\x92H\x10\a\xe3a\xbc\x81\xe9:\x1e\t\x01L\xeb\x84\xe1\x14պ\x01O\x88\xd6rd\"\x00\x13j\x19D\xb5\x13P\x003=\x13\x17\xe8u\\^ۃ+hB\x16F(\v\xd3eaj\xa8\xf3{\xe1`\xb3+\x16F\x01LD\x88\x99\x16\xc0\x90\x01\xcf\xfd\x1a,\xed\x01)\x02\x1b\xd3\\\xe2\xe0\x9cb}\xa5\x84\xed\xcdu<p\xd7\x15x\x9e\x87\x9aia\xef\xe0\x04\xd7w\x0fqm\xe7\x007\x0f*8\xaeYp\x1c\x03\x94\x02\x9a\x16\xd8\x1a\xce\xfc%.\x82\x1e\xea\x1f\f0\x04=w\xf3Q\x01&j\xeao\xa8\xf2\xf7\xa4{\xbb\xd1\vD\xfeҘ\xdf\xdcY\xf4\f<\xd23\x11\xf7^\f\u0600\xba*\xfd\xdbM;?\x97tA<\xbfA\x05\xf1: \xabc\xfbiǙܽ\xa4\xe7_\x14e\xf0\\\x82\xb6\xc0F\x06\xcbz\x12\x80'\tD\x10\x9c\xec\x05\xa0#\b@<\tW\xf8\x91\\ö;\n\xc0\xf4\a\xf3\x8e\xb08\xc1\x17\x8c2\x98\xb6\x03ôP\xc8\xebp=\x91\xcc]\xb0\\L\x80\x89b\x9c\x14\xc0d\xc7\xc41\"a\xba\x11,\x8c\bkadh\vC\x96\xc1\xc2P\xbd\xc7\xc2\xcc\x17\xc0d\x01s\xf8,\x01f\xd8\x0e\xa7\x1dw\xb8R\x02\x86\xe9\xa0a\xdaA\x86\x94\x9f!t\xf9\xc2i\\\xb9|\x16\x84\x10ض\x83\xc3\xe3\x1av\x0e*\xb8\xb6S\xc1\xf5\xfd\x03T*U\x9c\xd4\x1a\x90RBc~\x1a\xb5\xc6;lMg\xa6LD\x80\xe9\x05\x8eT\x01&dU\xda\xce\xf4\xf4~p\xe9\x1e\xa0\x83&\xf1П\x1d\x14`\xa4\x05D\x18`\xa1d\xc7vH\x90\xee\x93\xdeϡ\nzQ\x8b\xbe\xcfN\x83z\x14\xed\x1aE\xc1\x12Y\xb0\xc2&\tiepM\x1b`\x9aw\x84RJ\x9c\xd4\x1b(\x95\xf2\x10\x8e@_\xf1\xdee*f\xa7\x00&2\xc0\xc4\xe7\xda\xe9\x8c\x03Ҫ\vCp\xeb\xda\x1e\\\x81\xf1\x16F\x86\xb00\"\x9a\x85\xc1\xa2[\x18YG\x9d\xde\v\a[\x81\x85!s\x030ݶ|\xe6&&}\x80\x89\xbb\x03\xdb\xe9\xd5ݓ\xb2\xed\nض\x11<\xa7\xe0\x9cac\xb5\x8c3\xa7\xd7\xf1̧\xdf\x01\xd7\x15\xa85\f\xec\x1e\x9d\xe0\xfan\x057v\x0ep\xf3\xb0\x82Z݄m\xbb\xa0\x94@\xd7u\xe44\x06\xd6\x11[\xd3\f\xc6\r\x0f0\xa4\x87\x01\xb2\f0!'\xf1\x91\x00ӻ\xf4\xd6c\x85\x06\xc5\x10\xf5\x00L\xf3o\x8aN\xc8\xe9\xf8\xb9\x1c\xb0?\x9a\x17\xa6\xe6\xc5LN\x02\x94\x13\x00L3[\x8e1\x8a\x93Z\x03gOm\xa2+\xd3Z\x01\x8c\x02\x98x\xd2-3\x81\xbc$X\x8e\xb7\x02\v\xb3\x1aX\x18)\xbd\xc9-\x8cT\x16\xa6\xdb\xc2tg$\x8dD\x98i\xb5\x13\b\xf9\b\xf2C\xb2\xd1\xc5z\x12\x80\t\r(\xe3\xac\xcc\b\x80\x19\xf4\x9c\x02 \x8cve\x1b\x19\x9e\v\xc3\xf6m\r\xa5\x14\\g\xb8\xed\xc26\xee\xbc|\x0e\x12\x04\xa6\xed\xe0褆\x9b{\x15\\\xdb=\xc4\xce\xfe!\x0e\x8f\xeah\xd4\rH!\xc15\n]\xf3\v\xe11J\x82e.\x19Ĭ`\f\xc0\x8c\a\x81h\r\b\xe3\x03L\xd7\xd2\xce\xc4\x00\xd3kq\x06\xc1B\xf4\x18\xa2A\xfbrԒ^Ϡ\x89\x0f\x94\x13\x03\x8c\xff\"\xce\x18\fӂ\xe5\xd8`\x8cC6\xab\xf7*\x80\x898s\xabv\x02Y\x03\x98\x96I\xed\xb10\xfdA\xbb\xca\xc2$ca\xfc\x8c$2\xaa.\xcc\x14\x00&\x8a\x85\xc9Z\xa4\xcc蘘\xb1\x1ff\xf2e\xa4\xa8\x003\xe89\b\xc0\b\xeb\xa2&\xd7\x15pl#\xc0F\n\x8d1l\xae\x95q\xf6\xd4:\x1e\xbc\xf7\nlǯk\xb3\x7fx\x8c뻇\xd8٫`\xf7\xf0\x18պ\x01\xc7\x15\xe0\x94\x82k\x1c9\x8d\x81R\xd6\nZ\x95\xbdYC\x11\x00f\xb81\x99>\xc0\x90\xb1KH\xd9\x00\x98q\x9f=4P&\x000\x9d\x17P!$j\r\v\x1bk:\\!\xfc;\x93\x05\x06\x98\x89\xe6p\xd5N`n\x00\xa6\xdd#IY\x18\xd91ߤ\x11\vS\x0fba\b\xe4\xf0\xf9\x96\xa4\x0f\x10\xb1\x00&\x1b\r\xac\xdb\x10\x13\x06\xb9҈\x83I\n`\xba4\\\xe7\x84\x17\xa4\x05\x93\xa6\xad1]\x18\xa6\rI\xe0\xb7 \xd0\x18n\xbdx\x06w\xdcr\x01 \x12\xa6e\xe3訁\x9b\x87G\xb8~\xf3\x007\xf7+8<\xa9\xa3aԃ\xf4d\x82\x9c\xa6\x83s\x06\xc6\x02[\x13\xb4\x17\x88\x060ᖡ\xb2\x010\xddi\xe8\xa9\x02LDx\x9b\x04`F\x02\xe5\x90\f6t\x948\xa7\x04\xa8\xd6\xea\xd8\\/\xcf7\xc0\xc4y\xadj'\xb0\x90\x00\x83!\xb10Kma\xa4\xec\x02\x94$-\x8c\x8bM\xf0\xa8\xd5y\x13\xb60\xb1\x00&c*\x86\xcf*\x907m\x80\x19\xf4\x9c\xf6t\xeb\xb6m\x01\xdb1[\xdf\xe3\x9ccss\x05g\xb77\xf0\xc0\xd3o\x87\xeby\xa86,\xecU\x8eq3\xb05{\x87U\xd4\x1a\x06\\\xcf\x05\xa3\f\x9aơs\x06\xaa1\x7f(\x12\xd2^^\x18\v0\xe3cI\xa2,C\xa5\v0\xd1\xec\xd3H\x80\x19\xf2\x1e]\x17\x80\xa9\x01L\x0fP\x8e\x01\x98\xe6הQ4\f\v\xae#A\xd1j\xf157\x003@\xc5,<\xc0\xa8bv\xa3\x01\x86@\xc0vs\xd8.W\x95\x85I\xcd\xc28-\vC\xbb\xaa\xf3N\x7f\x19i\x11\x00\x06\xe3\x02{\x17\t`\xfa\xbau\x83\x80\xb2\xce\xf7 \x10B\xc20-\x98\xb0\x82U(\x86\x9c\xceq\xe5\xe2\x19\xdcy\xf9<@\x00ӶQ9\xaaa\xef\xe0\x18Ww\x0f\xb0\xbf\x7f\x84J\xad\x8e\xc6I\x1d \x80\xc6Y\xab\xaf\x92\xdf%\x1b\x91\x97\xa1F\xa5\x7f+\x80!\xe1k\xfb\x8c5X\xe1\x1aC\x0eZRc\x84\xc1v=\x18\x86\x81r\xb9\b\xe1z3\x04\x98\x98\xa6a\x01\xda\t(\x80I\x00dѴ0\x14\bz$\xf5Z\x98\xae\x9eG\xca\xc2Lha\ue0cb-p\x1c\x0f\xb70\n`\"@\xcc\x12\x01L\x98\xa3\xc5\b\xed\x1a\x11\xae\xed\xc1\xb1\x9dV\x8d\x13\x8d1\x9c\xdaXŹ\xedM<\xe3\xee+A&T\x03{\x87'\xd8ٯ`g\xaf\x82\x83J\x15\xf5\x86\x01\xcf\xf3@\x19\x83\xce}c\xe3gB\xa1\xa7\x1fT\x14\x80\x19\xbc\f\x95E\x80\xe9\x06\x99h\xcbP\x91\x01&V\fQ|\x80i_<%\xaa\x86\x89\x95r\xa9\xe3~J\xb5\x13P\xed\x04\xe6\x03`z\xb3\f}\vs4\xd0\xc24\xe1d\xfa\x16\x86̡\x85!c,\xcc3@a\x0e\xb70\n`\xa2\x9a\x98\x05\x03\x98P+\x87=\x9d\x8b\x87<\x87_\xa9\x1f\x04\xac\xa3\u3000a\n\x18\x96\r\x04\xc5\xcf\xf2z\x0eW.\x9d\xc5Sn\xbd\x00H\t\xc3rptR\xc5\xee\xe1\x11n\xecV\xb0wp\x84\xa3Z\x03u\xb3\x0e\x02@c\x1cZ\x8eC\xa3\f\x94\xd1 s\xb7c\xf2K\x19`\xfa\xab\xf9\xa6\t0Igq\xcd\x1e`\x9ao\xce)C\xbd\xe1\xf7\xf9\x8a\n0\x11\xe7\xeb\xb9\a\x98\xb0\x7fLU㝎\x89뽬\xfa\xb10^\x06-\f\xa6jad\"\x16\x06\x03-\f\x93u\x98\xf4>x\xd8\x02\x1bfa\x14\xc0D\x87\x98\xb9\x02\x98PGi\xfc2Rh\x80\x19\xf2\x9c\xb2\u0590\x05\x00X\xae\v˱\x83Rp\x14L\xa38\xb5\xb5\x86s\xdb[x\xf0\xe9\x14\x8e\xe7\xa2Z3\xb0_\xa9\xe2\xe6~\x05;\xfb\x15\x1cVN`\x18\x16\\\xe1\x811\xdf\xd4h\x1a\x87\x16\xc4\xed\x80HH1\x1a\x04\xc6N\xe2\x032~\xa2\x00L\x9cV\f\x8b\x060\xa3\f\x16c\x04\x8e\xe3´\x1c\x14t\r\x8e\xf0\xe2\xccۋS\vF\x8e{OU\x8d74\xc0\xa4h\xe2\xfa\xef\v\tl\x97c\xbb|\x82U\xad>\xd4´L\xcc\x02[\x18\xa4ha\xd0\xcaH\x1aba\xe6\x0e`\xb2Q(\x86g\x05`\xc2\x1e\x85I\xe3`&\x05\x98\xae\f\x1a4\xab\xccv\x94\xf0'\x81\xad1\x04L\xd8\xfe\xcf)E!\x9fǕ\xcb%\xdcy\xeby\b)\xfd\xba5\xc75\xec\x1e\x1cag\xff\x10\xfb\a\xc78\xa9\x1bh4\xfc@c]\xe3\xe0\x01Դbk:\x1b]\x8e\xcb\xe6\x99W\x80邐\xec\x02L\xf3=\x04$ꆁb^\x87\x10\x03\ueb56\xa5\x98\x9d\x1c\xf7~\n`\xb2`\xe2\xfa/\xbbA\xb7\xb5\x10\xb10]\xb0\xa2,\xcch\vC\xfa-L\x83\xde\x17\xf4H\x1a`a\xe6\x0e`\xb2\xa3g\xf8\xd4\xda\t\x8c\x17(\xa9\a\xf2\xa6\x010]\xdf\xe9x\xee\xd7\xe2k\a\r;\xb6\v\xdb\xf6\xa1\x86P\nF\x19\xb6\xb7\xd6p\xfe\xcc&(}\nl\xc7E\xadn\xe0\xa0r\x82\x9d\xfdC\xec\x1e\x1c\xe3\xf0\xe4\x04\x8d\x86\x05\xe1\t0F\xa1\xeb\x1cL\xe3\xd0\b\v\u0381\xceؚp\xcbP\x99\x01\x98\x81u[\xc6W4nw\xe9F\xc4,\xae\xe4\x01\x86\x04\x80Zo\x98\xd8\xdaXU\x00\x13~Z_\x1e\x80\t\xc54\xd3\xcfDj\x8f~\t\xc3\xd5p\xb6|\x9c\xbc\x85\x11Kla\xd0oa\x8ca\x16f\x0e\x1b:\xca6\xfef\xc1\xc4\xc4\a\x98\xb8;p\x16\x99H\xd3\x04\x98\x81\xcf)\x82\xae\xc7\xed*\xc3\r\xd3mw\xf7\xa6\x14ł\x8e\xf5\xd5\xf3x\xcam~lMòQ9\xa9ao\xff\b7\xf7+8\xa8\x04\xb6\xc61A\b\xa0q\xde\xea\xdeM\xa9\x1f[\xd3u\xd1\x18ۖ`\xf6\x00C:\xca!\xcf\x1a`\xe2\x04AsJa9\x0el\xc7\x05g\fbD\xf5\xdee[B\n\xf3\xc7\x16\xa9\x9d@\x987\xc8\x12\xc0 (v\xcf(\xc1\xadk\xbbp\x92\xb60rq-\f\x89dajh\xd0\a\x06[\x98\x8c\xb5\x13\bka2T\xeb\xae\x19\xd8;\x00XB\xdb\x13d>\x95z\xe6\x003h?4\x8b\xf1u|\xcfr=Xv\xc3_m\xa4\x14\x8cQlom\xe0\xc2\xf6\x16(\xa5\xb0\x1d\x0f\xb5F\x03\xfb\x87\xc7\xd8=8\xc2\xee\xe1\x11\x8e\x8f\xaah\x18&\x84\x90`\x8c@\xe7Z+\x13\nA\x1f\x94\x8e\xb25\xd3\x05\x98а\x10\xa5\xa7\xd4\x10\x80\x19\xb1\f\x95\x16\xc04\x7f\xeey\x1eL\xd3\xc6\xeaJ\xc1\xdf\xd7\xcb\xd4N\x00\x11-\xcc\x1c\x01L8\xa2\x99\xafZ0\xddW\xb6\xb6\x85YI\xd0\u0088\xb4-\f\x99\xbd\x85Ah\v\xe3\x024\a\x83\xdf\xdfoa\xb2\xdaN`\f\xc0d\xb2\xed\xc0\xb8}7ϵ`\xa6\x0e01\x06Vw\ag\xd2\x11[#a\x18&\f\xf8\x16\x87R\x82b>\x8f;n)᩷_\x82\x10\xbe\xad9:\xaeb/\x80\x9a\xc3\xca1\xaau\x13n\xc3\x02h\x90\t\xa510FA)\xf3\xef`:\x1aB\r\x03\x18B\x10\xa2\x19f\x7f\x11\xb8\xd4\x01f\xe0vGi\x8a\x99|\x1a:\x01E\xbda`\xad\\T\xed\x04沝@T\xb8\x9b\x7f\x80I\xd3\xc2ȴ-L\xc7~\x9a[\v\x93\xd5v\x02s\x060\xf0\xb3\x93\x16\xb7\x98]\xe2\x00\x13\xd1\xc7\r\xb20$\xe2s?\xa8\xb7\xd3ָ\xb0\x1d\xa7\xf53J)\xb6Om\xe0\u0099-\x10B`9~&\xd4a\xe5\x18\xbb\x87Gد\x9c\xe0\xb8Z\x83aؐD\x80\x11\x06Mc\xe0\x9c\x83\xf9A\x1d}wL\xdd\x003\xa4\xa2\xad\x02\x98\xd6g`\x9c\xc0\xb2m8\x9e\x98;\x80Q\xed\x04\x96\xa3\x1a/\xfa\xae\x8eM\vSɾ\x85!\vha\xa2M&\x91-L\xea\x00#\xb3\x13\xdb\xcb\x15\xc0D\x18\x1d\x13.#E\x05\x98\xde\xe5\x1a\x00>xt|\xcfτ\xf2m\x8d\x04\x01\xa5\x04\xa5B\x0e\xeb\xab\xe7p\xe5\xb6\v\x90B\xc20M\x1c\x9d\xd4qP9\xc1\xde\xe11*'U4\x1a&\x1a\xae\vB\x89ok\xb8_\xb3\x86Q\x02\x80\x0e\xae0<\xa0\x11b*\x00\x13\xbanK\x94\x9eR᳸\xc2\x02L\xe7\x05\xd7\xf1\x04,\xcbF)\x9f\x83\x1b\xac\xdfʹ\x9d\xc0D\xa6A\xb5\x13Xd\x80AP\x17Ʒ0\xfb\xbe\x85\x11\xb2\x03D2fa0\xaf\x16\xa6\x8a\x06}0\xa6\x85\xc90\xc0du9i\xd1\x01&\x1a\xfb&\x1f\a\x93\x04\xc0\x90!\x95\x87)\xa5>\x18\a'\xb3e\xdb\bj\xf1uٚs\xdb[ \x04\xb0\x1d\x17Ն\x81\xa3J\r\xfbGG8<\xaa\xa2Zm\xc00-H\t0F\xa11\xdejt\t\x00DR\xbf\xd1e\x92\x00\x13\xa2\x9dBb\x003\xaax]\x9f\x89\t\x0f0\x9d\x17YðP*\xe6\x01\xa1\xda\tD\x03\x98\t>\xaf\x02\x98q\xd3߀\x9fK\x98\xae\x86s\x9d\x16\x06^WF\xd1P\v#\x94\x85\t\x9f\x91T\x80\xc1\x9f\xd1ma\x14\xc0\xa4\x031\v\xdfN\x00\xb3\r\xe4M\x13`\x06M\\\x94vǎ7mM\xf3\xa4圢\\(`\xbd\\\xc6\xed\x97\xcfCH\x0f\x86e\xe3䤎\xc3\xe3*\xf6\x8f\x8eqt\\\x87a\x9a\xf0,\xff\x0e\x853\xea\xdb\x1aB@\x9b\xcbPB\x8c\xa8\xdb\xd2\r\x93\x93\x00L\x94T\xec\xc9\x01&z\x16\x97\xbf\xcf\x01ö!\x85\x8c:\xb3M}\x19i\x16\x00\x13\n1T5\xde\xe4L\\\b\vs9\x8e\x85\x91\xe3-L3\xc9@Y\x98\a\xe1`\xa3ma\x16\x05`H\x02\x8d\xb7\x93\x84\x98\xa5m'\xb0\xa0\x003t\x1f\x05)\xd8\x00 <\xc0u\\X\xb0;l\r\xc1\xe9\xad5\x9c=\xbd\x01I\bܦ\xad9\xa9\xe2\xf0\xa8\x8a\xe3j\x1dպ\x01ô\xfc\x1eS\x94\xb6j\xd6\xf8P\xe3\x9b \x810\x05\xed\xd2\x01\x98a\xc6$M\x80\xe9l\b\xe9x.l\xd7\x01\xe7Z\xbb\x93y\x18\x90X\x02\x80Q\xd5x\xa7c\xe2\xc6-#uZ\x98Ք,\f\xa5\xc9[\x18\x12\xc2\xc2\xc8,[\x98\xdek\xfa<\x03L\xe6L\x8cj'\xb0\xf0\x003x\t\n\x1d8-\x83\x0eޮ\xffN\x04\xe0\x8c\xa3\\,`m\xa5\x84\xdb.\x9e\x83\xe7IX\xb6\x85㚁\xcaq\x15G'UTk\r\x18\x96\x05\xe1\n\x10\xe2\xb7[\xe0A?(J\xd15\tǆ\x85\xcc\x03LG6\x97\x90h\x986\xd6\xcb\x1aĈ\x89}\x99\x00&\xd4\x16\xa9v\x02\xc9-#\x91\xd13N\xd3\xc2ܲ\xb6\x0f;C\x16\x86\x8c\xb10\xbd{t\x90\x85AF,\f\x97U\xd4\xe93\xdb\x16\x86P\xb5\x84\x94\xf6rR\x9a\x00\x13\xf6(,Z-\x98\xb4\x01&\xe2\xa50\xd4\f\xd5mk\x04\\G\xc0w+M[ð\xbd\xb9\x863\xa76\x00\x00\x8e\xe3\xa0n\x988\xa9\xd5Q9\xae\xe1\xb8ZG\xdd0aX\x16\x00\tF98\xa7\xe0̷5r\xc0I7n\x19\xaa\x0f`B\x00Gb\x003&\r\xbd\xf7{\x94P\x18\x96\x8d\xb5rI\x01\x8c\x02\x980\x1f{\xaa\x00C a\xbb\x1aΔ+X\xc9\xd5Q3\xb3ca\xb0@\x16F\xd2\x02\xea,\xc8H\"\xb2\xaf>X\xb6\x01&\xec\x12e\xc6 &6\xc0\x84\x13(KW\xcc.\t\x80I\x7f\xf2¸\xce6=\xb6\xa6YeX\x00\xf0\x1b\x1dr\xceP.汶Z\xc2\xe5\xf3g <\x01ӲQm\x188>\xa9\xe3\xa8ZC\xada¶lx\xc2\x03\xa1\x14\x9cR0\xce\xc1@@\t )i\xaeA\x85\xef\"\x9d0\xc0\xf4\xb7d\x88\x060\xcd\xf7e\x8c\xc2u\x1c\xb8\x9e\v6\xa0z\xefR\xb4\x13@\xf4\x99|Q\xda\t\x84\xa5\x9cY\x00L\xd3\xc2P\n\xdf¸\x8bia\xfc\ud7fd\x85\xf1\xc8\x06\x18\xe9\xae\xce;\x0f\xed\x04\xa2.#e\x81k\xf8h\x80\x89v\xf6\xa8j\xbc\xe1\x80%\x1c\xfc\x91\x14'\xaf1\x003b\xf2\xa2T\xb6NL!$\\\xe1\x01V\xbbn\rc\f\xa76\xd6pzsݷ5\xae\vðP\xad\xd5qT\xad\xa3V7\xd10MX\x8e\x03\x10\x02\xda\x04\x9b\xa6\xad\xa1\x12\x90\xd9\x04\x98\xf6\x18\x19\xbeMBHX\x96\x8dr\xb1\xe0;,\xd5N`N\x01&\x16\x9fd\x16`\b$,W\xc3\xf9\xf2\xd1B[\x98nd\x99\xa1\x85!FW1\x95yi'\x10\x16`2T&fD\xc5\xde\t\x97\x91\x96\x16`b\xd0s\x1aq0i\x00̠\x1f\xfaLӾ\xdb\xf0\x84\x80c\xba-\x95\xc3)E\xb1\x98\xc3j\xb9\x88\x8b\xe7\xb6\xe1\n\x0f\xb6\xe5\xa0\xd60qRk\xa0Z\xaf\xa3ް`9\x0e\xa4\x90\xa0\x94\x80R\x06\xc6i\x90\t\xd5\xfc\xab\xe3bVz\x8e/\x19T0/]\x80\t\x92\xb6`\xda\x0eJ\xa5\xa2j'\x90Q\x80\x99\xf0\x93\xce\x1d\xc04\x7f\xc6(pi&\x16\x86,\x8f\x85aτ\xc76\xc0d\xdb\xc2\xccu;\x81!\xbf\x93\xad\xdeI\xaa\x1aoD\x80\t\x7fe\x99U \xef\xb4\x00f\xd8ż3\xc5[\b\x0f\xae\v\xd8p\x82\xddNA\x19\xc3\xe6\xfa\n\xb66\xd6\x00\b\xb8\xae\x87\x86\xe5\xa0Vo\xa0Zo\xa0^7aZ6lυ$~\x93\xc5f/)J\x18d;\a\xaa\x1f`\x06\\\x10GY\x9c\x89\x00f@\x06\x16\xa5\f\xb6\xedBx^v\x00&\x9aTP\xed\x04\x16\f`\x18$\x1a3\xb50X\x1e\v\xc3\xef\a\x91m\v3\xd7\xed\x042\xbc\x844\xc0\xc4(\x80\x89\xb5f\x98\xc1L\xa4Y\x03̠\xef\xb6bk\x82\v\x89\x10\x02\xa6부\x87R\xa0T\xc8a\xa5\x94\xc7y\xb2\x05\xe1\xf9K2u\xd3B\xbda\xa0V7aX&lǃ\x90.(%\xe0\xd4\xef\aE@\x82\xa0a\x199\x8e&i\x80i\xbe\x87\xe7y\xb0\x1d\x0f9]\x8372\xd5zJ\xfd\x90\xe6\xb4\x1ao\x14\x80Q\xd5x\x87\xbfV(\v3=\v\xd3Y\x17f<ZD\xb60\n`\x06B\x8cj'\xd0w@\xe74\x95zV\x00\x13v\x96\xe9\xfc\xb5n[\x03?\xb6F\xc8ָc\x9cac\xad\x8c\xcd\xf52 \x00\xd7\xf3`v\x80M\xc34a\x99\x0e<)[uk(a`4\\\xe6Qh\x80\x89\xd8ջ\xf9\xb5i\xda\xc8\xe9Zb\x13W2̢\xfa!-\x13\xc0\xf8\xb7\x0e\x81\x85Y9\x8aP\x9dWİ0d\xa9-\x8c\xa0\x05\xd4\xf93@\xd0\x18ca\x14\xc0\xa4bb\x16\x03`\xc2\x1c\xd4ŭ\x05\x93\x0e\xc0\xc4\\\x8e\x180Í{?_\u0590\xd6ݷ\x10\x02\xae\xeb\xa7x7mM\xb1\x90G\xb9X\x00\xd9ڀ+\x05lǃ\xd90Q7M4\f\v\xa6e\xc3\xf5\\\xbf\xb6\x14\xf5\x97v\x18%\xe1\x00f\xc82T\x94\xaeޝ\x0fӵ\xb1\x8aR\xb2\x13\xf5ܥRO\x10{\xa3\xaa\xf1\x86\x00\x98\xf1\xaf\x17 `,\xb00\xa1\xeb\xc2\xc8\x18\x16\x06Kja\b\xb8l\x04\x16f\xbdea\x96\x06`2@6|>\x00&\xe4a^\xd2bv\x93\xa4QGƓ\b\xcbHa\x01f\xd8\xc4ՙ\xe2-\x04 \xe0A\x88\xf6\x05\x87S\x8a\xb5\xd52\xd6\xd7J\x90\x12\xf0<\x17\xa6\xed°l4\x82z5\x8e\xe3A\xba\x1e$!A\x83K\x06\x1a\xb2-A\\\x80\xa1\x94\xc2s\x05\x1cǅ\xa6\xf1v\xaau\xca\xcbHY60P\xd5xS0q\xa3\x97\x91\b$\f/\x88\x85\xe1\xca¤ca\\\bZ\xf4ca`\x80\f\xcd\xdbY0\x80\x11\b\x8a\xf8\xcd>ė\xcf\x1c`\"\x00\x8a\xaa\xc6\x1b\xe7\x02\x1dY\xa1$\x12\a3)\xc0\f\x1d\t\xed\"\xc3\x10R\x04\xcbPm[S\xc8\xeb(\x17\xf2\x90\x1b\xabp\x85\x84\xe7\xba0L\x1b\xa6e\xc1\xb0lض\x03\xcf\x15\x90\xc1\xb8\xec\\\xcb\xef[6\x8a\x010\xed\x8f\xee7\xe2\xd44\xae\x00F\x01\xccď\xa8q0舅\xb9\xbct\x16FN\xd9\xc2<4&\x16f\x01\xdb\t\xb4\x0e\xb8\xc8\x02Ĩv\x02\x8b\xd4N \x99e\xa4l\x02̠\x89\x8b\xb6\x95\r|\xe1!\xe0B@\x04\x97\x13F\x19V\xcbE\xac\x95K\x90\x90\xf0\\\x0f\xb6\xeb\xc1\xb0mX\xa6\t\xcb\xf6\xe0x\x0e\x84'\xfd\xc6m\f\xa0\x84M\x040\xcd#o\xd9\x0e\xcae\xb9\xd4\x00\x13iYr\x01\x00&\xf4\x91M1\x90\xd7\x1f\x9f\x12\xa6\x9bù\xf2!\xca\x1d\x16F\xa4aaH\xd6,\f\xfa-\x8cL\xd3\xc2\xdc\a\x82\xc6\x10\v3\xdf\xed\x04\x86\xbe\x9e\xa6D\xebqML|\x80\t#PT5\xde\xc8\x00\x13G\xa4d$\x13i\x9a\x003\xeau\xb4\xcb\xd6t\xde\x1fQ\xe4u\x8aB^\aVK\x10B\xc2q=؎\x03\xcbv`\xda\x0e\\ǅ\x10\x9e_\u070fP\x10BAh\xf7\x85l܃R\n\xc7\xf1\xe0\xb9\x02\x8c\xf9E𖣝@Lܞ\xf7v\x02\xa1_\x9b>\xc0\xb4-\x8c\xc0-k{]\x16F\xa6aa0\a\x16\x06\xb2\x05+\xa1-\f\toa\xf8@\v3\xdf\xed\x04\xc8(\v\x83\xecD\xfa\xf2\xe1\x00\x13\xedӫj\xbcɴ\x13\x00\xe67\x95z\xd6\x003ꦡ\xf3\xf2\xee\t\t\xaf\x03l8gЃ\x16\n\x90\x04\xae\xe7\xc1u]ض\vӱ\xe18\x02\x9e\x10\x10\xc2\x03(\x05\xedɮ\x1a\xf6\x10R\xc0\xb6]\x14\nzpG9\xa7\x00\x13u\nW\xed\x04f\n0\xdd\x16\xa6\xb1\xf4\x16FƵ0\b\x17\vC;2\x92\xa6\r0QH%\x99@^\x92ޝ\xcc$&&*\xa0\xa8v\x02\xa3\x9e\x8f[=[\xccZ0\xa9\x03\xcc\xc4f\xaa\xfd\x03\xd9\x036\xc2\x13]+\xbb\x14\x80\xae\xeb\xc8\xe9:ʤ\x04)%<υ\xed8\xbe\xb5\xb1]\xb8\xae\aOx\xed\xb1\x1c\xc0M\xef\xb06l\vł\xae\xda\td\x06`b\xf1\xc9\xdc\x00\fH\b\v#\x92\xb70$\xc3\x16\xa63\xe5\xb9\xdb\u0090\x89,L\x8d=\x04\x0fk\xe08鹢$\x010\x115\xc2T2\x91H\xd7\xf1!Y\xe9\x9d\x14o\x19iY\x01&\xaa\x9dZ\x8ebvi\x01̘Y,6\xc0\x8c5(\x00dWF\x91\x04c\f\x05\xc6P$\xfeE\xd5s%\x1cO\xc0q\x1d\x1fn\x1c\x1fj\xa4\xec<\xd7)\x1c\xdb\x1bS\xf0.=\x80\x89\xbe{U;\x81y\a\x18\x02\xdf\xc2\\X\x1daa\xe4x\v#D4\v\x83\xb9\xb40\x98\xc8\u0098\xfc~P\x18=\x16&\xa9~HYK\xa5&#\xec\xf6\xcc!F\x01Lԁ\xa5\xaa\xf1\xc6\a\x98ɸ%\x05\x80\x19\xfb\xf1\xdbuk\xba~H\x00]\xe7\xd0u\x8e2)\xf8)ޮ\v\xc7sa;\x1e\x1cǁ+\x04lہi9(\xe4s\x03R\xad'\x1c\x03Q-\x96j'\xb0\xd0\x00\x83\x8eX\x98K+\x93Y\x98&\xecL\xdb\xc2\xc89\xb10\xf5\xc0\xc2\xd0.\v3\x99\x97\xc8n-\x18\x12\x11\xaff`b\x96\x01`\xc2\f!U\x8d7\x06\xc0D\x9dhf\xb2|0\x19\xc0\f\x9b\xb8d\x0f\x940\xce\xc18G!\xe7_<=!a\xd9.(!\xcdԩ\x89\xc6@\x94\x0f\xaf\xda\t,\x1f\xc0\xf8\x16FÅգ\xb9\xb50\x98\x13\vc\f\xb40H`\x19I\x01L\xd4\a]\f\x80\x89z\x81P\xd5x\xd3j'\xb0,\x003p\x19*\b\xfe\xf5<\x01\xd7\xf3\xef\x16\xf39\x1d\x9c\xb3D\xaa)\xa8v\x02\n`F=Z=\x92R\xb40]veI-\x8c\xc1\ue18b5\x00\xcep\v\xa3\x00fz\x103\xef\xed\x04\x10\xc2\xc2(\x80\tu9\x0f\xc94\xd9J\xa5\xce\n\xc0\f\xdb!bL<\x8c\xaa\x05\xa3\x00&v0g\x8f\x85\xb1\\\x8e\xb3e\xdf\xc2خ\xdf\xed]Y\x98\xe1\x16\x86ı0\xac\b\x83\xdf\aֲ0\n`f\x0f1\xb3\x06\x98H\x80\x02U\x8dw\x02\x80\x89B,\n`\xa2N\\\xd1w\xbf\x02\x18\x050\x11\xa7\x96\xa1\xb2F\x80\x80'haD\x1a\x16\x86d\xcb\xc2 \x11\v\xb3\xf8\x00\x93\xf5\aM\x13`\"J\x14U\x8d7\xf2D\x1d\x87S\x16\xa3\x98]:\x003\xe1\xdeU\xd5x\x97\x1c`b\xe2\xca\x04q0\x9d\x16\xe6L\x82\x16F\xf6Y\x98N\x10\x89ia\xb0H\x16\x86,MG\xea\xac#\r\x8d\v0ѵ\xa7\xaaƫ\xda\td\v`\x90\xd8\xe4\xa5\x00F\x01\f\xa6\x1e\xc8;]\v\xd3\x11C\xa2,\xccܷ\x13@H\v3\x0fN\x86\xc6\xed\x7f\xa4\xaa\xf1\xc6\x05\x98\x88S\xa9\xaaƛ\xb2\x99R\x00\x93\xbc\x95\x9aW\x80\x99\x9e\x89K\n`\xda\x16\xa6\x92\xb8\x85\x11)X\x182\xd7\x16\xe6\xfe\x0e\v\x93\x15\x80\x89\xaeP\x12\x8d\x83\xc9\x00匭Y\xa3\xaa\xf1N\x0e0Q.\x9d\n`&\x9bHf\x11Ȼ\b\x00\x13\xeaH\xa9v\x02\x99\x02\x98n\v\xb3\x0f\xdbK\xd6\xc2\xc8\x14,\f\xe6\xd6\xc2\xdc\x03\x17\xab\x00\xec\x01\xf3a\xf2\x00\x13\x85Tf\x16ț\x11MCU5\xde\xe8\x00\x13\xebҨ\xaa\U0004e678\xe2\xee\x17\x050I\xf0\x9fj'0\x7f\x00\xd3\xceH\n,\x8c\xa7,L:\x16\xa6\x14\xc4\u0098\x00\xd8T\x00Fe\"\xc501\n`\"\xb0\xaf\xaa\xc6;\xd1\xc4\x15e\x1aU\x00\x13\x02`ƾ\xa7j'\xb0h\x00Ӷ0$\x1d\v\x13\xbcNY\x98\xdeX\x98\xe8\x00\x13ݭd\x1c`\b iv`'T`\xef\xbc\x03\f\"_dT5ި\x00\x13\vW\xe6\xb8\x16\xcc\xf4\x00&\xbeF\x99\v\x80\x89\xfa\xda%\xad\xc6\xdb\xff\x1a\x01\xdb\xd5q\xb6\\A)\r\v\x03\xd9\f\x98T\xb10}uab@ɢԂ!\xbd\x17\xb6\xd9wQ\xa2\v\x010\x91\x06\x86\xaa\xc6\x1b\x16`\"ͣKT\xcc.i\x80\x892\xdd/\\?$U\x8d72\xc0\xf8\x16\x86\x83S\x89K+\xbb\xca¤\x1e\v\xd3S\x9dwY\x8b\xd9uL\xbf\xb4\xe5@Ҹ͍lb2\x0e0\x11\xfd\x9b\xaa\xc6\x1b\x17`\xe2\xdf-+\x80I\xc8LA\xf5CR\x00\x13\xee=mWÙr\x05%nL\xcf\u0090e\x8c\x85\xb9\xbf\xdb\xc2(\x80\xe9\xf8F6\x16\x94h\x16\x00&\xdc\xceS\xd5xcM\\q\xa6RU\x8dwb\x80Y\xa6Z0\n`&\a\x98(\x13\x93\x04\x01\xa7\"\x01\v#\xa2Y\x18,\x9b\x85\xb97\xc8HrZ\xf7\xfb\xaa\x9d@\x9c\xdb\xdc)@LZ\x00\x13\xed|U\xd5x\xe3\x02L\x94q\xa6\xda\tD\x9d\xb8b\xeer\x050K\r0Q\x1faY\x87\x00\xb0\\\x1dgˇ\tX\x18\xa9,\xccH\vso\xdb\xc2,s;\x81\x81\x16&;\x0f:1\xc0\x84:1U5ި\x00\x13\x9dST5\xde\b\x18\x10m\xef\xaaj\xbc\n`\xe2\\\xf7\x13\x8e\x83! AF\x92\xc0ŉ3\x92\x94\x85\tma\b]\xdev\x02\x19\a\x18\xf4\xc6\xc4\xf4\x01L\xa8\x0f\xa9\x8a\xd9Ś\xb8\xa2^6U;\x81\t\xda\t`*w\xdf\xcb\xd6N`9\x00&\xfa\xf9\x91\x16\xc0\xf8ua\x9a\x16\xa6\x91\x8c\x85\x11b\x8e-\x8cL\xce\u0090!\x16\x86ȹ\x01\x980\xf3\xf4\xa2\x01\f\xc6\xe6G\xa9j\xbc\x89\x01L\x14JQ\x00\x93Ф9\xc5\xe5\x03\xd5N \xcb\x003\xe1h\xca\x00\xc0\xa0U\x17&\xa4\x85\x11!-L\x8f\xad\x99\x9e\x85\x91\tX\x18$ga\xd0ca\xe8*@\x1c\x90\x90)\xc4Sm'\x10լ,0\xc0tC\x8c\xaa\xc6\x1b\x19`bO\xac\n`\"N6\xaa\x1a\xef$c \xac\x92R\xed\x04\xb2\v0\x91-\x8c̺\x85\xc1x\v#\xa7ma\x1c\xdf°\xfbA\xa4\x01\x12\xf2\xc8\xc7\x02\x98\x10\xaf\xccV&Rv\x1fT\x01\x8cj'\x90\xee\xc4\x15\xd7L)\x80IB+\xa8v\x02\xf3\x0f0]\x16fu\x89,\fBX\x18\x92\x94\x85\xa1\xe0Ҁ\xc1\xee\x83\xcb\xca`\xb0B\x15r\x8b=\x8f\xccU*u\x12P\x96&Ĩv\x02\xb1,\x8c\x02\x98ɦQU\x8d\x17\xaa\x9d\xc0d\x9fti\x00\xa6\xcb°\xf0\x16F\xa4`aH\xd6,\f\x92\xb206\x04-\xa1\xa1\xdd\a.\x8dT\xa6\xe7\xf9\xac\x053\xecZ\x90\xa1\xb6\x03\v\x010\x11iYU\xe3U\xed\x04f\x0f0\xf15\xca|\x01\xcc$\xfbF\x01L\xcb°\xe8\x16F\xa6`a\x10\xc6\xc2t\x00\xce\\Y\x18\xed>x\xa4\xdc]\x9d7!\v\xb3H\x00\xd3&ǌ@\xcc|\x00L\xf8+\x89\xaaƛ,\xc0\xa8bv\xf1\xc7@\x14BY\x84T\xea\xc1c@\x15\xb3\xc3D5\xef$,o\xce,L\xf0\xfd\xb9\xb30\xbciad\x84c\xbf|\x00C\b@g\xdf2\xa9\abf\b0aOeU\x8d7\xb9v\x02\n`\xe2O\\\xb11e\xc1k\xc1DYBR\xed\x04bX\x98\x95x\x16F(\v\x13\"#ɷ02\xa4\x85Yj\x03\x93\xd6d3\x11\xc4̪\x9d\x00\xc2Z\x18\x050\xaa\x9d@v\x00F\x15\xb3S\x00\x93F;\x81AS\x19!\x81\x85)\x05\x16\xc6\xed\xb40\"\x94\x85\x91\xca\u008c\xb10\x0e\x04-\xa3\xc1\xef\x03\r\x11\v\xb3\x1c\xed\x04F[\x98\xac=\xe8d\x00\x13\xe6 \xaaj\xbcq\x01&\n\t\xabj\xbcQ'\xae\x98\xbb\\\x01L\nfj~\x00&\xf2E?&\xc0tZ\x98KM\v3*\xd6EY\x98\x84,\xcc\x12\x01L\x8ce\xa4,>\x86\xc7Ą\xdd\v\xaa\x98]$\x80\x89~\xd1W\xd5x#`@\xb4\xbd\xab\xaa\xf1N\f0\x8b\xd0\x0f)2\x94\xa4\x1c\xc8۲0\xae\x8es\xa5C\x14\xe7\xc8\xc2`.-̽c-\xcc<\xb5\x13X&\x80\xc1\xc8\xc5?U\xccN\xb5\x13\xc8\x18\xc0\x84\xdd0U\x8d\x17\xaa\x9d\xc0$\xe7\xe4\x8c\x01\x06\xa43\x16f\x17\xb6G\xe6\xc6\u0090\x19X\x18\x92\xb2\x85Y\xf6v\x02$۵\xee\x86\a\xf6*\x80Q\xed\x04\xa6\x010\xf1w\xad\xaa\xc6+\x91\xcc~\x9d\xed8H\x10`R4q\xd3\x04\x98f]\x18\xdf\u0098\xb0]\x1a\xdb\u0088%\xb00H1\x16f\xd9\xdb\td\x1d`0,\xb0\x17\xaa\x9d@\xbc\x89U\x01L2w\xc1\xaa\x9d\xc0\x84\xed\x04\xe2Y\x89,\x03\xcc,M\xdc4\x01\x06\xadX\x187\x11\v#gea\xe4\xbcX\x98\xd2P\v\xb3\xec\xed\x04\xe6\x01`0(\xb0\x17\xaa\x9d\x80\xaa\xc6;\xc1\xc4\x15~2R\xed\x04&\x99\xbb翝\xc0$\x16\x0e\vU̮{\x82iZ\x98\xa3\xf9\xb60Ȳ\x85q\xc7Z\x98\xa5o'0'\x00\x83\xde\xc0\u07b9\x04\x980\xc7O\x15\xb3\x9bh\xe2J\xceL)\x80\x89\x8d(s\x94J\x1d\xa5J\xf1\xb2W\xe3\xed}\xbdoa<eaR\xb50\x8d\xb1\x16&\x16\xb7,\x1d\xc0\x90P\x95\x8d\xa7\x031\x99\x06\x98\xf0W\x1aU\x8dW\xb5\x13\x98=\xc0\xc4\xd7(\x8bP\v&J,\x94\x02\x98\xee\xd7/L,L\xe6-\xccjb\x16fY\x01F\x82\x80\x82\x84\xee\xf4\x9d.\xc4d\xb9\x9d\x00\xa0\xaa\xf1\xa6\x040\xaa\x98]\xfc1\x10\x85P\x96\xa9\x98\x9d\x02\x98\xc8\xd3X\xd7C\x80@c\x1e.\xae\xec%naDF-\x8c\x9c\x89\x85\xb9g\xa8\x85Q\x00\x13\xe5J'3Q\xb8\x97\xa6\x0e0\bq\x80U5\xde\x18\x003\xc14\xab\x00&\xac\x1c\x89\xf8y\x15\xc0(\x80\x89|\x7f\xd6ea\xcevZ\x18\x99\x9c\x85A`aH\xc6,\fBY\x182\x15\v\xa3\xda\t\x84\x1f\xb8T\"F\xfb\xa0\x94 &>\xc0D=QU5ސ\x97\xf3\xe8S\xa9\xaa\xc6;\xd1\xc45`0(\x80\x99$\x98{\t\x00&\xda%\x90\x8c\x9d\xb1\x06Z\x18\x19\xc2\u0088h\x16\x06sia\x90\xac\x85A\xbf\x85YD\x80\x894FI\xc4ќ\xbd.\xd6$\x06T\xa9j\xbcq\x01&\x8a\x12P\xd5x\xa3N\\1w\xb9\x02\x98\x14\xcc\xd4\xfc\x00\xcc\x04\x12e\xa28\x98N\vs>\x8e\x85\x91a,\x8c\xa7,L\xd3\xc2h\xf7\x81\xa2\xd1\xef(\x16\xb0\x1fR\x1a\x81\xbcYLZ\xa2\xa3\x00E\x15\xb3\x9b\x00`\xe2ܓ*\x80I\xe6\x8e_U\xe3\x9dh\f\x8c\x19\x10\v\t0\xb3\b\xe4m><\x10\xe4\x02\vc\xa6ba\x88\xb20\xb2\x01C\xbb7\x88\x85q\xb3\x0f0\x13\xd2u\xaa\x00\x93\x8d\xa4\xa4N\x88Q\xd5x\x93\xb8\x1bE\x82\x93\x17T;\x81\xa9L^\xaa\x9d@\xda\xe3`\xb6\xed\x04\xe6\x01`\b$\x1cWǙ\xd2!\xf2̄\xbbd\x16FN\xd5\xc2\xdc\v*\x1a\xb1j\x8a-w;\x81\x01\x19\xc3Y\x88\xe8mA\x8c\x02\x98\x98P\xa2\xaa\xf1F\x06\x98P\xbbVU\xe3\r\v0\x91\x86\xafj'\x909\x80A\x90\xaa\xaaM\xd3\u0090lY\x18\xcc\xc8\xc2L\r`¼\xef\xbc\x01L\xc6\x1eT\xb5\x13@\x8c\x8b\xb4\x02\x98$\xe2}\x14\xc0D\x01\x98\xf8RK\xb5\x13\xc8&\xc0Ў\x8c\xa4´,\f\xa6ma\xe4\x8c-\x8c\xd3ga\xa6\xda\x0fi\u038b\xd9e\x1d`0<\xb0w>\x01&\xfd\xc9\v\xaa\x9d@\x88wT\xed\x04\"M\xeb\xb1f\xf2\xb9\x04\x98P\xc3bq\x8b\xd9\xf5~[,\x85\x85\xc1\xec,\fi\xf6Hj[\x98$\xda҄\xb60\n`\xa6\x051\xd9\a\x98л]\x15\xb3\x8b4q%g\xa6\x14\xc0$a\xa7\x16\xa5\x1fRئ\xe5\xcb\f0$\xb4\x85\x111-\x8cX,\vCbX\x188\x03ca\"K\x94%l'0/\x00\x83a11\xd3\x04\x98h\x17\x1dU\x8d7I\x80Q\xd5x\xe3\x03LtNY\xf4bv\n`\xa2\xccB^h\v#\xc7[\x18\xd1ka\x04\x84\xc4D\x16\x86\f|\xcd\f-\f\xe2Z\x98\xfb!I\t$\xa2\x85Q\x00\x13\x11\xf4f\t1i\x01L\\@Q\xd5x\xa1\xfa!\xcd\b`b\x9b),{5^\x050Q\xaew\x04\x12\xb6\xab\xe3l9!\v#CT\xe7\x8dha0\xf05\xf3fa\xd6\xd0\xd0\xeei[\x18\x050\x88<XG\x9c\xd2Yɲ\xa6\xb1\x01&\xd2\x05CU\xe3\r\x0f0\x13L\xac\xaa\x1a\xef\x881\x10\x11LT5\xdeddԒ\xb7\x13\x18\xf4햅)\uf1b20\"\xb0-\xca\xc2Ĉ\x85\x91E\x10\xb8\xaa\x9d@T\vCƃCV\xb2\xac\xe9@`\x89t\xa2\xaaj\xbcq\x00&\xd4\x16\xa9j\xbc\x13M\\Q\x0e\x80\x02\x98ɜ\x94j'\x10n\"kZ\x98\xf3\xe5C\x14\x98\x15\xca\xc2t\a\xed\xce\xc6\u00909\xb10\xa4\xd3\xc2\xf0{\xc1\xd0h\x7f\xa8\x05\x01\x98Hc4\x858\x98\xac\x85\xc9\xd0\U000749ea\xf1&\x12\xc0\x19\xea-\x14\xc0$j\xa6\xa0\xaa\xf1\x8e\x1f\x03q\xcf\x0f\xa8v\x021&\xb2\xa6\x859?g\x16\x06C,\x8c̘\x85\x01!`\xb2\x01C\xbb\x1f\xa0E\x808\xaa\x9d@\x1a\x00#\xb3C4TU\xe3U\xed\x04f\r0\xa1\xf7\xae\xaa\xc6;\xd1\x18\x88\x89Ӫ\x9d@B\x00\xb3\x88\x16\x06\x19\xb40\x92\xae\xc3\xd4\xee\x06\x95\r\x00,[\x003!]g\x02`\x90\xc1\x06\x90\n`&_\x95P\x00\x93\xf44\xaa\x00&\x153\x05\xd5N`\x16\x00\xe3[\x18\xdaaa\xa8\xb20)Z\x18A\x8b}\x9d\xaa\xa7\x050Q\xe8b^R\xa9\xb3\x9ciM\x97\xbe\x9d\x00\"X\x18\x050\xaa\x9d\x80j'\x90\x1c\xc0D=/\xe7\xac\x16\f\xfa,\x8c\x86\xf3\xe5\x83\xc0\u0090\xd0\x16F(\v\x13\xdd\xc2\bc\xac\x85Q\xed\x04b\x02LV\x1b@B\xb5\x13P\x00\x03\xd5N`\xfa\x00\x13_j\xcd\x17\xc0L\xc2n\xf3\r0m\v\xe3\xe0|y/r,\x8c\\\x04\v#\xa7da\xf4\xfb!hw\xa7\xea\xf8\x00\x13\x9dT\x96\x02`\x00@dg=\x89\xaav\x02\xcb\r0Q\xf6\xb2\xaa\xc6\x1biZ\x8f\x86,\xf3\\\v&\xeax_2\x80i[\x98\xa3\xc1\xb10b\t,\f\xc6[\x18\x92\x88\x85\xb9'Vu\xdeH\x16f\xd9\x01&c\x8bK\x14\xaa\x9d\x80j'0\x96s\x14\xc0L\xb2c\x17\xba\x98]\xa8%\xa4\xe5\x05\x1843\x92\xf8\x88\x8c$9\xde\u0088%\xb00H\xc2\u0090bB\x16F\x01̼<\xa8j'\xa0\xda\t(\x80\x99\x04\x00\x97\xb8\x1ao\x9c\x18\x98\x05o'0\xd0\xc2x͌$3\xb6\x85\x91\xca\xc2$ba\x14\xc0LZ\xc0\x91\xb4\xce_\x9a\x95\xb6\x03\xb1\x00&\x06\xa0\xa8j\xbcP\xed\x04\xb2\x000Q\xcc\x14T;\x81\xb0\x00\x13e\xc3\x16\xb5\x9d\xc0@\v#\x99\x9f\x91T\x1a\x92\x91\xa4,LB\x16\xe6\x19c-\x8cj'\x10s,g\xbc\x1b$\x1d\x0e0\xe1\xa8LU\xe3\x8d\x19\x13\x10\xe5گ\xaa\xf1\xc6\x1e\x03Q>\xbc\x02\x98\xf8\xe3U\xb5\x13\x18<\xc9\xd8B\x1b^\x17FY\x98\x04-\xcc\xdd#-\x8cj'\x10\xeem\xc3\x02L\xc6\xda\x0e\x8c\xf9\xe0\xaa\x1ao$\x80\t{\xc5W\xd5x\xe3O\\\xc90\x8b\x02\x98xp\xa2\xda\t\x84\xfd\x8b\x1e\x18r\xccI\xcf\u0088\xe1\x16\xa6\xdb^,\xb7\x85\x99?\x80\t\xf1+3\f\xe4\xcdd\xdb\x01U\x8d7\xcc\xc45\xc1E_\xb5\x13H\x1eLT5\xde1c`rJ\x99/\x80\x89p\x81N9\x90\xb7ea\\\x8es\xe5\n\xf2\x83\xea\xc2$aah'\x10t[\x98N{\xb1\xb8\x16Ɔ\xa4\x1b#ca橝@\xd8\xdfT\x00\xd3\x031\n`&\xb8ګv\x02QvD\"\x93\x97\x02\x98\xc4pz\xc1\xfa!e#\x13\xa99\xcb4-̅\xf2.\xac4-\fҶ02\xc3\x16\xc6\b,La\xa0\x85\x99%\xc0D\x1d\xb6\xf3\x90J\x9dՌ%\xba<\x00\x13뚯\xaa\xf1N\xb0\x82\xa6\x8a\xd9A\xb5\x13H\xe1\xf3f5\x95\xba9\xcb\xf8ua8Η+\xd0iH\v#Ұ0$\x01\v\x83\x8c[\x98\xc1\xb10So'\x10\xe7-\x14\xc0$\x041\xaa\x9d\x80\x02\x98\x88\xed\x04fq\xf7\xbdl\x003שԑ>\xefbԂ\xe9\x9ce<0䙃sQ,\x8c\faa\x82׆\xb70XJ\v\xa3\xda\t,\x0f\xc0\xb4M\x8cj'\xb0\xc4\x00\x13M\xa5\xa8j\xbc\xf1\xdb\t(\x80Y|\x80!\x90p\\\x8es\xe5\x03䒶0\x01\x1cL\xdd\xc2\xc8\xf9\xb10\xaa\x9d\xc0\x8c\x00f\x86\xa4CU;\x81\xc5\x04\x98({YU\xe3\x8d4\xadG#\xc0e*f\xb7\xe4\x00\x03\x00\"\x88\x859W\xde_\x1c\v\x83\xf9\x8a\x85\x894\xd9+\x80\x89G~\xd9i\x9d\x14T\xecM\x03`\xc2\xec\aU\xcc.\xde\xc45\xb1\x99R\x00\x93\x84\x9dR\xd5x\x15\xc0t\xce2S\xb30\x98S\vC\xb2`a\x14\xc0$\"W2\xd4Ś\xc7\x02\x98\x11\x0f!<@\bȮ#\xd0<\x91\xba\xc9|\xf4ą\x04\x02yeD\x80ioc\xfb\xe4\"\xa0\xa4\x1b;\xd3\x06\x18\xcf\xf3 \xa5\xe8\x1bP\xa2\xf5gHGO\x1a2\xf8u#>'\t~W\f:\x9a\xb2\x7f\x10\x8b\x11\x9f\x9c\xc8\xf6kz/\x9a\x94\xd2\xcc\x00L\x94\x87'<\x7f\xd2\x19\xb0c\xfb\xf7\xc9\xe0-\xea\xdc/a.\x14b\f\xc0t\xfd\xdd\x11\a\xb9o\xfb\xc8\xe0\x8d \xc1\xef\x89A\x7fH\xf4\xbfW\xe7\x8d\nc\x93\\\xc1\x16\b`\xfav\x1b\x83\xce\xe3Z\x18\x11\xca\xc20\x16\xa6.LF-\f\x92\xb10\x8d\xdcs H\x01T\x9e\xf4\xcfK\n`\xa2J\xa9\x81\xbfL\xc6\x0f\xf6\fA\xcc@\x80\t\x7fe鼸\x11\x00k\xab\xeb(\x94\xf4\xae\xf7\xea= \xa3\x0eP\xd8\x18^\x89\xde+lH{!\a\x1f\x80& H\t\b郄\xe7\xbap\x1c\a\xae\xe3\xc0u]H\xe9\x05e\xbe)\b!\x1d\x93tr\xed\x04(\xa5\xd8\xd8\xd8\x02\xe3\xb4k\x12i\xf6\xaa\x18\xf5\xe6\"\xc2<\x9e\xa4\x81i^l!\x04\\υ\xeb\xb8p\x1d\xdb\xff\xdau\xfd-\x13\x00\b\x05\r1\x01β\x9d\x00(\xc1\xea\xea\x06\xf4\\\xa15ч\xcf \x8fi`\x84\fu\xeeɾ\x03-\x87\x8e\x03\x12\xe2$\xe8\x1aWC^\xdf\xf9\x1a\x01\xc0s=\xd4\xeb\xc7\xf0\\7u\x13\x97\x85v\x02ag\x9afFҭk;\xc8Q\vu\x8b\x05\u05cb\xb0\x16F*\v3\xc6\xc20ؐt\xb3\xcf¨v\x021\xcdJ\\\x80\xc9\u0603\x87\xa3\xbb\xf1\xcbHB\b\xac\xae\xac\xe2\x03\xef{\x17\xfe\xf1\x13\x8f\xa1T^\x85\x14\x03\xee\xf5\xc7\x1d\xa1\x84'\xaf0\xbfL(\x01\xe5\x1c9]G>_D\xb1TFi\xa5\x8c\x95\x95u\xac\xadm`ee\x15\xf9B\x1e\\\x03<\x17\xb0\x1c\x17F\xbd\x0e˲\x00\b\x10B{\xacC|\vù\x86\xb7\xbe\xf9\x8d0M\x13\\ӂ\vK\xb8\xe5\x031\xc9\x18\x93\xe3\xdeO\x0e\x1c%\x94qp\x8d\xa3X,\xa3TZAqe\x05\xeb\x1b\x9b\xd8\xd8\xd8B\xa9\xbc\x8a|\xa1\x00@\xc2u=\x98f\x03\x96a\xc0q\x1c\xdf{\r\xd8o\xb3m' \x91\xcf\x15\xf0\xd7\x7f\xf6\xbf\xb0\x7f\xf3\x06r\x85\x02\x84\x94-\xab\x12\xea\x11\xec\xb4\xf0\xc7BF}\xeb\t\x0f\xf4\x98e\xa4\x11\xef-\\\x17T\xe3x\xe1\x97|\x15(\xa5\x10B,e;\x81~\x80\t\xaa\xf3rw\xa8\x85\x11\xca\xc2Lla\x884\xd0\xc8=\xb7\xcb¨v\x021\x97\x91&\x04\x18\x99\xa1\x15%\x9eT\x1c\f<\x0f\xa5\x95\x1c\xde\xfb\xaeG\xf0ۿ\xf9\x06\x9c=w\x01\xae\xe3t\x1fxټJ\x8e\xea\xbc@ҥ=2\xea\x02۾\xcaj\\\x83\xa6\xeb\xd0\xf5\x1cV\xd7ֱ}\xe6\x1c\xce_\xbc\x05\xb7=婸\xfd\x8e\xa7\xe1\x96ۮ\xe0\xec\xf9mH\x0f\xa8\xd5\xea0\x1a5\b\x01P\xc6b\x03\f\xa5\x14\x8cq\xbc鍿\x82\xbd\xbd\x9b(\x16K\x90BLz-N\xfdѼp\x11\x00\\נ\xeb9\xe4\v%ln\x9d\xc6\xf6\x99\xb3\xb8x\xcb\x15\xdcz\xfb\x9d\xb8|\xdb\x1d8s\xee<6OmC\b\x01\xcb0P\xaf\xd7\xe0yn\x8fٚ\x84Y\xe2\a\xf2\n!\x90\xcf\x17\xf0\xe7\x7f\xf26|\xe8}\xef\xc2\xc6\xe6)\xdf$\x91D\xe6\xc4\xe4~\x83\xa4r\x12\x8c|%\xa5\x14\x96i\xa0T^\xc5\xc3/}9\x18\xd7 \x1d\x17\x84ҥk'\xd0\v0\xdd\x16\xc6D\xdd\xe2}\x16F\x86\xb20BY\x98\b\x16f\x11\x01&\xb3\x99H=?\xccPH\fxR\x81\xbc2H\xfb+\xaf\xae\xe3܅K8{\xf6\"\\\xd7IM\x8d\xa5;)\x8b\xd6E\xc7u]\x9c\x1cU\xb0\xbb{\x03\x1f\xf9\xf0\xfb\xe1y.t\xaea\xf3\xd46\xee\xbc\xeb\x1e<\xeb9/\xc0C_\xf4<\x9c\xbfx\x1e\xb6-pt|\b\xcf\xf5\xc0F\xc0̨\x00N\xca(\xb6\xb6\xce@\xe3:\n\xc5\x12\x84\x10\x03\xf6\xe1\xd8\x05\x8dY\xed8H)\xe1\xba.<\xcf\xc3\xf5k_\xc0\x13\x8f\x7f\x1a\xef{\xf7_B\b\x81B\xb1\x88\xad\xd3gqە;q\xf7}\x0f\xe1\xae\xfb\x1e\xc0\xe5[\xae _(\xa0^\xab\xa2V\xadB\b16\xe6\"\xcdL$B\t\xd6ֶp\xe6\xdcElln\x05\x10\x93\xc1A,\xa7\xfb\xc7(\xa5\xb0,\x13\xb9\\\x1e\x9c\xf3\x96!\\\xb6v\x02\xbd\x00\x83.\v\xb3\a\xcbc\x13X\x18\xa9,\xccX\v\x93\a\x95\xc7 \xed\xea \xd9\x04\x988\x90='\x00C2\x863<\xb9T\xeaއ\a!ܞ\x00U\x92\xb1+\xfe\xf8\xed\xf1m\x8c\x8eb\xb9\fB(\xa4\x04<υe\x1ax\xff\xbb\xff\n\x7f\xf3\xc8;\xb1\xba\xb6\x8e\a\x1fz.\xfe\xc9W\xbf\n\x0f=\xfby\x90\x128<\xd8o]\xfc\xc3\x02\xcc \xb7/\x84\x1b\xdc\x19\r\x0e\xe4\xcd\xe2\x83R\n\xce9r\xb9<\bYm\xde\xf2\xc1q\x1c\x1c\x1fU\xf0\xbew?\x82w=\xf2\x0e\xe8\xf9<n\xb9\xe5\n\x9e\xf5\xdc\x17\xe0\xd9/x\x19n\xbfr\x17\x84\xf4p\\9\x80\xeb\xba\x03Ap\x9a\xb5`\x84\x10\xf0<\x0f\xea\x81\x16\xd0s. ;\x1a\x0f&\t0Q\xcf\xd1,\x00L\x7f,\x8c\xb20iY\x18#\xb00\xb3\x04\x98\xa8\xc3v\xeek\xc1\x90q\xfbi\xf6\xbd\xacy2\x00CZ\x03\xbaV=\xc1\xcd\x1b\xd7Q\xc8\xe7\xc15\x7f9\x86P\n\xc8\xe9L\bÖ$\b!\x00\xe9\b\x96\x15\xc2Ϥ\x1aw\x19\xee\xcaRi\xbf>_(\xa2P,\x01 \xb0m\x13\x7f\xfb\xae\xbf\xc0\xbb\xfe\xf2\x9d\xb8\xfb\xbe\a\xf1M\xaf~-\x9e\xf3\xf0è\x9d4P\xaf\x9f\x80R\x1e\x1a`\x84'px\xb8\x8b\xfd\xfd\x9bX[\xd9\x00\xd79t=\x0fM\xd3@\bi]\x10S܃`\x8c\xf4-\x03\xb6/d\x1d\xfb\xb4\xb9\xaf%\x82;J\xd15\xe9\r:6\xc5b\x11\xa5R\t\x00\xe0\xba.\xae_{\x12oz\xe3/\xe3m\xff㍸\xeb\xbe\a\xf0e/\xffZ<\xeb\x8b_\f=\xa7\xa3r\xb8\x0f\xd7qZ03\r\x80\x91B\xe2\xf8\xf8\x10{{7@9\x83\xae\xe7\xa0iZ\xeb\xf8\xa5\xbb\xef\x93\x19\xff\x84\f*\xe2 '\xda\xfe\xee\xc9\xc6_\x14\x16#\xefâ\x03\xcc\xfc\xa4Rwܪ\teaҰ0d\x80\x85\x91\x81\x85\tʛ\xa5\x0f0q_\xbb\x14\x00\x93\x9dB1<\xa9bv\x941\xd4\x1b\x0e\x1e~\xf1\xcb\xe0\xb86v\xae_Ó\x8f\x7f\x16;;\xd7!\x85@\xb1PDie\x05\x9a\xa6\xc3\xf3D*9Z\xae\xeb\xc24\xea\xb0\x1d\xa7;aH\n\bO\xc0\x93\x1e(e`\x8c!\xa7\xe7Q,\x95\xa0iz\xdf\x04\x1c\xf6δek4\x1d\xa7O\x9f\x81\x10\x12\x9f\xfe\xd4\xc7\xf1\x83\xdf\xf3/\xf0\xa2\x97~\x05^\xfb=?\x84\xad\xd3gq\xb8{\x13\b\x11\xef\xe1\xdf\xf9\xbb\xf8\xa6\u05fc\x16_x\xe2q\x1c\xee\xef\xe2\xe6\xceU\x1c\xec\xed\xa1r\xb8\x0fB\bVV\xd7Q(\x14R\x03B\xdb6Q;9\n\xde?\xb8\x80\x11\x80S\x0eB\xdbi\xe7\xc2\xf3\xb7Տ\xe3a\xd0\xf3y\x14\n%h\x9a\xe6Ǘ\fپ\xce\xfdF\bAyu\r+\xabkp]\x17\x1f\x7f\xf4\xc3\xf8\xfb\xbf{\x0fn\xbb\xe3i\xf8\x9aW\xfds<\xfc\x92\xaf\x00\xe3\fG\x87\xfb\xf0D\x87\xd5J\xd1\xc0X\xa6\x89/y\xf9+\xb1}\xf6\x02\xf6v\xae\xe1\xda\x17>\x8f\xc3\xc3=P\x10\x14J%\x14\x8bePJ\xd3\xd9\xff\xc1R\x9c\xe3\xda\xdd\xd9?\xa1~U\xf8\x99u\u0083\xe7\xb8\x10\x10 \x84\xf9A넂1\x8a\x9c^@\xbeX\x04\xa1t\xe8\xf1\t\xe7\a\xc7]\x00\x97\x03`\b$l\xc1q\xeb\xcax\v#\x94\x85\x89da\xd0aa\xbc\xc0\xc20ш\x0f0\t\xac\xef(\x80\x99\xf5\x8aʐM\xfd\xd8gnz\xed\x1eJ\x93W\xe3-\xad\xac`e\x85ö\x80\x83\xfd}<\xfe\x99\xcf\xe0\x1f\x1e\xfb\b>\xf2\xa1\xf7\xe1\x93\x1f\x7f\fF\xa3\x81\x8d\xadS\xc8\xe5r\x89N\x04R\n8\xb6\x8b\xef\xff\xd1\xff\x82\xb5\xf5Mئ\xd92\x05\xc2u\xe0\xb8\x0ej'U\xec\xed\xdd\xc4\xd5/<\x8e'>\xfbI<\xf9\xf8gqR\xab\xa2T*auu}\xa8A\x88\xf2`\x8c\xc1\x13\x02\xfb\xbb;\xd8\xd8\xdc\xc2\xf7\xff\xfb\x9fĳ\x9f\xf7|\xdc\xdc\xd9\x1d[\xb1X\x06w\xd2k\xab\x1b\xc8\xe5(\\\x0f0M\x13G\x95\x03<\xfe\x99O\xe1\xef\xde\xfb7x\xef\xbb\x1f\xc1\xc1\xde\x0e\xd67\xb7\xa0i\xb9\t;\x1dw\xdf\xc1[\x96\x85\x95\xd5U|\xd5?\xfb\x16\x10\xc8`)\xad\x00]ס\xe990\xce\xc1\x82@N\u05f5Q;\xa9a\x7f\x7f\aW?\xff8>\xff\xb9O\xe2\xea\x93O\xa0^\xab\"_(bue\r\x94\xb3Hǘ1\xffN\xb6V=A\xadV\xc5S\x9ez\x0f\xbe\xf15߉/z\xdeK`4j\xa8U\xab\xa0\x84\xa6\xb7\x84\x04\x80\x12\x8ary\x15\xb9B\x11\x86i\xe0p\xff&>\xff\x99O\xe2c\x1f\xfd\x10\x1e\xfb\xc8\ap\xed\xf3\x9f\x05\b\xc5\xfa\xe6\x96\x1fԚ\x90\x99\xf1\xf7\xbf\x81\xd5\xd5M\xfc\x9b\x1f\xfc\xcf\xfe\xf2\x83'F\x16e\x90\x1dWB\xcf\xf5 \x84\x80\xe3X\xb0\f\x03\x86i\xa0vRA\xadz\x82\xa3\xca>*\x87\xfbؽ~\rׯ}\x1e\x941\xac\xadnBF\xb8\x91hn_.Wď\xff\xec\x1b\x01\x02\xb8\x8e3`L/\a\xc0\xf8\xd7\n\nB\x81\x87\xce|\x02B\xbap\x9c\x00\"\x83eH\xcf\x13\xad%I\xcf\x13\xf0\x84\x17\xc0\xbf\xff\xb5\xff\xaf\x80\xf0\xfa\xbfv\x85\a\xe1I0\xe6C\t%A\x16e\x00)\xed\xd6\x03\xc1\xf7h\xf0\x9a\xd6Ϛ\xdf\xef}\riA\x8c\xe80B\x83j\xd94\xedQ\x7f\xbd\x9b\x9e*\xc3\x1d?\xf7\xff~\x1bPz\xb7\x8d\x10to{\xd7\xe7@\xd76\x12J\xa1\xc9c\xd4\xf2/\x81\xa9?\x13T\x1c\x03\x84\x86\x9f\xec\x13\\FZf\x80\x19\xf4\xdb\x14p,Wh\x9b\xab\xf9?\xbctf\xed\x95BJ\x8d\x12\xe2\xcc\x0ebH\x1bo'm' \x84\a)\x00\xaeq\xe4r9\x14\x8b\x05hy\xc0s\x80'\x9f\xf8\x02\xde\xf1\xc7o\xc5\x1f\xfd\xc1\x9b\xe0\xb86\xd6\xd77\x13\xd2\xf3\x04Bx0\r\x03\xbf\xfb\xd6?\xc7\xe9\xd3kh4\xdc\xf6\x9d{\xc7\tM\xa9\x7f\x1eX\x16ps\xe7\v\xf8\xe0\xfbރw\xfc\xaf?\xc0'?\xfe\x18VVWQ,\x96\x13\xd9&\xce9\xaa''\xa8\xd7N\xf0o\x7f\xe0'\xf0\x95\xff\xecUؽ1\x1cd:\xb3\xa7\x9b\xc5\ue6b1%\x9a\xe6\a\xf9\xea9\x86\x83\xfd\n\xde\xf9\xc7\x7f\x80\xb7\xfc\xdeo@x.J\xabk\x89L\xa4\x942\xd4jǸ|\xeb\x15\xfc\xca\xef\xbd\x19\xf5\x86\x04\\ъ\xc7\xf1\x84\x00\xa4hM{\x94\x12P\xca}\x13C\t,\xd3\xc4\u038d\xabx\xf4C\x1f\xc0\xbb\xfe\xea\x1d\xf8\xc4G?\f\x80b}s\xb3\x9d\x8e\x1bqI\xb0z|\x84\x86\xd1\xc0s\x9f\xffR\xbc\xfa;\xbf\x17\x17o\xb9\r{7oB\b/xM\x82\x15\x99;\xbe\xed\x05\xf1\v\x9c1\xe8\xb9<\xf2\xc5\x12t]\x87Ѩ\xe3S\x9fx\x14\xefx\xfb[\xf0\xfew?\x82Ri\x15\xb9B>\x11\x90\xa4\x94\xc20\xeaX\xdf8\x85\x9f\xfeշ\xc0q=\b\xc7\xe9X\xaf!\x1d\v~\xdd5\x81\xa8l\xff\x80\x82\x812ښ\b@)(\xa1\x10R\xc00\f|\xee\xd3\x1f\xc7\xdb\xff\xc7\x1b\xf1\xe8\a߃\x8d\xadS`\x9c\x87\xda\xfep\x10\xb3<\x00C a\xb89ܺ\xbe\x83[W\xae\xa2n\xf1\x16\xc0tB\x89\xe75\xbf\xd7\xfeڇ\x9b\xe05#~\xee\x17\xda\xf4'\xf6N(i\x81L/ t\x00\x00\x1d\xf6;\x94\xf4\x83J\x87%\xea\x86\x12\xd1\xfd\x1a1\xaax\x9f\xe80/m\x90io[{\x1b\xfa@\xa6\v\xb6\xda È\x03A\n8*}3\xa8\x14\xfer\xbe*f\x97.\xc0\x84\xb40ق\x98\xcf\xde\xf4\x9ax\x1b-\x88w|S\xc7f0\x1b @)Gi\xa5\x8c\xd55\r\x9f\xfb\xf4u\xfc\xf8\x0f}7\x1e\xff짰\xb1\xb9\x95\b4\b!`4\xeax\xc3o\xbd\x15\x1b\x9b\xa7\xd0hԻ&:\xd1\xe1J\t\x01\x18\xd3P(\x16Q.\x15\xe0\xba\xc0#\xef\xfc\xdf\xf8\xb5_z=\x8e\x8f*\xd8\xdc:\x1d*^&\x8cY\xb0m\x1b\xfb\xbb7\xf1\xfd?\xf2\x93x\xf9\u05fc\x12;;;\xa0\x94\r\x9fo\aԂi\xefG \x97\xcf\xe3\xd4\xf6\x06>\xf5\x0f\x9fƏ\xfd\xc0kqrr\x84Ri\xb5\xaf\xc2o\x9cI\xb4^\xad\xe2셋\xf8\xa9_\xfcmԪ5 8.b\xd0dӪ\x0f\x12\xecOʑ+\x14Q*\xaf@\n\x89\x7fx\xecC\xf8\xc3\xdf\xff-|\xe0=\x7f\x89bq\x05\xa5\xb55\x88\x88K$\x941@\n\x1c\xec\xed\"_(\xe2\x9b^\xf3\xaf\xf0\x95_\xfb-hTkh4\x8e۱F!\x01\x061\x9a:v\xee{\xce\x19VV\u0590/\x94\xf0\xb7\x7f\xf5N\xfc\xf2\x7f\xfbq\b)P(\x14'\x06\x99&Ĭ\xac\xac\xe3?\xfd\xfcoñm\b\xcf\x19x\xb5\x1b\xba\x9a\xd6\x02\b\xbf~\x8b\xe8\xb8`\x12B\xc0\xb8\x86\x95\x95Up-\x8f?\xf9\xc3\xdfÛ~\xf3\x17P(\x96\x90ˍ\a\xb14 f^\x00&)\v\xe3y\x01\xac$ia(邅\xf9\xb00\x83@\xa6\xdf\xc2py\x8cF\xfee0\xf4\aBX\x18\x050\x98\xe22R\vb\xd6\xf2\x7fxi{\xb6\x10C\xc7g\x19\x8d\xfe\xa4\xa3\xbaR7\xe3%\x18\xd3\x00BP=\xae\xe2\v\x8f\xef\xe1\xfc\x85\xf3\xf8\xe9_\xfc-lnmè\xd7c\x15\x8a\x1b\xb6]\x8csp\u0382\xbf\xcb\xc0\x18\xf7\v\xb2q\x06\xce98\xd7\xfc\xed\x01P\xafְsc\aG\x95\x03|\xd9W\xbe\x1co\xf8\xed\xb7\xe2Χݍ\xfd\xfd\x9b\x89l\x93\xe7y\xe0\x9a\x86\xadS\xa7\xf1s?\xf5#\xf8\xc8\a?\x80\xad\xad\xed\xe1K,C\x8a\xd95\xf7#e\f\x96e\xe1\xc9'\xae\xe1\xf6;\x9f\x82\xef\xfb\x91\xd7\xc3q\xecD\x80\xab\xdb\"i\xfe\xd2\x11砜\x83\a_3\xd6\xf1\x7f\xf0=\xce9\x18\xe5\x90\x00\x1a\xf5\x1avw\xae\xe3`\xff&\x9e\xfa\xb4{\xf1\xa3?\xf5\xcb\xf8\xe1\xff\xfc\x8bX\xdb\xd8\xc4\xde\xce\xf5\xc8\r@\x85\xe7A\b\x89S\xdbg\xa1\xe9\x1a~\xf5\xe7_\x87\x9f\xf8\x81\xef\x82aԱu\xfa\x1c\x84\xe7Ũ\xda\x1b\xad+u{\f\xfb\x05\xcc*\x87\a\xb8q\xedI<\xfc\xe2/\xc7w\xff\xe0\xeb`\x99f\xa2\xfb\x9fP\nJ\x19h\xeb\xdf\xee\xffI\xe7s\xd2\xf3\xf3`;\x19k\x1f7\x1e\x1c7J\x19\xa4'P98\xc0\xe1\xdeM|\xcd\xd7~\x1b\xfe\xcd\x0f\xbc\x0e\x96ـc\xdb d\xd2\xf1\xbe\x98\xed\x04\x86Y\x18[p\x9c+\xefC\x1b\xd2#iP,\x8cL#\x16\x06\xf3\x18\v\x83P\xb10\x82n\xc2\xe2w\x81ʺ\x02\x98\xe8Saj\x00\xd3z\x9a\x95\x06\x90\xc3>I\x9c\xae\xd4\xe3&)J)\xb8\xc6qsg\a\xa7\xb6\xcbx\xd57\xbd\x1a\xc7Ǖ\x04.\xa0\x9d\xd7R\x19z\xe2\xf2'(\rR\x02\u05ee\xdd\xc4Jy\x1d\xaf\xff\x7f߈+w<\x15GG\x87#k\xbd\x84\xde\x1c!\x90\xcb\xe7\x91\xcb\xe7\xf13\xaf\xfba\xd4\xeb5\x14\n\x85\x96}\x8a\xdȁR\n\x8dk\xb8y\xe3\x06\x9e\xf1\xe0\x83xֳ\x1f\xc6\xc9\xd1Q\"\xdb\xda\xdef_]E\xad\x01Ҝ\xf0\t!\xa8T\x0e\xb0\xbbs\x1d\xcf~\xfe\x8b\xf0\xdf~\xed\xcdx\xf1˾\x127w\xaeAJ\x11\x19f<σ\xae\xe7q\xf6\xfc%|\xf8\x03\x7f\x8b\xff\xe7_}3>\xf6\xf7\x7f\x87\xeds\x17\xfa\r\xc2\x04\x8d=ô\x13h\xc2\xe4իO\xe0Y\xcf}!\x1ez\xee\vqr|\x9c\xe0\xfe\x97\xe3c`\x10\xbf\xa1\xa3\xbf\x9d\x12ׯ=\x89\xe7\xbc\xe0K\xf0\x9d\xdf\xf3\x1fqrr\x04!\x9cɶy\x8e\x00f\xfc\x06\x8e\x9e\x92\x9a\x19I\x17J\xe33\x92ZYF\x113\x92\xfaS\x8d\ad$\x91y\xccH\xea\t\xea\x1d\x91\x91d\xe8\x0fB\xd0\xfc\x98Nժ\x9d\xc0\xac\x02y\xb3R\xf0#\xe22R<\x80\xe9-%Ϲ\x86\xe3c\a\xcf\xfc\xa2/F\xa1T\x82\xe7٩|\xb8(\x1d\xa99\xe7\xa8T\x0e\xc0\xb8\x86\x1f\xfe\x89\x9f\x85\xa6\xe90-#1#\xb3\xb6\xb6\x81\xabO>\x81\xdf\xfdￄ\x8d\x8d\xb5X\x00\xd35\x9fJ\x01\xd7\x03\x9e\xf5\xdc\x17\xc1\xb6\xcd)\x9c\x19\xd1&.\xc6\x18(%\xd8۹\x01)<|ߏ\xbd\x1e\xaf\xf9\xce\x7f\x87ý=\xb8\xae\x1b\xdd\xca\x04)\xf1\xa7N\x9f\x83Ѩ\xe3?|\xff\xff\x85w\xfe\xf1\x1f`\xfb\xec\x05\x90\xce~\x98)\x02L\xef\xeb,\xdb\xc2s\x9e\xff\x128\xb6\x95\xf0\x8e\x8f\xb0\x84\x14\x06`\x86,\xd5\xed\\\xbf\x8a\x87_\xf6\x15\xf8\xfao\xfb.\x1c\xee\xed\x05\x8dN\xa3\x0e\x90\f\xb7\x13\x883\xb4\xc7\x00\f\x81\x84#8Ε\xf7\xe2Y\x18\xa1,Lk\x9b\xc6d$\x99Z`a\x86&\xf2/*\xc0d<\x13\x89d\xab\xed\x00\x9d6\xc04\x1f\xb6ib\xfb\xecy\xac\xafo²\x9c\x04\x97\x94\xa2\x03L{\x19\x85\xe3\xf0`\x0f\xb7^\xb9\x8co~\xcdkQ\xd9?H\xecP\xb9\xae\x8b\xd3\xdbg\xf0\x8e\xff\xf9\x16|\xf6\xb3\x9fC\xa9\xbc\xda\xd5{&\n\xc04\xf9\xd36-\\\xbe\xed\x0epMO,K\t\xa3y \xf2\xc4\xc58\x87e\x99\xd8\xdd\xd9\xc17\xbe\xfa;\xf0\xaf\xbf\xff?\xa0Rه뺱\x8e\xb9\x10.\xca+\xabX][\xc7/\xbc\xfeG\xf1\xe6\xdf\xf9e\x9c\xde>\aB\xc8\xc0ت\xb4\x00\x86\x10\x02\xdb2p\xe9\x96ۑ/\xe4S\xad\x814qs\xee!c\x9e1\x86ݛ7\xf0\x8ao\xf8v|\xd1\xc3/\xc5\xc1\xfen+\xce(\xb41\x9a\x06\xc0\x84\xbf\xe4ǟ\xc8B\x00L\xb7\x85ه尾\xa5\xa1\xb1\x16FF\xb10d\xee,\f\tia0\xc2\xc2Pi\xc0\xd4\x1f\x00hn\x84\x85Q\xfd\x90f\x050Y{\xd0Y\x00L3[E\xd34\x14\xcb+\x13լ\x98\xfc\x9e\x14}\xdbv\xb0w\x8c\xaf|\xc5\xd7\xe3\xd2\xe5[\xd1h\x9c$\x06Xz.\x0f\xa3\xd1\xc0\x9f\xfe\xd1[P^)td\x15E\x05\x18\x7f\xbf\xbb\xae\x8br\xb9\x1c\xa4\xab\xbbS\x1a21J\xb0\x12\nx\x12\u05ee^\xc5\xcb_\xf9\r\xf8\x8e\xff\xfb\aq\xb8\xbf\v/f0\xb2\xe7y\xe0<\x87\xd3\xdbg\xf1;\xbf\xf2\xb3x\xd3o\xbd\x01\xa7\xb6ς\x11:\x15\x80im\x87\xeb\"_,#\x97+\xc2\xf3\xbc\xc8vi*#~\xccj\x9b\xf0\\ԪGxͿ\xfe\x01l\x9e>\x83F\xfd$~\x0f\xabH\x00\x93\x8c:\x99&\xc0\xf4Y\x18\xd1aa:\x8dKb\x16\x06c-\fI\xd4\xc2ȉ-\f\x12\xb00.ۂ\xa9\xdd\x13\xf4H\xa2\xd9\x02\x988\xc3wQj\xc1t\xc0[\x96z'\xd1i\x03\f:N\x00\x00\xe0\x94\xfa'\xf04dq\x88\xf0\tJ\tL\xd3\xc0\xcaZ\x11/{\xf9?E\xf5\xf88\xb1\x98\x1d!\x04V\xd66\xf0\x9ew\xfd\x19\x8e+'\xc8\xe5\xf2C\x83B\xc3\xdd}\vPF[\xed\x10\xa6\x89\xc92\xca뤟\a\xcc(ōk\xd7\xf0O\xbf\xe1[\xf1\xcao\xfcv\xec߸\x1e\xbaaf\xff\xf8\x11\xa0\x94\xe1\xf4\xb9\v\xf8\x9d_\xffy\xbc\xf5M\xbf\x89\xd3\xe7.\xb4\xb2\xb4\xd2\x06\x18\x04\x19o\x94\x92\xa0\"\xb5Le\x1f'\x11\a3\xfc\xd7\xfd\x02\x82\x8dz\rkk\x1bx\xf5w|\x1f\xea\xf5\xda\xf8lA\"A\x84\x9c\xb0^\xe5|\xa4R\x0f\xb20\xe73da\x10\xd2\xc2\xc8P\x16\x06\x19\xb10\xcf\x00\xa86\xc4¨v\x02\xb3\x06\x98왘\x19\x00L\x8f\x90N\xf4!BL^\xe3\xdc\a\xa5\x14\xb5c\x13\x0f\xbf\xe8KQ,\x95\xe08\xc9\xc4\xec\b!P(\x94\xb0s\xe3:>\xf1\xb1GQZ)\x0f\\\x06\n\a0\xcdK\xcb4\x87U\xb4\xf8\x87A\xe3@J\x89ݛ7\xf1\xea\xef\xf8^<\xe3Y\xcfEe\x7f7vP\xac\x94\x02\x8cP\x9c9{\x0e\xbf\xf5+?\x83\xbf\xf8\xd3?\xc2\xe9\xed\xf3\xadj\xc3i\x02L\\\xa8K\x96\x1a'\x03\x98\xe6\x831\x8e\x83ݛx\xd6\xf3^\x82\x17\x7f\xe9W\xe3\xf0`o\xec1\x11\x04\x10!\xae\xd0d\x8ek\xc1,\x92\x85\xc1\\Y\x98\xa7\x0f\xb10\xaa\x9d\x80\x02\x98\x01\x103K\x80A\nZ\x8a\f¢\b\x00\x83\xa0jk\xbdQ\xc3\xc5˷\xe1\xd6+w\xfai\xe0\te\x9fР\xca\xebc\x1f\xf9\x00tM\xebø\xf0\x003\x83%\x8b\t\x01\xa6\xf9\xf9\x1dۆm\x99\xf8\xae\xef\xfdQ\xe4\xf2\x05XF\xfc\x00j\x11\x18\x99\x8d\x8d-\xfc\xd2\xcf\xfc\x18>\xf9\x89G\xb1\xb1y\xaa?F%E\x80I\x9dYR\x04\x98Χ'\xc7\x15|\xed\xb7\xbd\x16\x1b\x9b[h4\x1aC\x8fIs\x15\x90\x8c=\x17\a\xc5)\xcf\x1f\xc0\xa0٩ZS\x16f*\x16\x86\f\x8a\x85Q\xed\x04\x96\xad\x9d@x\x133#\x80\x91]\xe6dzw\xb0c\xa3O\x82\x93\xd7\xf3\x1c\xe4r\x04O\xbf\xf7A\x18F\xbd\xdd95\x01W\xa4\xe7\xf3\xf8\xf4'?\x0eO\x02\x84\xb0\x88\x003\xa3)5\x01\x80i\xdf\xf93\x1c\x1fWp\xe1\x96+\xf8\xbao\xfd\x0eT*\x93\x05PK)\x91\xcb\xe7\xc1\x18\xc3Ͼ\xee\x87`\x18\r\x14\n\xa5\xf6\xb2\x88\xcc\xe8n\x1d7d\xc9\xf4\x00\xa6\xb9\xac\xb4\xb9y\x1a\xaf\xf8\xfao\xc7\xc9\xf1\xe1\x847\x13#\xaf\xbas\x050\x04\x12\x8e\xc7q\xae\xa4,Lz\x16\xc6\x1aca\xa2)\x14U\x8dwB55'\x16\x06\xa3bb\x10\xa3\x0eL\xe4}#\xa75\x1bD\x03\x18\xffk\x02\xc7\x01\xee\xbc\xeb\xee f'\x19ܒR\"\x9f\xcfc\xe7\xc6UԫU\xbfC\xb2\x8cbV\xa6ma\x92\x05\x98\x16\xc8p\x8e\x83\xbd\x1d|\xf9+\xbe\x01\xb7\xdf\xf94TO*\x13\x05P{\x9e\x87\x95\xf5\r\\\xbf\xfa\x04\xfe\xfb/\xbd\x1e\xab\xeb\xeb\xfex\x95\x11\aK\x04\vCBv&Oj\xfc\xa6\x050\xcd\t\x8aq\x8e\xca\xc1\x1e^\xf0\xa5_\x8d+O\xbd\a\xb5\x93\xe3\xbe\xea\xd2\x13\x9e\xf1s\a0\x00\xe0I\x15\v\x13\xd9\u0090\xa8\x16Ƅ\xa9?\x18\xcd\xc2(\x80\x99\x88Q\xe6}\x19\xa9\x03b\x06\x03L\xac\xebm\x84e\xa4L\x03Lp\xd2Y\xb6\x85\x8b\x97nE.\x97\\\n\xad\x10\x80\xa6i8>:@\xa3V\x05\xe7\xbc?\xb093\xcbH\xe9\x00L\xf3\xa7\x8ecC\xd3t|\xed7\xfeK4\x1a\xf5\xc9\xf7\xad\xeb\xe2\xf4\xf69<\xf2\x7fގ\xbf\xfe\xf3\xff\x8d\xadS\xddՑ\x93\\F\xf2\xdb2\xa6|4\xc4t\x00\xa6\xf3\xe18\x0e8\xd7\xf0U\xaf\xfc\xe70\x8cF\xac\x11\xb7\b10\xed\x97K8\x92㼲0\xd1,\f\xa2Z\x98S~]\x98>\v\xa3\x00&\x8d{\x88E\x01\x18\x8crvi\xc6\xc1\xa4\xfa \x93\x03LsDږ\x8d\xcdSgP*\x95\xe1\xbaN\xab+\xf6\xa43\x13\xa5\x1c\x8e\xe3\xe2\xe4\xf8\b\x94k\x93\xc5\xc1\xa4\xb5Se\xba\x00\x03\xf8\xfd\x96\x8e\x0e\x0e\xf0\xc5\x0f\xbf\x14O\xbd\xeb^\x9c\x9c\x1cM|\xe7O\b\xc1\xea\xca:~\xf7\xd7~\x0e'\xc7\x15\x14\vE\xbf\bY\x82\x00\x03\xc0\x9f\x80\xd2Z\vm\xce\x1a4]\x80\x19h\xc8\x18\xc3q\xe5\x00\x0f~\xf1\vp\u06ddw\xa1~\xe2\xa7\\\x87\x15N\xf3\xd4N ̇\xf1\xc0\x90g.\xce*\v\x93\xb2\x85y\x00 z\x8f\x85Q\xed\x04\x90\x84\x85Y`\x80\xc1\xa0\xc0ީ\x00L\x9a4#\x12\x00\x98 F\xc0s\x1d\x94˫XY]\x83縉\x1eT\xe1y0\xcc \xa0U\x8e\x9bA\xa7\f0\x90 \x11\xee\xc1'\x19\x03\xaek\x83j\x1a\xfe\xc9W\xbf\nf\xa3>qin!\x04J++\xd8۽\x8e?\xf8\xdd_\xc7\xca\xc6\xc6\bֈ\x99\x89\xd4<^$\x95]?T[\xc6\x03\x98\xe87\x15\xae\xebB\xe79\xbc\xe4˾\x06\x86Q\v\x8e\xc9x\xa2\x9d\xf7v\x02\xfd\x7fF\xc2u\xfdX\x18]Y\x98)[\x18\xd5N@e\"\x8551\x8b\x040\xa1.\xd2\xe1\xa3<\x85\x10\xe0\x8c\xa1T^\x81+\xdc\xc4\x0e\xad\x7fg+\xe1\xb9~\x17`\x99)\x80i^\xe4H\xf8},\xe3o\x10!\f'Ǉx\xf6\xf3^\x823\xe7.%\xd2\x10\xd4u]ln\x9d\xc1\xff\xf9\x93\xb7ⳟ\xfa\x04ʫk\x03\xea\xf1L\b0Sy\x90\tK7\"\xf42R\xef9_=\xa9\xe0\xa1g\xbf\b\x9b\xa7\xce¶\x1c0\xae\xb7\x1aM\xb6O\x03\x99*\xc0L|џ\x00`@\x001\xc7ua0\xd7\x16F\xb5\x13\x98\a\x80\xc9J\x92\x04\x9d-\xc0\xa4\xb7\x1b\x92\xa8\xef!\x84\x00\xe3\f\xc5r\x19\xc2\xf5\x92%n귞\x1f\x9e\x812C\x80\xf1\xe3\x9a\xd3;\x1e\xb2\x13\xe8\x00\xcb4\xb0\xb6\xb1\x89g?\xefŨ֎\xbb2\xb6\xe2>4M\x83\xeb\xbaxۛ~\x13\xc5b\xb1\xe7\xb28\x0f\x00\x13b\xcb\".#\tO\xc0\xf3\\8\xae\v\xcfs\x87\x16Z\xa4\x94\xc04\f\xacm\x9d\xc2]\xf7=\x80'\x9f\xfc4*\a;\xd8\xdf\xdf\xc1Qe\x0f\x9e\xeb\x02\x92@N\n0\xe1/\xf9\xf1'\xb2\x98\x00C\x9buaJ{\xe0\xaa:\xef\x14-\x8cj'0/\x00C\t\x92m\xde\x1c\xf3\xc1\x97\v`\xc6/#\xf5\x1d[\nh\x9a\x0e!\x92\xeb\xd9)\x84\x00\x01\x05g\x1a\x84+\x87\xf6\b\x9d:\xc0\xc4Y\x8e\x98h\x1c\xc8\xd6\xe9m\x18\r<\xf7\x85_\x82?y\xfb\xefC\x88\xc9[(x\x9e\x87\xf5\x8d-\xbc\xffo\xff\x02\xff\xf8\x0f\x8f\xe2\xd6\xdb\xee\xc4Ig\x06\xd4\\\x00\x8c\x80?\x9dN\x0e0\x12@\xa9\xb4\x02=\x9f\x0f\x0e\x19\x81\xed\x980\x1b\xf5\x9e\xcf\xe6\x9f\x18\x94\x10Ԫ\xc7\xf8\x9aW\xbd\x06\x0f=\xfb\x85\xc8\xe5\vp=\x17\x04\x04\x8c1H\xe9\x81\x12\x1a\t`\xa2\x90\xc7,\x01\x86\xb4\xaa\xf3کZ\x18Ɣ\x85i\xe8\x0f\xfb\x16F\x9a\x00\x98j'0\a\x00\xd3E\xd1\x19x\xf0\xd9\x01\f\x99\xc1\xa4\x10\r`\xa4\x94 \x12\xe0L\x83L4\x8aS\x801\x86B\xb1\xec\xdf\r\xf7\xed\xef\xd9\x00L余\x00\xc0 \xe8\xa8\\\xafVq\xe5Χ\xe3\xe2\xe5۱w\xf3\x06J\xe5\x95\xf1\xe5\xef\xc7\rn\xce\xe1y\x1e\xde\xf9\xf6\xb7\xe0\xbb\x7f\xe8\xbf\xe0\xb8Y\xfb$\xf3\x00\xd3\xdc>\x92\x8c\x81\x91\x02\xc5|\t\x8f?\xfeI\xec\xed\\C\xbeP\x84e\x9aX]\xdb\xc0\x1dO\xbd\a\x96ev\x9d\x182Pd\xa6\xe1\x1b\xb23\xe7/\xb5\x16\xb5\x84'Ш\xd7!\xa5\x88\f0Y\xcfDjM\xb6\x81\x85\xb9u\xe5\x068\xb5`9\x1cRzm0Q\x16&a\vSoG6\xcc\n`T5\xde\b\xfcB@3\xd4<\x89\xcf\xd2\xc0\xa415\x88\x84\x00\xa6\xf9bA\x12\x86.B\xe19.4MGye\x05n_\xe3\xc6\xf0\x00#g\xc45I\x02L\xf3\x99\xebZ(\x14\xcf\xe0\xde\a\x9f\x83?~\xeb\uf87c\xb2>qm\x1eϓX[\xdf\xc2߽\xf7\xafq\xed\xea\x13X[]G\xa3^\xf3c\x922\r0\"\xb4*\v\x95\x89\xe4I\x14Je\xbc\xedM\xbf\x81\xf7<\xf2\xa7\xd8\xda>\x8b\x83\xbd\x9bx\xf6\xf3_\x82\x7f\xffS\xbf\n\xc3\b\xe2\x90\x06\x94\x190M\x13\x86at\x9d\x03\x8c\r\xbac^\f\x80\xc1\x18\v#\x17\xdd\xc2\xc8iY\x18\v\r\xfd\x05-\vC\xc0\x929\uec6e\xc9\n`\x92\xbcDM\xfbAS\x05\x98\x84\x96-\xa6m`ړ\x17\x01<\xc0\xb6m?~%\x81\xad\xa6\x04p=\a\xabk\xeb(\x16K\x10\xae۱ߣ\x19\x98Y\x8c\xa34\x00\xa69\x14m\xcb\xc4}\x0f<\vD\xa2\xd5\xc8qR\x18\xd0\xf5\x1cj\xd5#\xbc\xffo\xfe\f\xa5\x95\x15H\xe9\xcdW\xd5\xdeI\x01\x06\x00\xd34ض\x89\xda\xc9\x11.\xdfz\aΜ\xbb\x80\xb3\xe7.`mc\v\xb4\x19\x7f4\"K\x8f1\x06\xc6x\xf0/\x1b\xd0N`q\x00\xa6\xd5#\xa9t\xd0\x17\v\x93\x86\x85!Y\xb30\x98\x96\x85ق\xa9=\rT4\"\x01L\x14\xbaP\xed\x04\x92\xc7>B\xb2\x97\xb7D\x93\x04\x98\xf1Wb9e\x830\t\xc0\x04i\xd6R\xc0\xb2\x8c\xc4*\x97\x12B`\x9b6No\x9fE\xb1\xbc\x02\xc7q\x82}/#\x7f\xb2i\x1b\xbd\xf4\x00\xc6\xdf/F\xa3\x81\xdb\xef\xb8\vk\x1b\x1b\xb0ms\xe2,\xa5\xe6\xb1,\x14\xcbx\xef\xbb\xfe\x02\x8ee\x833=\xd2\xef\xce;\xc0xB@\xcf屻s\x03\xbb7\xae\x82r\x0eǶ\xe1z^\xb0\\'\"U\x8c\x8eZ\x13s\x9e\x00fZ\x16\xa63#\tS\xb60\x9d\x06f\x96\x16ƯΫ\x0f\xeeu\x17e\xc2W\x003\xb5v\x02Y\x04\x98\x16\xc4$\xb5qQ\x02y\xc94\xa7\x02\x19/\xfe\x81R\n\xd7qp|T\x01\xe7,\xa1I\x8d\xc0v,\\\xba\xe5v0F\xba\xde3\xca2Ҵ\x9dV\x9a\x00\x83\xa0\xe9\xa6e\x9a\xd8\xdc:\x8dK\x97\xaf\xc00\x1a\x89\x14`\x10\xc2C\xb1T\xc6\xe7?\xf7)<\xf9\xc4g\x90+\x95C\xc5\xda\xcc\n`\xc2PM\xa4v\x02R\xa2X(\xe3\xd3\xff\xf8\x18\x8cF\xddos\xd1:\xff\x9a7\x15d\xa2\xf1<\xcf\x00\xd3\xfdԷ0\x17Ҷ0dv\x16\xa6ӵ\xcc\xd6\xc2\xdc\x05*\x8c\x91\xcb\x1c\n`\xe2͕i\xc5\xc1d\xf5AC]?3\x1e\a3n\xc3\xe2\x00\x8c\x10~wd\xcb4P\xad\x1e\x83s=\xb1\r\x12\x9e\x87\xa7>\xfd~xn3\x03E.5\xc04\xbf!\x84\v\xc65\xdc\xf6\x94\xa7\xc1\xb1\xacĚnr\xaeö,|\xf4\xc3\xefG\xb1X\x84\x94\xde\xc4\x00C\xa6a\x14\xc9\xe4\xed\x04\xa8O\x88\xf8\xe8\a\xdf\x03\xaeu\x8fa\"\x81(\xac\xb6H\xed\x04\x06\xbd\xd6\v\xea\u009c\x1daaD\xf0\xf5D\x16\x06\xcbka\x88\xb4`\xea\xcf\f,\x8c\xab\x00fһ\xfa)\x05\xf2f\xf9Ag\x16ț\xda\f \xbb\xae\xe4\xf1\x038%r\xb9<n\xee\\C\xf5\xf8\x18\x9a\xa6M\x9c-\x83\xa07M\xb1\xb4\x82\xa7\xdd}?\x8cF\x1d\x84\xd0\xe5\x06\x98\x9e3\xccs\x1d\xdc\xfe\x94\xa7\a5L\x92\x8a\xfa\x11\xd0s9|\xec\xef?\b!E;\x0ed\x02\x03#\xa6q\x18&l' \x84@\xaeP\xc0\xc1\xee\x0e>\U000713e0T^\xed\x1a\xc3Q\xf6\xeeB\xb5\x13@\xff2R\xcb¬썴0\xb2\aT\x06Z\x18\xa1,L7\xbc\xb4-\x8c\xc7N\xc1֞\x06\x16d$-#\xc0L\xe8Q\x14\xc0\x8451\xcbd`\x06\xfe\xba\x90\xc8\x15\xf2x\xf2\x89\xc7a\x99&\x18\x9b<&\x861\x86z\xed\x04W\x9ez\x17.^\xbe\x05\x8dFmp?\xa6\f\x00\f\x91S\xaa\xc8,\xbbO\x18۲p\xe1\xd2-\xd0\xf5\\bM7\xa5\x94\xc8\x17J\xf8\xfc\xe3\x9f\xc6I\xe5\x10Z.7\x10H\xc3\x02\xcc\xd4Ʋ\x88\xf77;'\xa2\xf2\xca:\x1e\xfd\xe0\xdf\xe2\xe4\xe8\x00\xb9\\.\xd62٢\xb5\x13\xe8\x05\x98.\vS\u0603\xe90H)\xe2[\x189+\v#3ea0\xc4\xc2H\xa2\xf7#\xf4\x9c\x03\xcc\x04\xf2$ө\xd4\xf3\xf0\xa03\x01\x189\x9d\xaa\xb3Q&\xb9A#\x98s\xe0c\x7f\xffw`\x9c%\x14FKШ7\xf0\xf0\v\xbf\x14\x94Qx\x83\x8a\xbaE\x05\x98\x94R\x94$\x99\xac\x9d@(\xf4\xea\xef\xba\t˶\xb0\xb5}\x06\xe5\xd5u8\x8e\x93Hp\xaf\x10\x02\xb9\\\x0e\xc7G\xfb\xb8q\xed\v\xc8\xe5\xf3\xfd\xdb\x16\x15`HJ\xfb\x9e\fk\x00\x19\xce\xc2t~\xd7oo!\xf0\x9e\xbf~\ar\xf9B\xac#95\x80\x89;E$\x000\x04\x80#\xb4\xc0\xc2\xd8\xf0\x04i\x83\xcb@\v#\x92\xb70$\t\v\x83\xb1\x16F\xce\xdc\xc24\xeb\u0090\x05\x01\x98\x91\x1c\xa2\x00f\x16\x103\x15\x80A\xba\x91\xbd\x13\x03\f\xfc*\xbd\xf5\x9a\x85\xc7>\xfaa\x14K\xa5\xb1q\x14cw4\xa50M\x03[\xa7N\xe1y/\xfa\x12\x1c\x1f\x1d\xf5\x97\u05cf\n02\xbd\xfd'C\x02̤q0\xbd\xfb\xc8ql\xac\xac\xaca}c\x13\xaem'7\xd0)\x85\xe3:x\xe2s\x9fB.W\x80\xec01\x91\x01\xa6\x19ʔ\xc6\x01\x90a\xbe5\x1e`<\xcf\xc3\xea\xda\x06>\xfe؇\xf0ɏ?\x8a\x95\xd5u\x88\x88fk\xaa\xfd\x90f\x90\x89\xd4ma\x9c\b\x16F\x8e\xb50\"\xaa\x85\xc1x\vC\x12\xb00\x98\xa1\x85\xb1\xf4gB\x12\x0e\xc0\xcb4\xc0D\x1d\xb8\xcb\b02CM\"\xe9L\x00&\xc5\a\x89\xf07\x86M^\xfe\x04\xb0\x86\xc7\x1e\xfd \x9e|\xfc3(\x16\xcb\x13\xc7\xc3\x10Jqt\xb8\x8f\x97~\xf9+\xb0}\xeel\xbb\xc0ؤ\x00\x93V'e9]\x80i\xb1\x81\xf0\xc04\x8e\xd3\xdb\xe7a\xbbv\x82w\x04\x04\x8c0|\xe1\x89\xcf\xfa\xfd>\x82\xf7\x8d\x050\xcd/R>\x8beL\x80iB\x9b\x96\xcb\xe1\x8f\xff\xbf\xdf\x06%<\xb2\xd1J\v`\xb2\x92J\xddma8.\x94w\x13\xb502\x05\v\x830\x16Ff\xd7\xc2X\x1d\xd5y\xa7\r0\x91\xc6\xfe\x92W㝷\a]$\x80A\x04\xc3?j\xf2\"\x84@\xd3\x19\xfe\xf4\xedo\x06cl\xe2%\rJ)\x1a\x8d:\xd67O\xe1\x15_\xf7\xad8\xae\x9ct7Ί\t02\xb5դ\x10)\xc8)\x00\f !\x85\a\xce86N\x9d\x82\xeb\xd8~\U000ea110@\xd3rع\xfa\xf9\xe0BM\xb3\x17\x03\x13uK\x86\x00\x8c\xe798s\xee\x02\xfe\xea\x1do\xc7c\x1fz?V77#\xc5\x17-\v\xc0\x00\xa4maJ\xfb\x8baa\x10\xc6\xc2\xc8\xd9[\x98)\xf7CR\xd5x\x93\x05\x98\fu\x1d\xf0\xb7e\xd6\r\x1d\xa7^\xb4m\xc4\xe4\xe5y\x1e66Oᱏ|\x04\xef\xf9\x9bG\xb0\xb1yj\xe2\x00SB\b\x8e\x0e\xf6\xf0u\xdf\xf2/\xb1}\xf6,\x1a\x8dj_#\xc2\b\x18Ѕ\x1a\xe9ps\xba\xa9\xd4\xc3\x00\xa6s\x18nl\x9eJ\x94\x1e\xa4\x94\xe0:G\xa5\xb2\x0fˬ\a\xc5\v\xe3\x16oL\x1fk\xe2\x18\x18!\x04\x84\xe7\xe1̹[\xf0\xd1\x0f\xbf\x1f\xbf\xf3\xeb?\x8dՍ\xf5H\xe7\xd72\x01L+#\xa9\xbc\aN\x96\xc9\xc2`\xac\x85!iZ\x18\xd5N \xbb\xed\x04\xa2\\#2\"mh\xd2\xed\x04\xc2N^\xb2\xa3:\xca4\xcb\xe7\x8f\x02\x18?\x00T\a\xa5\x04\xbf\xfa\v?\x05M\xd7&\xceJb\x9cc\x7f\xff&\x9e\xf1\xd0\x17\xe3\x15_\xf7-8\xd8\xdb\x03c<\xfe\\\xd81y͂\x86\xd3\x04\x98\xd6ݱ\xeb`m}\xb3}5N\xe8\xa1q\x1dՓc4\xaa5p\u0382\xce\xe4Q\xb9e\x9a\x00#\xbbΖ\x8eA\f\b\x01/\x80\x16/\xf8\xbfX(\xe1܅[\xf0\xfew\xff\x19~\xf6\xc7\xff\x1d4ʡi\xb9\xd0K\xa1\xb1\x01&\xfc%?3\x00\x83 \x16&\xcf\x1d\x9c-\xed)\v\xd3ca\x10\xc2\u00908\x16F\xb5\x13\x98\n\xc0Ĺюd\xb4\xb2\xd4\x002\xc9v\x02Q\x00f\x16\x92~\x1c\xc0\x10B\xb1}f\x13?\xf7_\x7f\x12\x9f\xf8\xd8Gp\xe6\xdcŉ,L3\xa5\xbaT(\xe1\xdf\xfe\xe0O\xc0\xb6m\xb8\x8e\x03:\x02\x8c\xc2.#MoJ\x9d&\xc0\xf8\x17\x13OH\xac\xac\xad\x0f\xae\xf6\x16\xf3!\x84\x00g\f\xb5z\x15u\xa3\x86\xf5\xc2)\xc0\xb22\a0\b\xe2\xa7\b\xa5 \x82\x83О\x10\xba\xe6SN\xa11\x06Mסk9P\xceq\xf3\xfaU\xbc\xf9wހG\xfe\xf4m(\x96W\x90+\x14!\xa3\x8c߄\nn\xcd\x03\xc04-\xcc\xc5\xd5k\xe0Ć%\xf8\x98\xba01-\f\x16\xd7\xc2 \x94\x85\xd9\ue3c5\x89r\xec\x15\xc0ļqH\x11`H\xb6\x1a@\xf2\xc9&/d\"\x0efR\x80\xf1<\x0fz.\x873g6\xf0\xc6\xdf\xf8\r\xbc\xedMoĩ\xb3炂kq\xc9\xd6/\xa3_\xaf\xd5\xf0\x1f\xff\xeb\x1bp\xee\xfce\xec\xef]\a\x1daa\xa2\x02\xccb\x19\x98\xff\x9f\xbd7\x0f\x96%\xbb\x0f2\xbfs2k\xbb\xfb}\xf7\xed[wK\xbdh\xeb\x96ZR\xb7,/\xb2l\x8c\xbcac\x8b\xc1\x160\x189\xc6\x06\x8c\x17\x90\xc7\x13\xf6\xcc\xe0!0\x18\xec\x01\f\xc3\x103&\b\b \x18\b\b\xb6\x01ƀ\xc1Ƌ,\xdb2\u0096e˖l\xb5\xb6\xee~\xfb{w\xa9-\xb7s\xe6\x8f̪\x9bU\xb7\xeaV\xaeUYuO*\x9e\xfa.Uu+\xb32\xcf\xf9\xf2;\xbf%\x0e\x1c\x01\xeb\xeb[C\xe9P\x9cw\x94\xf8~@\xfb\xe8\x90\xf3\xe7/\x9fz4\x17\x010\x02\x81U\xb3\xb1-\x1b\xaba\xa3j\xde\xc9\xe1H\x83Қ@\x05\xf4:]^\xf9\xfcgx\xe5s/\xf1\xf1_\xff\b\x1f\xfb\xc8/\xd2i\x1f\xb1\xbbw>\x8c\xf9I\x1b\a\x93p\x18\\\xb6~H\x13\xafyeѴ\x1d\xaeL\x8b\x85Q\xf9-L\xbcS\xf5RZ\x18Q\x84\x85y\x1e-l\x84\xeeN\x8do3\x00SНC\x89q0\xc5\x14\xf2+\rb\xe6\x1b\xc8{ܭe~G\xe3\xb4,$!\xe0\xdc\xdey\xa4\x94\xfc\x8d\x1f\xfd\xcb\xfc\xcb\x7f\xfa\x0f8\x7f\xf1b\xaa,\xa7I\x06\xa6\xdf\xef\xb3\xff\xf0>\xdf\xf7\x83?\u008b_\xfcn\xee\xbeb\x00&\t\xc0\b-PJ\xd1l\xaeEƪ\xb8\xbd\x0e\xeb\xa6\x04t\xdam\xa4mM\x05\xa4$\x00St\xdb\x01\xa5\x14v\xad\xce\xd1\xfe>\xff\xdb\aޏ\xef\xfb\xa7>\xd6u\xfa8N\x1f\xa7\xdf\xc5s=\xecZ\x9d\xf5\x8dM\xce_\xbcL\x10\x04\xa9\x8aڭb;\x01\x12Y\x98;#\x16&\x88\x83\nj\xb2\x85Qg\xc8\u0090\xc0\u0088\x84\x16\xc6\x00L.FYd o\x95\x01f\xa6\x89)\v`\xe6o`\xf40\xfeA+\x05Bc\xdbu\xf6\xf6.PoX|\xec\xa3\x1f\xe5o\xffͿ\xcc\xc7\x7f\xfdW9\x7f\xf1J\xae\xbe\x83\xb6msx\xb8\x8f\xdb\xeb\xf3\xfd\x7f\xfe\xaf\xf2\x15_\xf5uܹu\xfbx\t\xe9\xac\x00L\xd6?&\xc2Iڲ-,\xcb*\xa4\xd5C\xfcr\xd4J\xd1\xefM\x0f\xecMj`ʰ\xa9RJ4\x8a\a\xf7\xef\xce8\xa2\x12)%v\xadF\xa3q\x1e\xcb\x12C(O\xb3\xfc)\xcf@;\x81I\x7fi\xdc¨\bR\x18\xb10z\xb2\x85a\xb1\x16FW\xcd\xc20;\x16FН\xb8\x94t\xb6\xda\t\xa4\x01\xf7e\x02\x98\xc5/\xb7ث\x060Z)<?\b\v{\xe9\xf0\xb2\xb6m\x1b\xdb\x0e\xebf4\x9b-궠\xdb\xf3\xf8\xd8G?\xc2O\xfc\x9b\x7f\xca\xcf\xff\xf4Ob[6\x97\xae\\\xcb<i\x0e\xb2\x8d\xee\xdc~\x95\xdds{\xfc\xe0_\xfa[\xbc\xed\x85/\xe0֭[XY3\x91\x16\b0'\x96=\xd3\xf6\x16H{\x1e\xc4J\xe1j4\xb6U\x8b@\xa3\xc0}\x12\xa04x\xae\x13K\xcbK\x0f0e~\x1eBJ\x9a\xad\xb5\xd9\x03\x92ք\\\xae\xc8\x1a\xb6\xa5Y\xfdv\x02'\xa7\xb6\x93\x16\x06\xad\bX\x0e\v\xc3\xd2X\x98\v8\xb5\xa7\x91\xba\xb3\x1a\x00\x93\x05L\x96<\x95\xfat\x80\xa9Nz\x92\xbdP\x80)l6\b\x0f\xa6eۜ\xdb;\xcf\xc5˛t;[\xe1Ū4\xae\xeb\xe2\xba\x0ewo\xbf\xca˟\xf94\x1f\xfb\xe8\xaf\xf0я|\x98O\xbf\xf4I\x84\x94\x9c\xdb=\x87\xb4k\xa9+\x9a\x0e\xe0EJI\xe7\xe8\x88\xfd\xfd\x87|\xe1\x97~\x05\xdf\xf5}\x7f\x8es\xe7/p\xeb֫\xc7\xd9M\xa5\x01L9\x11VEg\xa3%=\x0f\xa2y\x19aI\x84\x90Q7qY\xdcy\xa25~\x10\x80\x94\x93\x03\xa4\xd3\x02\x8c.\xe9\xe0\x8bS\xf9\xa5\x98?\xbe`\x80\x99g \xef4\v\x13h\x15\x92\xed\x12Z\x18]u\v#k\b\xd5;\x011\xcb\xd4N \xe9\x9b9{\x00S\x9d\xcd^~\x80\t_LJ\x89%%\xff\xfb_\xf8\x01\xa4e\x13x\x1eA\xe0\xe1\xb9>\xed\xa3\x03\x0e\x0e\x1e\xd1>:\xa0\xdb\xed\"\xa4d\xbd\xb5\xce\xf9\v\x97\x90R\x12\x04Aj\x80\x912\x9cd\xfb\xfd\x1e\x8f\x1e\xdc\xe3╫\xfcO\xdf\xfd#\xbc\xe7\xf7\xbd\x97v\xa7̓{w\xe7\x000%\xf6NJxR\x14\x0e0\xd1WB\bdY\xd7J\xb4\xb4(\xf2\x00LI\x11\xfaZ+\\Ǚ\xb1\x98\x04X\x12KZض\x8d\x10Q}\x13U\xfc\x1bZ%\x80\x19X\x98\x9b1\v#\x96\xd8\xc2P\xba\x85\x11\x19-\xcc%\x9c\xfa3\x133\x92L;\x01\x030\xa5B\xccr\x02Llgj5>\xf2\xe1\x0f\r\x81D\b\x11\xc6\x0eX6vݦ\xb5\xb6\xc1\xd6\xf6.\x10\x06x*\xa5\xd2\xc5\x10H\x19N\x18@\xb7}\xc4\xe1\xc1>\xe7\xf6.\xf0\x87\xbf\xf5O\xf1\x8d\xdf\xfc-l\xef\x9c\xe3\xfe\xbd;\xa3\xf6\xa04\x80\tG\xae2\x02\xa4\xf5\xd4Iz\x1e\x00S\xf2I\"\xc4q\x9d\x9e<YHe\x00\x8cR(\xad\xd8\xde9G\x10xS\x1f\xe7\a\n\xdfsp\x9c>\x87\xfb=\x82\xc0\xa7\xd9l\xb2\xb6\xb1\x85m\xdb)\xcb\x02H\x12!\xc0\x92\x03\xcc\xc0´lgX\x9dw`atA\x16Fk\x90r\x95,\f\xd9,L\xe3y\xb4\xa8!\xf4\xa8\x851\xed\x04\x96\b`\x12\f\x7fUYP\xb2\xcb\x02\x982N\xc2$\x1f\xd4\xee\xee\xb9\x13\xf7\u0603\v\xf6\x18Z\x92\r\xf2\x03h\x810c\xa6\xdf\xef\xd2=l\xe3i\x9f\x1b7\x1e\xe7\xbd\xef\xfbV\xde\xf3\xb5\xef\xe5ҕK<z\xb4ϝۯ\x8e\xb6)(9\x06\xa6\xd4T}\xbdH\x809\xae\x9cZl/\x0f\x8d@c\xd9\xe9\x03\x86'^\x1f\x05\xbe5)%=\xc7aw\xef<\x7f\xe1\xc7\xfe\x1eZ)|\x15 &\xc4\x13h\xad\t\x82\x80\xf6\xd1>\x0f\xee\xdd\xe1\xa5\xdf\xf9->\xf6k\xbf\xc4\xef\xfe\xd6\xc7PJ\xb3\xbds\x0e!\xe5H\x93\xcb\xe9\x17\xa1>\x13\x00\x13\xb70\x96p\xe9\x17laT0\x1f\v\xa3\x97\xc1\xc2\xd4NZ\x18\xd3N\xa0\xfa\x003\xfbM\x8f\x1e\xed\xaa8\x19;\x17\xc0\xcc\x1c\xf4\x93Y\x98\"\x8b\xff%\xbf\v\x15\x13\xfa\x7f\x84'\xf0`\x92p\xfa}\xfa\xbd\x0en\xbf\x8f\x16\x92\xbd\x8b\x17\xf9\xa2\xdf\xf3ż\xeb˾\x8a\xe7_\xf8\x026678\xdc?\xe0\xd5W^A\b9Z\xdd7G;\x81DGW\xcf3\xe0w\x9e\x00\x13\x9e\x10\xbe\xefG\x05\b\x8b\xbbT\xb4\x0e\xeb\xf7\xd4\xea\xb5p\xb0\x17\xc9,LY\xed6&ai\xe0\xf9\x04J\xe3\xbb\x01\x9e\xf2\x90S\x062)\x04ۻ\xe78\x7f\xf1\noz\xfe\x1d|\xdd\x7f\xf7-|\xfaS\x9f\xe0'\xfe\xc5?\xe2\x97~\xfe?\xb3\xb5\xbdC\xad^O\x95j\x9d\x16`\n\xbdo)\x11`\x00|mѪ9\\Z\x9bla\x82q\v\xa3\xd2Y\x98a\xd3\xc4S,\x8c(\xc0°\x84\x16ƴ\x13X\x1c\xc0d\xba>\x13,#\xc9\n\x95\x81\xb3u\x9e\xf18\xe7䵨\x830\xb8\vWJ\xa1T@\xe0\xf9\xf8\xbe\x87\xeb{a\x81\xab\xc0\xa7\xdeh\xb1\xb5\xbd\xc3\xeb\xde\xf4\x16\x9eyÛy\xf6\xcdo\xe3\xe9g\xde\xc4\xee\xf9=|?\xe0\xf0`\x9f\xdbG\x87ȱ\xe5\x89\xcc\xf3Z\xaae\xa4\x92\x8f\x9d\x9e>\xb1\x97\x0e0h$\x12\x15\xf8%\xc4xh\xa4%i\xado\x10\xf8A\x14\xe8\x9b\a`\xca8\xf4\n\xa5|\x02\xe5\x81\xf2P'\x10?\xb4'J+\\\a:\xba349\xd7n<\xc1\xf7\xfc\xd9\x1f\xe1\xb9\xff\xf8o\xf9\x87\xff\xf7\x8f\xa2\xb4\xa2\xd1h\xa6\xaf\x19\x93~ȟ\xf9\x02\x8b\x04\x18\x89\xc6U6\x8fm\xdf\xc1\x92.\x8ew\xd2\xc20nat\xf1\x16\x86D\x16F$\xb60zI,LR\xba0\xd5xg\x03L\x96Չ\xa2\x01\xa6jQ1vRJ\xa9j\x1c\xcc\xc8ݖ\xef\xe3\xf6\xfb\xf8\xbe\x17^\xa8\xf1?+\x01\x1d\x0e\x1c\x96%\x11\xc2ªY4\xebM6.\\fg{\x97\xdd\xf3\xe7\xb9r\xe5:\xd7n>\xce\xd5\xeb7\xb9|\xf5\x06\xdb;琖\xc0s=:\x9d\x0e\xb7o\xdd\x02\xc0\xb2\xe4\xa9\xed\x03R\xb9\x8c*\x01\xccȩ\xaa\xe6\f0\x91-\x91\xe0y\x1eA\xe0#\xa5,\ff\x06\xad%\xd6\u05f7P*\x88\r[Y\x01F\x97t\xdc\a\xff&ū\f\xce\x01\x89\x90\x10?\x03\x8f\x0e\x1eqtt\xc0\x97\x7f\xe5׳\xb5\xb5\xcd\xdf\xfc\xe1\xefǶ\xed\xec\xa9\xeaK\xd8N`\xd2O\x03\x1df$]j\xdeǉՅ\xa9\xa6\x85!\xb1\x85\xa1r\x16\xe6m\xc9-\x8c\x01\x98\x8c7\x0e\xd5\x00\x18=\xb1\xc6Ԣ!f\x8e\x81\xbc\xa5d\xa5*\x85R\x8a?\xfb\x97\xfe\x06\xbb\xbb{\xf4\xfa\xfd(6\xe5\xf8\xaf\ti!\x84\xa0fש\xd7\xeb\xb4ZkԛMj\xb5:\x8dz\x83z]\xe0+\xf0\x03\x8d\xeb:8\xbd\x1e\x0f\xee\xdeAi\x15]\xf4\"\xb6d4\xab\xdc\xeb2\x03\x8c\\\b\xc0\f\x96T\x84\x94a\x86\x8e\n\n\xbdJ\x94R\xd4j5\xd6\xd67\xf1\xfd\xa0\x82\x00\x93\xef\x1c\x18@\xf5\xadW>\xc7\v_\xf8\xe5|\xfd7\x7f+\xff\xfc\x1f\xfd8\xe7/^I\x04\x82\xab\xd0N\xe0\xe4\x9f\bcanl\x1e[\x18\xb4B\x15`a\x02\xa5сF\x9ci\vcaџha\f\xc0\x14t\xe7P\x91L$Q\x90\x1d*\ab\x96\x1c`°=\xf0=\x8f'^\xfb:\xce_8O\xa7\xdbG\xca\xd1^#\xa8\xf0\xaaV\x84\x99I\xcaW\x04\xbe\x87\xef:\x1c\x1d\xeeG=Q\xe2ŝ\xc2\xec&\xeb\x84\x1aՉ\xe7\xb5\xe5\x03\x18\"\x80YDA\xc3\xf0\xb9R\vz\xddN\xecִ\x00,\x93\x12\xcfs\xd8\xd8\xdae}c\x03\xdf\xf3\xa6\x16dI\n0s\xae=\x9d\xf8\x0f\n!\xb9\x7f\xf7\x16_\xfd\r\xef\xe3C\xff\xe5߳\x7f\U00010d75\x8dSAf\x15\xda\tL\xfa\x13\x81\xb2hZ\x0e\x97\x9aw\x87\x16F\x15da4\xea\x18Vά\x85\xd11\vc!t\x00\x93#\xb9\xce\x04\xc0\xa4=U\x97%\x95\xba\xaa\x00\xc3q\x17\xebe\x06\x18F\xb2\x90\xba\xedC\x8e\xea5:\x9dNT\xc6=6UM)\"&\a]\x83\x91Ǹ\xa2\xa7\xff\xad\xd5\x06\x98\xf8Y\xaa\x8b\x89cMda\xf4\x90\x9f\x84eqtt0}\xd2̸?\xbe\xeb\xb2w\xf1*kk\x1b\x1c\x1e<\x9aXD/)\xc0,\xea\x1aNr\xf8\xa5\x94xn\x9fݽ=\xbe\xfck\xde\xcb?\xfc\xdb?\xc6\xfa\xfafu\x00&\xe3\xe9\x98v\x19I\xa0\xf1t\x8d\x9b\x9b\xb7\xb1\xa4?\xb40z\x9a\x85Qq\v\xa3f[\x18\xa5c\x13}\x02\v#\x8a\xb40\xba:\x16\xc6\x1e\xb50\xa6\x9d\x80\x01\x98ynr\xb1\x00S\xfc\xd4,d\x18\xab\x12\xff\x17\xa6=G\xdfG\x15v\xe3\xff\x92\xbf\xad\xb3\x020\xa7%\xdff?\x0ff\x02L4RK\xcb\xe2\xe8`?\n\x90)h\x7f\x04\xb8\x9eǕ+ש\xd5\x1b\x13\x8b\x1b\xa610s\xe9D\xaf\xb3\x9d\a\"\xba\x0e\x0e\x0f\xf6yǗ\xfc^v\xf7\xce\xe3\f\x97W\xb3\x8d\xc0\x85\x02\xcc\x1c\xe2`\x18Z\x98>\x17[\xf7\x92Y\x98\x11ˢO\xb70Z\xc5&\xfa\x84\x16\x86\"-\fձ0\xf5\xd0\xc2@\xb0\x92\x00s\x96\x8b\xd9U\x1d`\xc6 f\xf9\x01\xe6\xb49 \xf1;1\x003k\x0e/\x11`B8\x90\x96\xc5\xc1\xfeÂ\xab\x11H|\xcf\xe1\xfac\xaf\x19\u07b9f\x05\x98\xb9\x92d\x06\x80A\x846\xa6\xdf\xebr\xfe\xe2%\x9e\x7f\xe1Kh\x1f\x1dL\x1c\xe4\x92\f\xe6\xcb\b0\x03\vsm\xf3\x1e\x96\xf0\t\x94\x88\xacF\xb4D\x94\xd7\xc2hca\xc6-\x8c\x98\x96\x91T\xe5v\x02\x06`\x96\x1a`b\x10\xb3Z\x00\xa3\f\xc0\x14\x0f1s\x00\x18\x1d\xc5s(?`\xff\xd1}lێ7\nʷ?Z!\x90\xdc|\xe2)|\xd7\x1d\xb9\"\xab\x19\xc4;r*\xa4\x02\x98\xf8O\x9c\xbe\xc3;\xdf\xfd\x95a,ش\x98\x98\x15\x03\x18\x06=\x92j}.5\xc7-\x8cNP\x9d7\xfa>\x0e6#\x81\xbeg\xcb\u0088\xa9\x16ƥW\x7f{\x18\v3\xad\x80\xa8i'\xb0\x94\x00\xb3L\x9b\\h\x1a\xb5\x06Q\xf0\xfc NW1+\x050\xb2\xa4e\x8dE\x01\f\x80%\x04A\x10\xf0\xf0\xfe}l\xbb6\xed\xe8\xa7>+|\xdfa}s\x8b\xc7^\xf3\x14\xfd~od\xc2(\xc3N\xe5?\x8bu\x14\xbf\x91\x1d`,ˢ\xd3>\xe4\xa9g\xdeĵ\xc7\x1e\xa7\xd7m#Ɨ\x94V\x10`\x86\x16f\xfd\x1eR&\xb70j\xdc\xc2h=ja8\x9b\x16\x86S,\x8cW{\x1akZ]\x98B\x00&\xc9$n\x00&\x1f\xc0\xa4\xd9\xff\xd1a\xaf\n\xe8#\xd3N\\\xe4\x1d\xf4\a\x81\x91:֣\xa7`\v#\x96\x16`\xd2\xefki\x9b\x98?\xc0\x00H\xdb\xc6u{<zp\x17\xbb^+\xc4\xc4H)\xe9\xf5z\\\xbev\x93s{\x97p\x9c~\x8aX\xa8){\x1a/\xa3S\xf8\x95\x1c\xbe\xb0V)\x06\xa8)ѹ\x9e\xe7\xd0Z_\xe7\xcdo\xff\"\xba\x9d\x0e2\xf1\xa0W\\\xc1\xady\x02L\xdc\xc2\\LiaP\xcaX\x98\x94\x16\x86(\x16\xa6\x10\x80ɀ\xc7\x06`\n\xbf\xf5O\xb4\x8cT\xa5\xb6\x03\x92\x92\xda\t\xe8\x19\x93\xd7\xf0\xda*\xa5hL\xf5\x00&\xf1\x1bO\xb1|\xa0)\xded\x8d\xbc\xf8\x9c\x01fP\xc7\xe5`\xff\x11\xed\xf6\x01\xb6\x9d\xadl\xfeɋ]\xd2\xefvy\xe3\x9b\xdfF\xa3\xd9\x18\xb6\xa6\xc8\x15\aS&A\x0e\xae\x0f\x99p\xf895\xbdH\xe2\xf4\xfb\xbc\xf1\xcd/ \xa4HX/F\xe4\x18\x02\x17\v0q\vc\xa5\xb60\xfa\x84\x85Q\xcbla\xb4.\xd1\xc2\\Ư==\xb9:o\xd6\xc9մ\x13(\xf5~t\x95\xe2`&\x9a\x98\xa2\x96\x0fH\x1a\a#ʛ\x00\x16\a0i\xadT>\x80\x89\x8aߔt\xc6\xcf\x17`\x06\xb7\x98\xf5z\x93\a\xf7\xef\xd29:®\xd5\n\x81\x18\xa5|\x84\x94<\xf7\xd6/\xc0\xe9\xf5\xf2\x03L\x89.5MV\xd8l\x80\t'\xa0^\xbfˍ'\x9ed{\xe7\x1c\x9e\xeb\"\x84,켨\x12\xc0\x94aa\xf42[\x98\xf8$^\xb0\x85\x89g$\xe5\x1cR\f\xc0di|\xb9\xc0@^Qp\xbf\xc3\xdc\x103\xf7@^]f\xdf$\x9d\xfc\xb7i\x01&\xcb\xdf/)\x90W\x8f\xfe\xdf\xdc,L\x91\x003\xf1\x11ZSk\xd4y峟F\xa9 \xf1\xd2ǩ'\xb8\x94\xf4z\x1d\xae^\x7f\x9c'\x9fy\x96N\xe7h\xb4]DV\x80\x89۰\xb9\x9d\xc1\xe9\x00f\xb0\xff\xbe㰳\xbd\xc7\xc5K\xd7\xe8\xf5\xbbC\xeb\x15\xd6II`a*\b0\x93\x1fY\xae\x85QU\xb30zq\x16\xc6Mha\f\xc0\x94p\xa3\xb3`\x80\x19\x1d\xa7\x16\x8f3r\x11\x00S\xde\a<=\x90#w#\xbf\x8ad\"\x95\x0e0SN\xe2\xfc\xed\x04\x12\x9c+Q3\xcd\xcf~\xfaw\"[ \v\xb8\xe0%\x9d\xa3#^\xf8\xc2w\xb3\xbe\xb1\x81빅\x01̲lZ\x85\xf9z{\x17.\xd1=:\xa4\xdf\xef\xe2:=\x02\xdf\x1b\xe9\xfa\xbeT\xed\x04&\xbc\xb8\xa7,Z\xb5>\x17J\xb20\xb2b\x16fdQi\x8a\x85\x19\xbc\xafb-\xcc\xdb'[\x18\xd3N \x9d\x8fZ\xb2Tj\x91\xe3\x06\xa3\xcc\xcd^\x04\xc0\x948\\\xe7.{_\xe5T\xea\xb9\x01L\xf2i=\x1d\xb2\xcc8.\x96\x94x\xae\xc3g_\xfa\x04\x8dFk\xa8\xef\xf3l\xbe\xef\xd1h6\xf9\xc2/}\x0f\x9dv\xfbx)%'\xc0\x88\x052MR\v3\xf8\x8d\xb0,\xda\xed6\xdf\xf4Ǿ\x83\xdf\xff\xcd\xef\xa7Vo\xe0y\x1e\xb6e\xd3\xed\xb4Ê\xd5\xcb\xd4N`\xe2]\xaf&\xd05\xae\xae\xdfƖ>\xee\xb0:o\xdc\xc2\xe8\\\x16F\xce\xcb\xc2\xe8d\x16\x86\x04\x16f0\x9d\x9ffa\x8e\x1bXβ0\xdd\xc8\xc2<u\xd2\u0098v\x02i0aE\x00\xa6\x1awu\xf6\xea\x00L»\xfe%\xad\x05\xb3(\x80Iz`3\xc7\xc1D\x9bR\x8af\xb3\xc5Ç\x0fx\xe5\xf3\x9f\xa1\xd1j\xe5\x8e\xf7\xb1,\x8b\a\xf7\xee\xf1\xb6w~\t\x8f?\xf9\f\xf7\xef\xdc\n\xb3\x92\nXBR\v:\xe4i\x01f\bs\x9eCk}\x83\x8d\xad\x9d\xe1/\x94\xef\xe3y\xeeb\x01\xa6\x808\x98c\vӛQ\x17F\xa5\xb30\x03\xf8\x99\xb7\x85a\xbe\x16f\xd8)n\xa6\x85\xf1\x86\xb10\x83\x1eI+\x050Y\x1f{&\x01&\xfa\xf8+\x10\x1cc\xaf\"\xc0(\x030\x85L\x96*\xe1\x81\xc8\v0D\x03xkm\x8d\xdf\xfe͏r\xb4\xbf\xcf\xf9KWP*ȵ\x0fA\x10\xa0u\xc0W~\xfd7\xe19\x0eJk\xac\x02\x00&\xc9\x15P%\x80\x19|\xe3:\x0e\xe0\x8c\xd9/k\xe9\x01F\xa0\tT\x8d\xab믆ua\xe2\x16F\xe5\xb00\xd1\xf7\x81\xb101\v3\x16\v\xb3J\xfd\x90L5\xde\xe4\x00#\x16|7\x17\xdb\xe4*\x1a\x18i\x00\xa6 ɢ\xe7\x020\x83\xad\xdeh\xf2\xb1_\xfd\xe5\x91\xc9!\x8f\x859\xdc\x7f\xc8[^\xf8\"\xde\xf8\x96\x1798|4y\xc2\xce\x15\x03\xb3<\x00C\x14\xe4\x1b\xff\xb7\n\x003\xb40\xf5\x1e\x97Z\x0fOZ\x18\xc6-\x8c:aa\x02e,L\xa6X\x18\xd3N`\xa5\x01fY6Y\x1a\xc0,pS\x06`r\x03\x8c>]\xd1\x15\x0e0\xb6\x1d\xc6g\xfcƯ~\x98\xd6\xdaz\xee\xd4\xea \bPZ\xf3\r\xdf\xfc~<\xd7E\a*\xf7\xf9\xba,10I\x06\xa7UXB\x8a[\x98k\xebw\x91\xc2;\xceH\xd2\xd3baN\x16\xb0\x1b\xfe~\x8a\x85\xa1d\v\xa3+oaz\x04\xf6\xd5Q\vc\xda\t\xac\x06\xc0\xa49Ne\x17\xb4)\x02b\nk'\xc0b\xe3`\xc4\n\x00L%\xb7\x92\x00F\xa9\x80\xf5\xf5M^\xfa\x9d\xdf\xe6\xe5\xcf\x7f\x86\xb5\xf5\x8dDE٦[\x18\x9b\x87\xf7\xef\xf0%\xbf\xe7kx\xfdso\xe7p\xff!r\xa2\x85!\xb1\x85Y\xd42R\xa5\x01&\xe3\x0e\x15\x050q\vsq\xdc\xc2\xe8I\xb10\xf3\xb00\"\xb5\x85a\x9a\x85\xd1U\xb20oC\v\x19Z\x18\xd3N`I\x00&\x13\xa9,\x15\xc0pjہ\xd4w\xa4Y\x96\x91\x8a\x9f\bF\x96閴\x9d@\x1a\vS\xfc\x92\xa4\x98\xeb\x12R\b1\x9a\xe6\xda\x1a\xbf\xf8s?\x89\n\xfc\xc9-\x01\x92\x9e\xd0R\xd2\xeb\xb5\xd9\xda\xd9\xe3\x0f\xfe\xd1?\xc1\xd1\xe1~!\x81\xbc\vs2\v\x04\x984\xb3̼\x8aٕea\x82\x13\x16Fe\xb40\x14ga\xa8\xa0\x85\x11VA\x90k\x8a\xd9\xe5\x05\x98\xb4Ǹ\x908\x98\nn2\xe5,^\x18\xc0h]X\x83\xe2\x94|\xb4\b\x80)g\x19\xa9\x9cç\xe6\n0Zk\x1a\xcd&\x8f\x1e\xdc\xe7ÿ\xf0\xd3ll\xed\xe4[JҚ\xfd\x87\x0fx\xdf\xfb\xbf\x93\v\x97\xae\xd2m\x1fM\x80\xa2\xea\x03\x8cH1\xb4\xe6\x02\x98\xac\xd3̂\x01\x06\xc0W\x16\xeb\x05Y\x18\xc6\xfb$i\xbd8\v\xa3+jaD\x90\xfdd6\xd5x˻\xbf\x99W oٖ6\x0f\xc4,&\x90\xb7\xc4\xc9`\x8e\xed\x04V\v`&\xfc\x81\x12\x01\x06 \xf0}\xb6w\xce\xf1\x8b?\xf7\x93ܽ}\x8bV\xab\x95y)I\xda6\x0f\xee\xdf\xe5\x9d\xef~\x0f_\xf6\xd5\xdf\xc0\x83\xbbw\xb0,\xbb\x1c\x80\x11\xe5E\xe6\x0f'p1\xbb4\xa4\xc8;\xaa,I;\x81\xc9\x16\xc6\xe2\xca\xfa]D\n\v\xa3\x13Z\x18\x95\xc3\u008cZ\x8e\f\x16\x86\xaaY\x98'\xa3X\x98\f\x16\xc6\x00LJ\xb9Y\xd1L\xa4\nor\x11\x00\x13\x0e&\xf3\xde\xd5\xd5i'\x90\xbb\xfapҫ\xa4d\x80\xd1:\xa0\xdeh\xd0\xebv\xf9\x0f\xff柱\xb9\xb9\x9d\xd9\xc2X\x96E\xfb`\x9f\xf3\x17\xaf\xf0\xed\xdf\xfd\xbf\xd0=:\x9a\x00C\x05\x1a\x185\xd5[\x15\f\x92\xa2\xa0\x8fs9\xda\t$\xdd[_Y\xb4\xea.\x17[\x0fpSX\x18=\a\v\x13=j\xba\x85\x19ڕe\xb10\xf6\x89N\xb3\x06`\x8a\xf7\x11\xcb\x020\x95띴\b\x80\xa1\xc4Թԓ\xfa\x92e\"\xcd\x05`\xc8\xdeN\x80\x14\xe7\x81\xef+v\xce]\xe0\xa7\xff\xe3\xbf\xe6s\x9f\xfe]\xd66\xb2\x05\xf4\n!p\xfa}\x82\xc0\xe7\xbb\x7f\xe0\x87Y\xdf\xd8\x1cV\xa2-\x05`b\xe7@)\xa7\xf10GW&\x1f\xa0\xab\x98\x89$2\x1e\x9fD\x16\xa6\xc6\xd5\xf5{\b\xe1\x9fnab`S\x19\v\x13w-q\xa52fat\x15,L\xfdi\xa4\xee\xa6\xcf)ZQ\x80!Ӿ\xaf\x16\xc0P\xb1\x0e,r\x11\x00\xc3\x02\x92\x86W!\x95\xbat\x80\x91bx\x17(\x13]\x82\xd9\xe3`\x94R\xacon\xf2\xe0\xdem\xfe\xf5?\xfe\xbb\xec\xec\x9c\xcbx\xf7\"Q*`\x7f\xff\x01\x7f\xfcO\xff ϼ\xe1-<\xba\x7f\x1f+\xde\xe4\xb1\x04\x80Y\xb4a]n\x80ɳ\x8c4\xb00}.\xb6\xeeͶ0\xd1\xefN\xb50*\x06?\v\xb40z\xcc\xc2P\xb5\xba0g\xb1\x9d@:L8\x13\x00S\xb5M.\x02`\xe6\xbb\x19\x80I|2H\x89\xe38\xf8\x9e\x8b\x90\"\x1a\xf4\x8b\a\x98\xc1\x85\xbb\xb9\xb5\xc3\xdf\xff\xf1\xbf\xc6\xc1\xc1#\x9akk\xa9-\x8c\x90\x02\xa5\x02\xeeݽ\xcd\xfb\xff\xe4\xf7\xf1\xee\xf7|\x1d\xf7\xef\xde\x1a\xedR]\x92\x81)\xbfP\xe5\x12ׂ)\t`\x06\x0f\tT\x9dk\xebw\x8a\xb30\xc3\xdf\xe7\xcbHZ-\vs-\xb40\xb1꼦\x9d\x80\x01\x98jC̒\x03\xcc``QZ!\x91\xa7\x97l[!\x80\x11\x05\x11\x8d\xd2\x1aۮqt\xf0\x88^\xb7\x83e\xd7N\x99\xa8\xf3\x01L\x10\x04\\\xbez\x9d\x7f\xf7/\xfe\x11\x1f\xfa\x99\xff\xc0\xb9\xf3\x17G\xba)'\xfb\xbc-|\xcf\xe7\xfe\xdd\xdb\xfc\xd1o\xff\x00\xbf\xef\x0f|\v\xf7\xee\xbe:6(\xcc/\vI\x14y9\xeah\x96Q\xda\x00\xcc\xd8\xe3\x06ua.$\x89\x85\x99`a\xe2=\x92\x06\x16&\xa8\xb4\x85ы\xb10ͷ\x1fׅ1\xed\x04\xce4\xc0T}\x93e\x03\xcc\xd4?,\x04J)\xbc\xc0\x0f\x971\n\x80\x98\xc0\xf7\xe9u:H[\xae&\xc0\x8c\xfdF\"\bTX\x9f8\xbc\x1e\xf2\xa5&\xd7j5:\x9d#n\xbd\xf2y\x1a\xcd\xe6\x14\xf8,\x02`n\xf0\x8b?\xf7S\xfcÿ\xfd\xd7\xd9ݻ\x88N\x19 eY6\x8e\xd3\xe5`\xff\x01\xdf\xf6\xdd?\xc0{\xffзq\xefέp\xf2\xcf\x000\xc9?\xb0ѧJ\t~\xe0\xa3\xfc\x00!\xf2㤔\x12\xd7s\xf0}\x0f)\xe5\t\x88<\xeb\x00#\x80@\u05f8\xb6\x91\xdd\xc2\f\xbe\x8f[\x18\xc6-\x8c\xa8\x92\x85a1\x16\xc6>\xeeTm\xda\t\xac\x16\xc0\x14焫\x021%\xb7\x13\x984\x11*\xa5\x90R\xe2{.\xdd\xf6\x11\xb6m\xe5\xde\x11˲p\xdc>wn\xbfJ\xbdV\x0f\a\xa4%\a\x98S߬\xd6H[\xd2\xebuq\x1c\a!\xecB@P+\xcdG~\xf9\x83\xb4\x9a\x93J\xff狁\xd1Zs\xf5\xfaM~\xe5C\xff\x85\xbf\xfe\xc3\xdf\xcf\xda\xfa:\xb6mC\x8ae$۶\xd8\x7f\xf8\x00\xcfs\xf9\xde\x1f\xfc+|\xf5{\xff\bwo\xbf26(\xeat\xef/\xa1\xa6\x19\x7f\x9ce\xd7\xe8u\xba\xf4\x9d~\xb8\x84\x95\xc38*\xa5B\x88<<\xe0\xe8`\x1f\xbbV[.\x80\xc90\f\xa6\x01\x18\x10\xc7\x16\xa6Y\xb2\x85a\xfe\x16FW\xc6\xc2\xf8#\x16f\xf1\x00c\xaa\xf1.\xb2\x9d\x80\x98\xa5@*@8\xe93\xa5\nYF\xd2ԛM\xee\u07b9\xc5\xe1\xc1>\xb5Z#W\x99\xf9\xc1\xaeH!\xf9د\xfd\n\xf5\xa6urb\\\xc2v\x02\xfa\x94o4\x9aF\xbdɫ\x9f\xff4\x9e\xefbY\xf9\xcf&\xad5\x9bۻ\xfc\xcc\x7f\xfa\x7fy\xf5\xd5ϱ\xbd\xbd\x1b[\xe6\xc9\x0e0\xbe\xef\xd3l\xads\xf1\xca5\xfe\xbf\x7f\xf9O\xf8+\x7f\xfe\x7f\xa4\xd1h\xd2h\xac%^j\f3\x8d4\xb7^y\x99\xab7\x1e\xe3\x87~\xec\xef\xf1\xc2;\xbf\x9c\xbb\xaf\xbc\x9c\xae\xc2e\x8e8\x98\xf1\xfd\xaa7\x9a\xbc\xfa\xb9\x97p\xdd\xdeX qv\x10\xef\xf7z|\xfaw\x7f\x9b\xb5\xb5\rt\x10,\x06`2rJѵ`\xe2\x00#\xd0\x04\xda\xca\x15\v3کZ\xa5\xb00\xa2t\vCe,̕\xa1\x85\x11I\xa6\a\xd3\x0fie\xdb\t,\xcbʒLea\n\x8a\x83\t\x02\x9f\x9d\x9d\x16\xbf\xf2K?O\xbfםP\x90,\xcb\x04\x1c\xb0\xb5\xbd\xcb\a\x7f\xe6?r\xfb\x95ۜۻ\x88\xefya\bf\xc9\x00\x93\x1aLr\xc5\xc1\x1c\x17@\xb3\xebu~\xf9C?K\xddn\x14r2(\xa5h6\x9bt\xdbm~\xfc\xc7~\x88F\xab\xc5\xda\xfa\x06\xbe\xef\xa5:.J\x85\xcbF\xbe\xefc\xd5j\\\xbav\x03\xdfs\xf9\x1b?\xfc\x03\xfc\x9d\xbf\xf9\x17Y\xdf\u070e\x96\xabf\xc7\xc1\x84\x1d\x97m\x8e\x8e\x8ex\xf8\xe0\x1e_\xf5\r\xef\xe3\xcf\xff\xb5\xbf\xcb\xd5k\x8f\xf1\xe0\xdex\x10/\xa5\x05\xf2N\xda\xea\xf5\x06\xbf\xfc\v?E\xadV\xcc\xf1\xd7\x1a\x1a\xad\x16?\xf3\x93\xff\x1a!\x04\xf5V\v\x15\x8f\x15:#\xed\x04\xc6\x01\x06\xc0\xd3QFR\xf3an\v\xa3\xa3\xe2w\xc9-\fg\xce\xc2\b\x82R&\x1c\xd3N\xa0\xa0;\x86D\xfb_\x1e\xc0\xe8\xe1S\x17\x9fl-\xe7\x010J)\x82  \b<\x94\xd2\\\xbfq\x9d\xdf\xfd\xc4K\xfc\xcb\x7f\xf2\xf7\xd9\xde\xddC\xeb\xfc\xb9\x1eJ)\x1a\x8d\x06\x9d\xa3#~\xe4\xcf}\x1f\x8e\xd3\xe3\xf2\xb5\xebX\xb2\x16\xfdm?\xb2=\xaa\xf0~H\xf3\t\xe4\xd5(\x15D\xfb\x12p\xf5\xfaM\xfeۇ\x7f\x81\x8f\xfc\xe2ϱ\xb5\xb3\x93:0v:`\x06\xec\xee]\xe0\xa3\x1f\xf9\x10?\xfa\xe7>\x80eY\\\xbc|\x15\x81 \b\x02<\xdf'\b\xfc\xb0Kt\x10\f\x8fm\xe0\x87\xff\x94R\xd4\xeb6\xdbۻ\\\xbez\x1dKJ\xfe\xed?\xfb\a|\xffw\xfe!~\xee\xa7\xff=\xe7/^\xa1f\xd7\x12\x19\x18˲\xf0\\\x87\xbb\xb7?\xc7\xf9\xf3\x17\xf9\xfe\x1f\xfa?\xf8\xf6\xef\xf9_q]\x97\xfdG\x93\x9a:\x96\a0J)\x82\xe8\xf8\x03\\\x7f\xec\t>\xf8\xd3?\xc1\xaf}\xf8\x83l\x8d\x18\xab<\xe7p\xc0\xe6\xd6\x0e\x9f\xf8\xf8G\xf9\xfb\xff\u05cf\xb2\xbb\xb3\xc7\xce\xee^\x18\xc3\xe1\a(\x15L\\z[\xb5v\x02\xe3\x00#\xc4\xc0\xc2܅S\xaa\xf3\xaa)\x16&\xfc\x99ZJ\v\xa3\xe7]\x17&\x16\vS\xb4\x851\xed\x04R^\x9fU\xcdD\x12\xf1\x82\xe2\x8b/{'~\xf3\xa5\xfb\x81\x9e\xf8N\xb2\x01\xcc1,\b\xa4\x94ض\x8de\xd9ԛ\r֚M4\xf0\v?\xf7S\xfc\xad\xbf\xfa\x17贏\xd8\xd8\xdc*4{IJɣ\x87\xf7ٻp\x99?\xfam\xdf\xc5\x17|ᗱ\xb1\xb5I\x10h\x9c^\x87^\xcf\xc1\xf3\x1d\xa2q\xebd_\x9d\n\x04\xf2\x06*\x88\xd6죓EJl\xdb¶m\x9akk\xb4Z\xeb|\xe4\xc3\x1f\xe4\xc7\xfe\xe2\xff\x8c\xe79\xb4Z\x1b\x85\x80\xe0\xf8]ã\a\xf7\xb8|\xfd\x06\x7f\xf8[\xbf\x9b\xb7\xbf\xe3]ԛ\xcdc\x18\xf5\x15*:\x85\xa5mcI\x19Ņ\xc0\xe1\xd1>\x9f\xfd\xd4'\xf8\xaf\x1f\xfaY~\xe9\x83?\xc5\xddۯ\xb0\xb9}\x8e\xb5\xb5\xb5\x99\x93\xbd\x04\x84e\xe1\xb9.\x8f\xf6\x1f\xb0\xb6\xb6\xc1W\xff\xfe\xf7\xf1u\xdf\xf4Ǩ\xd7\x1b<zp\x0f4\xa5\xf6CR\x81\n\xefࣻi۶\xb1l;\\\x02k\xae\x11\xf8\x1e?\xfd\x1f\xfe\x15\xff\xf8\xef\xfd\x9f\xd4\xea5\x1a\xf5f\xb1\x19xZ\xf3\xe8\xe1\x03\xde\xf0\xdc[\xf9\x83\xff\xfdw\xf0\xe4\xeb߄\xb4-\x9c~\x1f\xa7\xd7\xc1\xf7=\x82 \f\xe8\x96\u009a\xdc0sI\xdb\t\x9c\x80\x18\x01\xbe\x924m\x8f7\xef\xfd6\xae\xaf\xf0\x02\x8d\nT\b\xf5J\xe1\xfb\xe1\xd7\xc77K\x8a@\xa9\x10\xb2\x95\n\xbf\x0f\x06\xbf\x0f\xbf\xf6\x95BE_\xa35B\x86\x9f\xb5\x14\x02!\xc3\xc4\x03!\x04R\x86 \x10\xfe<\xf6\x18A\xf4\xbb\x93\xcf\x11\xf2\xf81\xc7P\xa5\x8e[ \xc4l\xd0\xc8\xf7\xc3\xca\xc1\xa3\xc6(\xde]{\xf0\xf5\xe0}\x85\x7f\x9f\xd8{\x8b\x00%\xbe\x0f\xc3\xdf3|_\xf1\xf7(\xa4\xc4҇\xf46\xbe\x1e\xb7\xf6\fR\x1dΞ\x9c\f\xc0\xa4\x1eOW\x05`\xa2\xffx\xbe\xafk\xdb\x1b\xcd\x7fuyo\xfd\xbdJ\xeb\x9a\x14\xc2[\b\xc4\xfc\xc6K\xf7\x83S\x1bA\xa640\x1b\x1b\x9b\xac\xad7\x11\x02|\x0f\\ϡ\xd3ns\xe7\xd6+\xfc\xee'>\xce\xcf\xff\x97\x9f\xe4\xd7\xfe\xdb/\xb1\xbe\xb1A\xab\xb5^J\xfa\xb5\x94\x92N\xe7\x88\xceQ\x9b+\xd7n\xf0\xe6\xe7_\xe4\rϽ\x95\xa7^\xf7F\xce_\xba\xca\xc6\xc6&҂n\xb7K\xfb\xf0\xb0R\x00#\xa4dcs\x93\xba\xddDH\x89\x94\x02\xcf\xf3p\x9d\x1e\x9d\xf6!/\x7f\xee3\xfc\xecO\xfd\x04?\xfb\x9f\xfe\x1d\x8df\xb3\xb4c8<\x8eGG\xf4zm\x1e\x7f\xf2\x19^\xff\xa6\xe7y\xec\x89g\xd8=\xb7G\xbd\xd5D\n\v\xdf\xf7q\xfa]\x0e\xf7\x1fq\xe7\xce+\xbc\xfc\x99\x97\xf8\xecg~\x97{\xb7o\xa1U\xc0\xc6\xd6\xd6\xf0=\x9e\x16\xf7$\xa5\x85\x10\xd0\xef\xf79<xH\xb3\xb9\xc6\x17\xbe\xfb+\xf9\xc6oz?\x97o<\xc6\xfe\xc3\xfb8\x8e\x83%\xad\xa9\x94R\x04\xc0\b$\xeb\xeb\x1b\xd4[-\x84\b\x83\xcf]ϡsx\xc4\xddۯ\xf0\xa9O\xfe\x06\xbf\xf4\xf3\xff\x99O\xff\xce\xc7\xd9\xda9G\xadV/\xed\x1c>\xd8\x7f\x88R\x01O\xbd\xee9\xde\xf2\xc2\x17\xf3\xcc\x1b\x9f\xe3ʵ\x9b\xac\xadoQo\xd4Q\xbe\xa2\xdb9\xc4q\xfa\xa3\xc3Ϫ\x00Lda\xfaA\x9d'w>\xc7\xc5\xd6\x1dz\x8e}l$#(QJ\xe1\xfb!\xa4\x84\xbf\v!&\xf0\xc3\xc7\f\x7f\x16\x846ME\xbf\xf7\xa3\xdfK!\x90\x92\x18\xa0\f @\x8e\xc1J|\xf2?~\xce1\xc8\x1cC\x84\x94\xe2$|\xa8鰢f@K\xbc}\xc21\xbc\x84\xefs\x14^\xe2\xef\xe1\xd8\x16\x1dC\xd88\xc8XX\xa2\x8b\xb2.\xd2\xdex\x1fB\xf7f\x8f\x80g\xb9\x9d\xc0Y\xae\x05\x13{\xaaDx\xae\xaf\xaa\n1Y\rL\xc0\xfa\xc6\x16\xbf\xf9\xd1\xff\xcaG>\xfc\v\xb4ۇ<\xb8\x7f\x8f\xfd\x87\xf7yx\xff\x1eG\xedCܾCk\xad\xc5\xe6\xd69\xa4\xa4\x80`\xde\xd3'\x01!\xa0\xd3\xe9\xd2\xed\x1c\xa1\x02\x9ffk\x8d\xdds\x17\xb8\xf9\xf8\x93\x9c;\xbfǳϿ\x83/\xf8\xa2/\xe3\xe8\xe8\x00)\xe4\xc2\x01\x06\xa0\xd1l\xf2\xe1\x0f\xfe\f\xb7o\xbd\x8c\xeb\xf6yx\xff.\x87\x8f\xf6y\xf4\xe8\x1e\x8f\x1e>\xe0\xe8\xf0\x11\x1a\xc1\xce\xce9,\xcb.\xdc\xc0L:\x8eZk\xba\xdd6\xbdn\x17\x15\xf8 %5i\x83\x10h\x14\x81磴FZ\xa1-j\xb5\xd6i4\x9b\xc3\xe7N\xfb\x9c\xc3\xcf(L\xb5\xef\x1c\x1d\xd1\xed\x1e\xb1\xbd\xbb\xc7;\xdf\xf5\x1e\xbe\xf2\xeb\xfe 7\x9fx\x92\xa3\xa3\x03:\x9dN8\xf8N\xbc;,r\tI\xd1ln\xf0\xdf>\xfc\xf3|꓿I\xbb}\xc8\xc1\x83\xfb\x1c<z\xc0\xa3\xfd\x87\xf4:G\xf8\xbeOk}\x9d\x8d\x8d\xadP\xf1\x97x\x0e[V\b\x89\xddv\x9b~\xbfC\xadVgsg\x97\x8b\x97\xaer\xf1\xcauv\xb6\xf7\xf8\xe2\xaf\xf8\x1aΝ\xbbH\xbf\xdf\r\x8dL\x05\x01&\xeb2R\xe1\x16\xc6\x0f!f\xd9,\xcc\xf8\xe3ʱ0߀[{-R\xb5O\xb70+\xdcN\xc0\x14\xb3K\n\xae\x02\xc0\xf3+\x021vQ\x06F\a\x01\x1b\x9b-~\xf6\xa7\xfe=\xff\xe4\x1f\xfc8W\xae\xdeD\vMݮSkԣI\xb7\x06\f\x06\x95rwl0q\xae\xad\xad\xb1\xbe\xbe\x0e\x80\xe7y\xb4\x8f\x0e\xf8\xf5_\xfde\xee\xbc\xfa2\xaf|\xfe3|\xf9{~\x1f\x87\a\x8f\xc0\x92\x95\xa8\x05S\xaf7\xf9\xe7\xff\xcf\xdf\xe1\xb7?\xfe\xeb\xec\xee\x9eC)\x85mװ\xeb5j\xb5\x06\xe7\xf6.\"\xa5$\b\x82\xd2\x01&~\x1c\xd7\xd77\xd9\xd8\xd8\x06\xc1\xb0\xea\xe9`9I\x88X!\x8dx\xf5ӱ\xa5\xa3\x01\xb4\f\xa0\xb7\xd3>\n{\x1cY\x16\x8f\xbf\xf6i\xde\xf9\xae\xf7\xf0E\xef\xfeJ.]\xbaF\xbbs\xc0\xedۯ\"\x00K\xcaS)\xa5\xa8\x18\x18\xa5\xa0\xdel\xf1\x93\xff\xf6\x9f\xf1\xd1\xff\xfa\v\xec\xee]@\vA\xbd^Ƕk\xec\xee]\b\xd3\xd0gX\xa5\xa2\xb6 \b\xeb\xcfl\xed\xec\xb0\xc5\x0eJ)\\\xd7\xe13/}\x92O}\xe2\xe3ܿ\x7f\x9bǟz\x86\xabWo\xd2\xebu\xaa\xdd\xd01\x03\xc0\f2\x92\xae\xac\xbf\x12\xc5\u0084\xe9\xf8\xa3\xb10*\x16\xf72#\x16\x86\xd1X\x18\xa5\xf5\xf1d>!\x9d\x9a\x8a\xc4\xc2į\xab\xa9\xb10\"k,L\x17e_ó_\x8bT\xbd\xb3\x010\xe91\xc1\x00̸!\xa5:\x9b]\x04\xc0\x00a\xfc\x00\xb0\xb5\xb5\xc3\xc5+\u05f9p\xe92\xbe\xef\x8d\f,A0\x7fP\x8bO6RJ\x9a\xad5\xea\xf5:J)\xb6\xb7\xf7\xc2\vMW\xa7\x1a\xaf\x14\x92\x9d\xbd\xf3\\\xber\x8dͭ]\x94\xf2\xc3\xfd\x88e'\x14\x15ě\xfe8\x9e\x9c\xb8'M\xe5\xe12\x98D\x88\xc8\fD\xef\xb9\xdf\xeb\xd2\xeb\xf5\xf0<\x87z\xadΕ\xeb\x8f\xf1\xdc[\xdf\xc9\v\xef|\x17O\xbd\xfeY\xea\xf5\x06\x87\a\aܾ\xfd2\b\x11\xc2\xcb\fJ)2\x88\x97\xa8\x95\xc1\xe6\xf6\x0e{\x97\xae\xb0\xb3\xb3\x87\x17\xf8\bu\x9cݲ\x88-\xfey\xd7\xeb\r\xea\xcd\x16vtl\x1a͵\xb0X\xa0\xd0+\xb3\x844\xf8\x8f\xa7,\xd6kc\x19I\x13@%L\xad\x8eg$\x1dC\xc1\xc8\xcfb\x19I\xfa\x94\x8c$b\x99?\x8b\xcaH\x1a\xc9FJ\x92\x91D\x82\x8c\xa4\x13 \xa3A{8\xcd\x17\xc2\"\xd1:\x98\x0e1K\xd6N \xcd\v\x19\x80Y\xee\xcd.\x02`\xa6\xcf4\x02!$rF\x0eT\xb2\xdf\x16\xb4\xe9\x93\x1d\xfc\xd4\xcc0\xb6E\xf7C\n\xf5o\xa5\xfa\x9fO8\x17T\xa4\xed\x95\xe7\xe1\xb9.\xae\xeb\xe0\a>Z\x054\x1a-v\xcf_\xe0uoz+\xaf{\xf6y\xde\xf0\xec[\xb9~\xe3\tZk\xeb\xf4\xfb=\x8e\x0e\x0f\xf0}\x1f!\x04\x96eœ\xb2\xca\x05\x98S~\xa3\a\x87\\\n\xac\xca\x1c|\x9dx$Z\xf4\x12R\xba9E\x8c\x8d\xb7y,\x8c\x1a\xb10\xdaX\x98\t\xa9\xd5),\x8ci'pv\xdb\tL\xb00\x15\x84\x98b\xda\tDB\x83\xc3\xc3\x03\xee\xdcz9J\v\xf5ҟ\x8f\x94\xd1\x1b}\x14bl\xdb\xe6\xc1\xbd;\xec\x1f<@\xe9Y\x17\xdd|\x01F\xa9\x80\x83\a\x0f\xb8s\xfb\x15z\xbd\xeeh\x9d\x90jaKă\x83\x01Vb\xd7\xea\xd4\xeauZ\xad5.^\xbdƅKW\xb8|\xf5\x06\x8f=\xf1\x14\xd7\x1f{-\x17\xaf\\gkc\x1b\xa5\xc1\xe9w\xe8v\xdb\x1c\x1e\xeeG1\x06\xd6\x10^\xca\x03\x98\xe9\xcf\x19>Vi\x8e\x0e\xf7\xb9\x7f\xfb\x16\xbe\xebF`U\x1cod\x85\xf6\xf1\xc7J)y\xf8\xe0.N\xbf\x8b\xd0:\xe5@^d;\x01rY\x181e\xa0\x1dX\x98\v\t-L`,\xcc1\xbc$\xb40\"\x89\x851\xed\x04\x96\x18`r\x9a\xad%\x00\x18F\x96\x93\xd2\x16m\x1b\xcb\xc8\x10Ң\xdb\xee\xf3\xe2\x17})\x9b\xdb[lll\xa5\xd2\xefɬ\x84.d\xfe\x90B\xd2\xedt\xb8v\xf31\xba\x9dN\xb8\xec1G\x809ms]\x87\xaf\xfa\x86o\xe2\xc5/\xf92\x9a\xcd\xd6\xc9c\xa8˰X\xe9\xaa\xf1\x86\xb6\xc4FZ\x92z\xb3E\xab\xb9\xc6\xda\xfa\x06\x1b\x9b[lnﰽ}.ʜZ\x03!\xf0\xfd\x80\xbe\xd3\xc7\xe9\xf5\xb8s\xe7U@E\x81\x93\xd6H\xa5\xdb\xd9\xe7\x80ι\xaf\xa7ׂ\x91\x12\xfa\xfd\x1e_\xf2\x15_\xcb\xeb\xde\xf4<\x8d\xd6\xdaq\xe0\xae.z\x84H;\xf0\x8c\rlB\xd2\xed\xb6\xb9t\xf5\x06\x8e\xd3\x1f\x19<\x97\xad\x9d\xc0\xf8K\xc5-\x8cHha\x98aaT\x19\x16F\x88\x13\xf5e\x16iaHia\x82Y\x16ƴ\x13X\xaav\x02ivbU\x00\x860;\xe9^0\xe8\xa1TD5ލ\x8dMZkͱ\xbb\xdb\xe9\xc7A\x8fO/\xea\xf4\xa3|\xe2/O\xe0\xa4\xd1?\xa7G?\x18\x15\r\x93Q:o\xfbp\xbf\x10\x80\xa1\xa0j\xbcRJ66\xb7\xa8՛'[X\x9e\n0\xa7\x97%\x9e\xb9\x88\x92\xa1\x9d\xc00\x1e*ZJ\xf2\x03?\xaca\xe2{aʫ\xef\x13\xa0\xa3\x01T\x86\xd9ER\xa7<&EY\x98dE\r\xa5\x94\xac\xadmPk4\xa2AI\x83\x82\xa48.\x89\xcd\az\xfa\x89\xacǧ\x8d)s\x88\x8aZ\xf0\x89\tOVJ\xd1\xed\xb4q\xdd\x1eZ'\x18nD\x86\x01r\x0e\xc5\xec\xc6\x1f?\xc8Hzn\xef\xb7\xf1|\x85\xa74*J\xa1>\x91\x91\xe4G\xd9F\x83\x8c\xa3a:u\x94^}F2\x92Fӽ\xc72\x92Nd'Il}Hw\xe3\x1bqk\xaf\x99\x9c\x91\x94\xa1\x9d\x80\xa9ƛ\x17`\xa8l \uf107x^\xa5\xb2\x93\n\x02\x18\x80\xc3\xc3}\x0e\xf6\xd5\xf1\xa0\xaf\xc5\xd4YI\x8c3\x88>9ԋh\xa1*\xac\xb3+\xa6\xceKb\"\xd3\f\xeei\xa2\x8fC\x8f>FF\x01\xa8U\x01\x18\xa2\x00\xdaG\x0f\x1f\x84w\x8e\xa7\x1c\x9b\xd1\xfd\x9d\f0b:獼\xe8\xf8q\x99uy\x8d\xbf\xb7\xe1\x1d^\xcc\xc9K)\x11\x96\x15\x9e`\xba\b_4\x9fv\x02J)\x0e\x0f\xf7\xa3\xf3\\\r\x01F$xw\xb9\x01FO{\x9c\x18\xfd\xb5\x8cߕ\x8b\xe19\x9c\xaf\t\\5\x00&\xb40\xf6\xa8\x85\t\x8e\x81\xe0\x84\x85!\x16\xf0n,L\n\vs#\xb20\xddB\xaa\xae\x1a\x80)\xc4),\x05\xc0\xc4\xc7\"Q\x81PA\xbbH\x80!*Z\xa6e\x14\x069c\xf2ҳ\xfb\x1e0\xb8\xf4e\x82\xf5\x93\xd1\xc7M\x9f\xb8\x8a>\xee\xc5\xf5C\x8a\x8e\xa1e%ij\x15\xed\xcbl\x03#\x13\x1a\x18\x99\xf0\x1c(\xe5\xf8\x9d\xba\xaf\xf3\xeb\x87D\xac\xd9$Z\xa2e\xb2\xe3rr\x02\x17Ɇ\xaa\xb4\xfd\x90\xb2\xda\xdd\n\xb6\x13\x98\xf4\xf80\x16\xa6\xc7\xc5\xe6\xfdX,\x8c\x9a\x1c\v\xa3Fca\x98\x12\v\xa3ʈ\x85\x89\x97\xf8g\x19caގ\x16zr,\x8c\xa9\xc6[\n\xc0,c*\xf5L\xed\xbc\xf8\xd6I\xd3\xe4~6\x80\x99\xcc-\xf9\x96\x0f\x92L\\\xccj\xe8\x98vJ\x9dk&Ҍw^\xb1%\xa4\xd4`\x92\xfb<\x98/\xc0d9\a\xca\x00\x98\xb4\ney\xfa!\x9d\xf6\xfb\xd0\xc2\\]\xbf\a\"\b{$ŖW&Z\x98a\xd0n2\vÙ\xb70\xbd\x98\x85\xe9\x19\x80\xc9\x010\x99.\xdb\x15\x01\x18!\xaa\x13)\x93覺\xea\x00\x93\xf4\x8dU\xa5\x16\xcc2\x02L&\xb3b\x00&Ǡ\x97|$Z\x96Z0\xb3\x06ځ\x85\xb9м\x97\xdc\xc20\x1e\xd8;?\v#\x96\xdc\xc20ީ\xfa,\xb7\x13\xc8$7W\xb7\x98ݬ\xcf]R\r\v\x93\bb\x16\x030\x19'\xcd9N^\xcb\t09\x8e\xf1\xdcσe\x04\x98\x14\x03t\xdae\xa4\x15\xea\x874y\xf7\x96\xcf\u0090\xc0\xc2\xe8e\xb10+\xdcN \aW\x1b\x80\x99\xf2\xb9/\xa6\xecg\x06\x88)u\xe2\x9a\xf1\x8a\x85,#\x9dA\x80I\xa3\xa4\xaa\xbb\x94X}\x80I;\x82\x9eU\x80\x11\t\x00\xa6(\v\x13\x14ia\x84\x18\xab~\x9b\xcd°\f\x16ƴ\x130\x00\xb3ĵ{e\xf1\x13W&}b\x00\xa6 \x8d\xb2\xa88\x98\xe5\x06\x98\x8c\xb82\x8f@\xde\x15_B\x1aX\x18\xa5m\xae\xae\xdf\x01\x9d\xdd\xc2P\xa4\x85!\xa3\x85!\x87\x85\xd1\v\xb00\x95\x06\x98|\xf7\x10\x06`\n\x1e\xf3*\xca92\xcfĕf\x1a\x9dk \xef\xb2\x02\xcc\xcc\u05ecf o\x95\x01&\xf1\xd1]p \xefY\\B\x1al\xfe\xd0\xc2<\xc0\r*`a\x10\xb1\xe3\x92\xd2\xc2\xe8\x1c\x16\x86\x92-L\xe3\x85Q\vS\xd5v\x02\xc91\xc4\x00̜\xda\t\x88\n\x8b\x9a\\ٲ\x95\xccD\xaa,\xc0d\xd7(\x06`\xb2\xf3\xc92d\"U\x0f`\n2U\t\x06ځ\x85\xb96G\v#\xcb\xcaH\xcaeat\xb9\x16\xa6~\x03\xaf\xfe\x9ac\vS\"\xc0\xe4\x90'\xa6\x9d@\xee#Z^\x1c\xcc\xd2@\xccR\xa7R/\x18`\xd2L\xa7\xab\x90J=\x17\x80ɂ*K\x94J\xbd0\x80\xc90\f\x16U\vf\xdc¬\xd5z\xec\xc5-\fI,\x8c\xcala\xc8ia(\xc5\xc2P\xae\x85\xa9\xbd\x10\x8d\xb2A\xe9\xfd\x90L5ޜV)\x9dF9\xd3\x00s\x02b\f\xc0\xe4\xdb\xceR-\x98\xb9e\xa3\xadp-\x982\x00&\xcd8\xb8\x88bv\x89,\x8cJba\xf4\xf2X\x98X\xd0n\x8c]\xe6ha\xae\x1f[\x18!S\x01Lړ\xca\x00LF\x97R\xe1L\xa4\xa2\xac[\xe9\x10c\x00&\x9f\x851\x00\x93\x0f\xfd\xceZ1\xbb\xc2\x00&\xcbc+\x010\x05X\x185\xdb\xc2\xc8R-\x8cNfab\xa3\x9d\x1e!\x9di\x16F\x14ha\x02\x9cڋ\xc7\x16&-\x96\x98j\xbc\x85o˔J]u\x80\x19B\xcc\xd9\x00\x98\x8cӫ\x01\x98\x12̔\x01\x98\xe2F\xc4\xe5h'0\xc9\xc2\x04\xda\xe6\xda\xfa\xed\x13\x16&\x88Y\x18\x1d\x81\x8c\x9ada\x98ma\xc8ca\xe4,\vCI\x16\x86b-L-\x9b\x851\x00\x93\x12JV\xac\x16\xcc2\x00\f\x804\xed\x04\f\xc0\x98v\x02\xf3\x03\x98\xfc:y\xf9\x01\xc6\x02<Uc\xa3\xd6c\xaf\xf9\xf0\x84\x85!fatda\xd4\x04\vs\\\x9dW\x95ca(\xcf\xc2\xe8\xb9X\x18\x1f\xa7\x16Յ\x11\x81\x01\x982\xad\xca\x19\x04\x18Q\x11\xacI\xd6v`A\x00\x93\xf4\x8d\x99j\xbc\x98v\x02\v\x04\x98t\x83\xde\xea\x15\xb3Kg`\xc2\x11 В\xab9-\xccqu^=\xd3\u0088\x8aY\x18\xe6aa\xec(#I\x87\x19I\xa6\x9d@\xb1\x16\xc6\x00LEL̼\x01&Ӥ9\xc7\xc9k\xa9\x01&\v\x98\x98j\xbc\x99'\xf0\xb4\x16\xc6\x00L\xb8\xa5\xb50Ǡ2)\x16&\x99\x85\x11g\xd1´\x8e\xeb\u0098v\x02\x06`\x8a\xf9\xdcc\xe0 \xf3\x16i\x99\x03\xc4\x14\xdfN\x00S\x8d7#\xc0\x14k\xa6\f\xc0d\x94\xc6\x06`r\x02\x8c\xc8`aN\x04\xed\x8e\xc4\xc2,\xa9\x85\x89=\xa6p\v\xa3{\x04\xb5\x1b\xc3X\x181\x1c\xe6M;\x01\x030\xf9\xc7@\x01\xa8\xc1\xb7\x15h\xa2$\xf3N\\\xc9'^\xd3N \x0f\x00\x9aj\xbc\xd9a-\xf5\xa5j\xaa\xf1\x16\x7fL\xe3\x16\xa6\x1eZ\x98\xfeD\v\xa3\n\xb10\"\x81\x85\x11\x8b\xb40zt\xf2Lla\xc4)\x16FD\x16F\xf8\xf4[/\xa0Q\x88aF\x92i'p&\x00&\xcb\xe1]\xd2e\xa4D&&\xf5\xe4j\x00&\xe3\xe4j\xaa\xf1\x96\x030\xa6\x1ao\x19\x00\x936\x95\x9a)\x16FO\xb40z\xb6\x85\x19\xfc\f\xa6Z\x18\x12X\x18\x96\xd1\xc2p\x8a\x85!\xb20\xf6\r|\xfb\t\xaca\x8f$\xd3N`\x15\x00&͛,5\x0e\xa6b$#˝\xbc0\xed\x040\xd5x)\xa1\x9d\x80\x01\x98\xe2\x00&\xfd\x18\x99\x1e`\x86\x16\xa6\xd1\r-\x8c\x1f\xb30*\f\xdcMla\x86\xbfW\x99,\x8c<\x03\x16\x86a]\x98\x12\x00&\xbd<1\xed\x04\n\xb8\x1a+\x11\xc8[A\x15#\r\xc0`\xda\t,\x02`\xb2Пi'P\xcaVV1;\xc6-\f\x92\xabkw@\x05h\x1d\xb30\x83\xb8\x16\xc6A%\xfa\xa75*\x18\x85\x99\xe9\xb10b\xa6\x85a\xa2\x85\x11\xc9-\x8c\xae\xaa\x85\xe9\x12\xd87\xf1\xed\xd7 U7Jf/\x02`\xd2ͦ\x06`\xb2\x92J\xc53\x91\x96\xa1\x8b\xf5\x99\x03\x98,\x8f5\xd5x\xf3\x9b)L5\xde\xd2\x00\xa6\"\xd5x\xc77O\xd7ب'\xb30\x8cY\x98a\x05_\x9d$\x16\x86\x8c\x16\x86\xe4\x16\x86\xaaZ\x98\x80~\xeb\xed%D[\x9av\x02\x99\xdb\t$\xbeF\f\xc0䆘3\xd9\x0f\xc9\x14\xb3\xcb\x040i(\xc5T\xe3-N'/+\xc0\b\x11\xc5\xc2$\xb20j\x89,\x8c\xae\x90\x85\xe9\x10\xd87\t\xec\xc7#\vc\x8a\xd9\x15\x060Y\xc1dUj\xc1,C\x17k\xd3N\xc0\x00L1f\xca\x00La\xa4\xb2\x84\xa9\xd4\xd3nVÌ\xa4\xa4\x16F/\x91\x85\xa1B\x16F\xd1o\xbd\x98\xe8\xfc7\x00\x93[\x9b\x9c\x9dbvY\xae\xf9yC\x8ci'`\x00f\x86\x8e1\x00S\xc2\x15\xbbj\xb5`\xc4\xd4\xf7U\x90\x85Qձ0\xbaD\v#rY\x98'\x90\xaa\x7f\xfa\xf9l\xda\t\xa4\xbb>\r\xc0T~\x93\x8b\x00\x98\xa4\x13\xa9\xa9Ƌi'P\x15\x80I>\xe4g\x18\xccV\x13`\x18\xaf\v\x93\xd0\xc2\x04\x93,\f\t,\x8c\xc8`aDz\vC\x89\x16\x86\xcc\x16&\xac\vsZ\xa7\xeaU\x03\x98\xf4r\xd3T\xe3-ʧ\x88\n1\x8e,\x15`\x92@\x82\xa9ƛ\x18`R\x81\x89\xa9ƛ\v`\xd2\\\xe7\x06`\xa6\r\xb2\x9a@[\\[\xbb\x9d\xca\u0090\xd5\u0090\xc1\u00889X\x18]\xb6\x85y\x8c\xc0\x8e:UO)\xfd\xb5\x9c\x00S\xdc\xd4k\x00\xa6\b\x035n?\xaadb攁b\x00&\r\xc0d\x97Z\x06`\n\xd4\xc9\x06`2o\x9e\xaa\xb1Y\xef\xb2;\xcd\xc2\xe83ba(\xdb¼x\xaa\x85Y\xa6v\x023\xcfe\xd3N\xa0\xd4v\x02I?\xfb\nt\x1b\x88CL\xdei\xc0\xb4\x13H9\xad\xa7C\x16S\x8d7\a\xcee\xb30\x06`r\xdc\t\xc7\x06\xda@[\\]\xbb\x8d\x98fa\xf4$\v\xa3N\xcfHRKfa\x98\x87\x85y\x1c\xa9:\x13\xef\x8f\x17\t0iO \xd3N\xa0\xe0\x1b\xb1\xb2\x03y#\x90\xae\x88\x89\xc9ja\f\xc0\xe4\xb1S\xa6\x9d@\x96s`\xe4T0\x00S\x10\xc0\xa4\x1dUg\x8d\xf5\xd9-\x8c>=#I\x9bX\x98\xc9\x16F\xcf\x05`\n;\x85\f\xc0\xa4\xbe\xee\xa9d&\xd2\xe2\x17\x96\xa4\x01\x98\xd4;\x9e\x10\x12L;\x81\xfcG2\xffy`\xda\t\xa4\x99p\xf2\xc7\xc1\x1c[\x18y\xc2¨\x84\x16&X\x12\vC\xa9\x16F\xe4\xb20\xa6\x9d\xc0\xf2\xb4\x13XJ\x80)\xab[l\x11\x10c\xda\t$\x80\x1dL;\x81\xa4\x00\x93\xe8h\x9aj\xbc\xb9\xcdH\x1e\vS$\xc0\x1c[\x98\xce\t\v3\fНaaX\x12\v\xa3\x13Y\x18=\xf2\xbe\x92[\x18\xa6X\x181\xd3\u0098v\x02\xa6\x9d\xc0Y\x01\x18\xa6\xb6\x1dX\t\x80I9\x95\x9aj\xbcEʨS^\xc2\x00L\xd6~HU\xceD\x8a\x1f!\x15Յ\x99da\xf4\x99\xb30\xf1\xb7\"\n\xb002Q,Lj\vc\x00\xa6\x10\x97\xb2\xf2\xc5\xec*\x060Ll;\xc0\n\xb4\x13H\xf0\xea\x06`\xf2a\x89\xa9\xc6k\xda\tL:x\xbe\xaa\xb1qf,\x8c^b\vc\x00&3\x98\x9c\xd5j\xbc\x15\x04\x18N\xb4\x1d\xc0\xb4\x13X}\x80\xc9r\\\f\xc0\x14F*+V\vf\xf4\xd5tha\xd6\xe7ha\x84\x18\x81\x97\xf9Z\x18\x16da\x1e\x9fha\f\xc0\xe4\xd6&\xa6\x1a\xef\x92\x01\f#m\a\xe6\b0\x89\x91\xc4T\xe3\xcd\x050i\f\x95\x01\x98¤\xc9\x19\x05\x98c\v\xb3ט\xa3\x85\x89Ob\x82Q@(\xd9\xc2P!\vc\xda\t\xa4\xbc>\r\xc0\xac\xcc&\x8b\x04\x98|Ө\x01\x98R\xcc\x14\xa6\x1ao\x1e\x89b\xaa\xf1&\xa3\xbfA\x8f\xa4+\xebw\xd0EY\x18\x95\xcd\u00889Y\x18\xbd \v\xe3ۏ\x8dX\x18\xd3N\xc0T\xe3M\f0Yn\xc4\x04\x94\x97\x17Y\x14Ęv\x02\v\x05\x98T`b\xaa\xf1\xe6\x02\x984W\xa1\x01\x98\xe4\xfb>\xc8H\x9afa\xb4V\x11\xa8\xa4\xb00\xa4\xb50\xe2LX\x18b\x16ƴ\x130\x00S\x9c*N\x0e0\x15흔O\xa5\x18\x801\xed\x04\x16\x010\xb9\xaea\x030\xb9\x8f\xa4\x18\x8b\x859aaF\xb2x\xa2%\xa5\x19\x16&\xc8la\x98ha䈍Y\"\v#N\xb70\xa6\x9d\x80i'\x90\xca¬P\x1c\xccI\x13\x93\xc6\xc2\x18\x801\xed\x04*\x040\xa6\x1aoJ\x80\xc9z\xb3&\xa6\xbf\xa2\x17\xc5\u009c\x9bdatz\v\xc3\xd0¨B,L|\x92X*\vø\x85y\xc7\xd0\u0098v\x02\xab\x050\xa9\x0e\xef\x82\x03y\xab\x865\xd2\x00\x8ci'P\r\x80\xc9x\xafa\x00\xa6\\\x9d<\x03`B\vc\x9dbaT\x0e\v\xa3\x8d\x85\x19\xb107\x91\xaa\x83\x102\U0004776f\xa4|\x96\xf3\xde\x00L\xf6cZ\xfdL\xa4*z\x19y\xd6\x01&=\xa7\x98j\xbc\x89\x01&\xed\xd15\xed\x04\n\x1f2\xca\xcaDb\xc4´O\xb10:\x9f\x85\x11\xc5[\x98\xc1$\xb8\x8c\x16F\x88\xe4WH\xael\x14S\x8d79\xc0\xe4\xbb8\r\xc0\x14\v1+\b0\xc9\x05ʩO6\x00\x93\xddN\x99j\xbcY\x00&\xcd\xfe/\x06`\x86\xd5y\xd7o\x9fja\x86KJC˒\xd0\xc2(\x1dS+\xc5Y\x98\xe3kkI,L\xfd5\xf8\xf6M,\xdd)\xa5\xe1\x9ei'\x90s\xa6>\x03\xb5`\xaa\x1c\x19#W\r`\xd2\xcc\xdc\x06`\xb2S\x8a\xa9\xc6[\x00\xc0\xa4Ǌ\xca\x00Lha\xecX,\x8c=\xd5\xc2h\xad\x8f\xbbW\xa7\xb10\xb2 \v3\x027\x05X\x18=\x1f\v#\x06\x16\xa6\xf1\x02bJ\xa7\xea\xbc\x16ƴ\x13\xc8z5\x9e\x9dbv\xe2\xb4\x17Y|\x13\xeb\xc1[X\xa6v\x02Y\x99\xc5\x00L681\xd5xK\xbb\x1bY\xb2bvL\xb50\xd1D>X.\xaa\x9a\x85\xe1؆\xc4-\fY-\fc`S\x92\x85\x91\xbaCP\x7f\x82\xc0~\f\x99\xd8\xc2\x18\x80I\xfa\xe2\xa6\x1aoN\x80\x11\x94\x97F\x9a\x0ebL;\x81Uo'\x90\x94R\f\xc0\x98v\x02I\x00椅9\x8e\x85ё\x85Q\xd3,L\x04:\xa0\x16natZ\v\xa3\xc7-\f\xa5Z\x18\x84\xa6\xdfx\x11D\x90\xf0B7\xed\x04\x92\xbeY\x030)\a\x96\xfc\xf2\xbe<\x881\xed\x04\x96\x0f`ҜQ\xa6\x9d@\n\x80I;\xe1\x9fQ\x80\x19X\x98kc\x16&\x1e\v\xc34\v\x83&\x18\x98\x96e\xb30\xcc\xd7¨\xda\xe3\xa8\xdac\t;U\x9bv\x02\tOl\xd3N \xef\x98'(\xf7\xc3Lob\xd2\x02L\x1e03\x00S\x98\x99\xc2T\xe3\xcd\n0i\xafZ\x030\x93-\xcc\ue30c\xa4\x81\x85\xd1\xc6\xc2d\xb20n\xebE4I,\xccj\x02\x8c\xa9\xc6[,\xc0\x14\xb6\x8cT\xb1-CX\x8e\xa9\xc6[\x06\xc0\x98bv\xf9\x00&\x1d\xab\x98v\x02Y\a\xa6$\x16f<\x16FO\xb10j)-\x8c\x9e\x9b\x85\x19\uf474\x94\x00\x93\xf5\x1a5\x00S\xaeU\xc9\t0\xd5j;\x90\xca\xc2\x18\x80)Ŭ\x18\x80)\xf6\"5\xed\x04r\xdcDͰ0\xdaf\xb3\xd1ag\xa6\x85Q3-\f\v\xb60d\xb20$\xb20\"\xb7\x85yG\x02\vS\xb5v\x02\xb3&q\x030E\x8ets\x0f䭨\x9a\x91\x06`fN\xeb\xe9\f\x95\xa9\xc6[\b\xc0\x98j\xbc\xc5\x02L\x9a\x83'fX\x98\xabk\xb7#h\x99na\x94\xd6\xf3\xb30\x11\x00\xc8\x18\xb8$\xb10\xbaD\vCn\vss\x86\x851\xed\x04\x96\a`\x92\xee\xfbr\x00L\x91\x85$\x8a\x83\x18\xd3N \xd1\x1f3\xd5x\xb3\x9c\x03\x19\xe7U\x030\xe5\xea\xe4\x94\x003\xb40\xf5d\x16\x86\xa1\x85Q\xe5[\x181\xfa\xd9L\xb60z\x85,\x8ci'Pi\x80\xc9\xf0\f\x030y ƴ\x130\xd5xM;\x81J\x00L\xfaaq>\x81\xbc\x83]\x1fԅaf,L\xdc\xc2\xe8\xdc\x16fİd\xb60$\xb20z\xe1\x16\xe653,\x8ci'\xb0H\x80I\xf3&W\xa2\x16L\xc5\x01\x86IW\x89i'p\xb6\x01&\xcd\x1b3\xd5x\xb3\x00L\x9a\xfd\xaf\x06\xc0 \u008c\xa4\xcdz\x9b\x9dz\x9aX\x98b,\xccа\xcc\xc1°p\v\xf3B\u008c\xa4\f\xf7\xff\x06`\xca1\x9a\x06`\xaa\x031\xa6\x9d\xc0\xea\x02Lf\xf83\xd5x\xcf\\;\x81I\x8f\x0fca\xee\xc4,\x8c\x1a\x1a\x95\xd3,\x8cJea\xe2\x99=%X\x18}v,\x8c\x01\x98\xacW\xa3\xa9ƻL\x003\x021\xa6\x9d\xc0\x92\x03Lj81\xd5xK\xbb4W\xa0\x16\xcc\b\xc0(I\xc3\xee\xb3]\x7f\x80\x1b\f,\xccqW\xea\xd3,\f\xa9,̨و\xd3ȸ\x85\x11Y,\f\xc9,\x8c\xae\xa4\x851\x00SL\xf0\xba\x01\x18*\xde\xd01\x13\xc4\x14\x060Y\xc0\xc4T\xe3\xcd\x040i(\xc5\x00\x8ci'\x90\x15`\x00\x02${\xad>hI\xe0\xbbh\xe5\xa3\x14\xe1t[\xa6\x85a\xba\x85\xa1D\vC\xe5,\x8ci'\x90\xf4͚v\x02)\a\x96%\xb70\x9cl;\x903\x03\xc5\x14\xb3\xcb\x050Ř)\x030\x05ܤ\x19\x80\x19\xb9\xd3\t\xd8nA\xdf~\r\xaa~\x15\xa8\xa1\x83>\xdaw\xd1\x1a\x02%\x8ea\xc6X\x98\x82-\x8ci'\x90\xf0\xc46\x00\x93w\xccK\x030\x15\xaavg\x176\xa5\x1a\x80)\x0eLL5\xdeL\x00\x93_'\x1b\x80\x19\xdf\x14P\x93\x1a[\x06\xe17\xd6\x0eb}\a\xcb\xdd'\xe8\xdd%\xe8=$P\x1a\xb0PZ\xa4\xb60Rf\xb70b%,L\x1bU\x7fmda\x0e\xc7,\x8ci'`\x00&\xebx\xb7\xdaq0S \xc6T\xe35\xed\x04L;\x81U\a\x984\xc0'\x00\xad$\xf5\xba\x8f%5~ \x00\x1f4\xc8\xc69Z\x8d]\xea\xad\x03\xbaG\xaf\xd0k\xdf\xc7\v|\xa4\xb0\x87\xa0@d`fZ\x18\x91\xcd\u0088\x85[\x18\x91\xd3\u0084\xe6%\xec\x91\xe4!\xd0#\xdd\xf5L;\x01\xd3N \xf5\xfd\xd9<\x00F\x94qf\xe4\x86\x18\x030\xa6\x9d\xc0\x1c\x01&\xebEj\xaa\xf1\xe6>\xa6閑B\xf9Ҭ\xf9\b4\nq\xec\t\x94\x83F`7\xb6\xd8n\xee\xd2\xda:\xe0\xe8\xd1\xe79:\xb8\x8d\xef\xbbH\xec\x10\x19\x14h\xa5N\xb70,\xab\x85\xa1 \vs}\xcc\xc2,\x16`ҜH\xa6\x1a\xefj\xb5\x13X6\x80\x89 ƴ\x13H\x84\x18\xa6\x1ao\xees -\x99\x18\x80\x99O;\x81\xd3\x063\xa1\xa1i)\x84\x9e\xfc\x04\xa5<\xc0\xa3V\xdf`\xef\xea\xb3l\xed>\xce\xc1\x83\xcf\xf2\xf0\xc1\xe7p\x9d\x1eB\xd6Ú\x9a:\bce\n\x8a\x85\x99\xa7\x85\x19\\˅Y\x18N\xb30\x8b\xef\x87d\xaa\xf1\x16\v0+\x93J]A\x80\xe1DL\x8ci'`\x00ƴ\x138s\xed\x04\xa6\rf*:\x13\x9a\xb5\x80`j\x17\x9f\x18\xcc(\x0f\xbb\xde\xe2\xe2\xf5gٹ\xf0\x1a\x1e\xdc\xfb4\xf7n\x7f\n\xa7w\x80\x105\x906Z\x05(\xad\x91byba\xe2\xa7G!\x16F\x84\x16\xc6?aaDi\xc1\x9ci?{\x030\x05\x1d^\x030s\x84\x98e\x05\x98\x99\xafi\xaa\xf1&\x06\x98\xb4\x14h\xaa\xf1\x16>\f.:\x13)\xfe\xad\x02j\x96\xa6n)\xb4\x92\x89\u07b9R\x1e*\xf0\xb0\xec:Wn\xbe\x89\v\x97\x9e\xe4ޝOq\xeb\xe5Ort\xf4\b)ml\xbb\x81\x94\x9c\xb0\x15g\xcd\xc2\xf8\xad\x17b\x16F\x9av\x02\x98v\x02\x06`\xb2B\xcc\x12\xb5\x13H\xfad\x030ُ\xa5\xa9ƛ\x05`\xd2\xec\x7f\xf5\x01\x86(\xa8\u05ee\x05Ԥ\xc6S\"\xf9ފ\x10f\x82\xbe\x87eոz\xf3Y.^}\x9a\xbb\xb7>\xc5˟\xfd8\x9d\xa3G\xd4l\x9bz\xb3\x15\xb3/\x13,\x8cXe\v\xf3d\x94\x91tpJ\xa7\xea4\x13\x99\x01\x98\u008d\xa6\x01\x98\xca\x03L\b1\xa6\x9d\xc0\xea\x01L\"\xa61\xd5xM;\x81\xe9\xaf)\xa2s\xa1USa`L\x06t\x13\x02\x94\xf2q\xfa.\x96\xb4\xb9v\xf3\xf5\\\xbe\xfa\x14\xf7\xef~\x86W?\xff[\x1c\x1dޣf\xdb\xc8zk8\xe1\x8fX\x18V\xd5\u0080\xdbz;\x1a7\xb5\x851\x00\x93\xf5j4\xd5x\x979\x8dz\xa6\x891\xed\x04\x96\r`\xd2\x11\x8b\x01\x98L#M\n\v\xb3Z\x00s|^\v\x9a\x96\x8f\xd0\xe1Ғ\xccx\x8d\v!QZ\xd1ﵑ\xd2\xe2\xe2\xd5'\xb9x\xf9\xb5<\xb8\xf79n\xbf\xfc[\xb4\x0f\xef\"\xa5\xa0\xdeh\"\xa5u\\G+\x83\x85\xd1K`a\x82\xfakQú0\x96\x01\x18\xd3N`\xee\x00\x93\xf6\xf8V\x19s\xec\xa4\x13W\xea9\xd4T\xe3\xcd\x040i(\xc5T\xe3-\xe6\xea:\xcb\xd5x\xa7\x02L\xe4bZ5?\x17\xc0\x8cÌ\xd6\x1a\xa7\xd7\x014\xbb{W\xd9ٻ\xca\xe1\xfem\xee\xdd\xfa$G\aw\x90\xb8\xd4\x1bM\x84\xb4bВ\xdc°\x04\x16\xc6i\xbd\x1d\x8d\x87\x8cׅ1\xed\x04\xd2Y\x18\x030\x05\x1d\xdd\xe5]F\x1a11\x19n\xee\r\xc0\x98v\x02\x8b\a\x98\xac`b\xda\t$\xfaTl\xa9\xa9\xdb\x1aU\b\u009c\xb8xp\x9c.h\xc5\xc6\xfa96\x9f~'\xed\xf6C\x1e\xdd}\x89\xf6\xe1\x1dP\x1e5\xbbq\x02f\x96\xd1\u0088\xb1\x8c$5^\x9d\xf7\f\x02\x8c\xa9\xc6[\xd1v\x02K\x060\t \xc6T\xe3-\x02`L5\xde|\x00\x93\xf6\xaa5\x00\x93gD\x1cd\x19IjV@M*|U\xe0P\xa65h\x85\xd6\n\x11\x01\x85\xe7u\xd1\x1a\x1a\x8d5\xae\xde|3\xfd\xfe!\x87\x8f>O\xe7\xf06~\xd0Ƕ\xeaHi\x0f\x97\x99fY\x18]1\v\xc3H,̋\x88x]\x18\xd3\x0f\xc9\x00\f\x99\a\xd7\x05\x03L5\x10\xc76\x00c\xfa!-\x02`\xd2]\xbb\xa6\x1ao\xb1\xf47{\xbf\x14Ш\xf9a\x83¢\x06+\xad\xc3F\x91\xc3\xf3j\xf0?\x01(\x02\xcf\xc5\xd7\n\xdbj\xb0w\xf1\x19\xb6\xb6\xaf\xd39\xbcE\xb7s'l8iգ\x98\x99\xe3\x0ff\x92\x85!\xab\x85\xd1\xf3\xb00\xb1\xba0\xa6\x9d\xc0\x19\x00\x18\x8a\xb9>+\t0\x9a2$mA\x10c\xda\t\x14aa\xce6\xc0\xa4\xb8HM5\xde\xdc\xf79\xf9\x97\x91\xc4ȡ\xd2\"\xccL\xca\x1b\xd4\x1bw\x1fD\x00\xa3\xf5\xf8\xd7z\xe4\x9a\n\x02\x17\xed+\x90\x92\xadݛ\xacm^\xa2߹\x8bӿ\x8fV.\x12\x89\x14\xf5\x110)\xc4\xc2P\xae\x85\xf1[\xef@\xe2\x85\xefm\xc6\xecg\xda\t\xac\n\xc0\xacb*u\xf8\b\t\xc82&\xd8\xfc\x10\xb3:\x00\x93\b1L5\xde\xdc\x00\x93v\x045\x00\xb3\xf8v\x02\xa7\xfdTG\xed\x06ZvqA\xbd\xa8\b.b\x00\x136V\n\xed\xcc\xd0\xd2ĈB+\x1fO\xbb\x80\xa0\xb9~\x89Fk\x0f\xb7\xff\b\xcf}\b\xaa\x1f\x82\x89\xb0&X\x18=ba\xf4\xc2-\xcc\x11~\xfd)|\xfbZha\x84,\x1f`\xd2c\x82\x01\x18\xd3\x0f)1\xc0\fnt\xd4\xe2\x19f\x1cbV\xa0\x9d@\xa2\xa7\x1b\x80)\\}\x9av\x02\xc5\x1f\xd3\x05\x00\xcc`\xff-\xa1iX\xaa\x90\xa0^\xad\xd5((\xe8\x19_\xc7\x10#\x04\xa0\x80@\x87\x06\xa3\xd6\xd8\xc1\xaem\xe2\xfbG\xf8\xfd\x87\x04A\x17\xa5b\xf11\xb1\x13}$Yi\xa1\x16F\xe0\xb5^\x04\xbc\x995wL;\x81\x05\x00L\x96\xc3{&\x8bى\x14\x06j!\x10c\xda\t\xac:\xc0$>\xba\xa6\x1aor\x80I5\xa7T-\x13i2\xc0(\x05\xb6\xad\xb0-\x85\xca\x19ԫ\xa3 ^\x86&dܺ\xa8ȚD?'\xf6s\x15\xfd|\x18ӢЁ\x1f\x96\x87\xb3֩\xb7ZȠ\x8d\xdf\xdf\xc7\xf5;h\xa5\xa34n\n\xb30\xa2\x00\v\xa3\xeaO\x85\xb10:}u^S\x8d7\x1f\xc0\xa4y\x93\xa6\x1ao\xeaQ\xa9J\x103o\x80ɮQ\f\xc0d\xe7\x13S\x8d7\v\xc0俶\x97\t`$\xe0#\xa9K\x0fKjT\x90}\xf0\x1aY\x1a\x9ad]\x88U\xd7\xd53\xbeֱsS+\xb4\xf2\xa3n\xd8M\x1a\xad\xcbH\xbf\x87\xe7\xee\xe3\xb9\x1d\x94\x0e\xed\x87\xd6\"v\xadg\xb30\x14`a\x9c\u058b\xb1\xea\xbc),\x8c\x01\x98\xdcS\xa9\xa9\xc6[l\x1a\xb5\xa8 \xcbȹ\xb5\x13 \x85\x851\x00\x93\xe3\xe8\x9aj\xbc\xa6\x9d@6\x80\x19l\nhՎ+\xf5f$\x98a*\xf50\xf6%\xb2-09&\x86\xc8\xc2\xe8\xf1\x9fǬ\xcdh\x10\xb0F\a>A\xe0!E\x8df\xe3<kk\x97\xa9\xd56\xd1:\xecߤu\x10=w\x11\x16\xa6\x8b\xaa\r,L\xefT\vc\x00&\xe7\xf5i\xaa\xf1&\a\x98\x8c\xc7XTT\xc6ئ\x9d@\x95\x00&\x13\x9f\x18\x80\xc9{\xabp\xa6\x8a\xd9\xcd\x06\x18\xa1\xa1i\xa9\\\x00s\x9cJ\x1d\xcb\x18\x1a,\x17\xa9\xf8\xf2\x91\x9e\x10\xd4;a\xd9)\x02\x9f\xc1\xf2\x92\x1e.7\r\x8cN\x80\xd2\n\xb0h\xd6w\xa9Y\xeb8\xde\x11\xae\xdbF)\x1f\xc4\xc0\xcc,\xc0\xc2\b\x17\xa1u\xf2\xc9ɴ\x13H\xf8c\x030\xe5\xb7\x13\xa86\xc0\x90\xac\xed@z\vc\x00&\x1f\xfa\x99j\xbc\xc5\xe8dS\x8d7\x1d\xc0\f\xf5\xac\xd043\xb6\x1b\x18\xa6R\xebQP\x19\x89\x8b\x19Y&R\xa3\x8d\x1bcߏ\xa4L\x0f\x1e\xa3b5_&-7\xe9 \n$\x964\xeb\xdb\xd4\xed5\x1c\xa7M\xdfm\xa3\x94\x17.3\rj\\\x94\x1c\v\xe37\x9fFծ\x9dکڴ\x130\x00\x93j`I\xffNV\x1a`H\xddv\xc0\x00L\tf\xca\x00La\x9b\xa9ƛ\x1c`\xa6l\n\xb0\xa4ƶ4\xa8\xf4\b\x83\x1a\x8bg\x99\xb4l4\x12\xd4\x1b70*\xb6\xe4\x14{\xbc\x8a-?1\xeduFA\t\x02\x82@\x85\xe9\xd9\xf5Mjv\x13\xc7\xed\xd0w\xbba\x1d\x1a5\xc8\x13-\xcf\xc2x\xcd\x17\x11\xb8\x83\xa4\xf53\x010\xa6\x1a華\x13X\x0e\x80!鍖\x01\x98|\x16\xc6\x00L1W\xadi'P\xc0\x888c\xff\x95\x924l\x15\x06\xf5\xa6E\x98h\xd9g2hLI\xa5\xd6Lh\xd68\xf6\xf8\xa4\xe9\xd9ė\x97\x06;\x14\x10(7lkP_ck\xed\x1c\x1b\x8dm\xa4\xb4\xf1\x03E\xa0\xd5к\b\x042faDV\v\xa3:\xf8\xb5\xa7P\xf65P\x93caL;\x81\xb3\t0i\a\x17\x030\x05\x98\x18\xd3N\xc0\x00\x8ci'PQ\x80I\xb7\a3\xf7_\x0e\xda\r\xd8~\xd8\xd7(\x85'\x1bM\xa5V'\x97\x86\xa6\xa5R\x8f\xc7\xc8\xc4\n\u175a\x92\x1d\v\x16\x0e\xc1%\xf6s\x15\x7f\xfdAju\x80R\xe1\xcf\xea\xb5\x065\xbb\x86\xe3\xf6\xe9\xba]\x02\xed\xa3\x05Ș^\x89ۇ\xd4\x16FH\xbc\xb5\xe9\x16ƴ\x13X-\x80\xc9u}.9\xc0T\x81s\xec\x95\x05\x98,`b\xaa\xf1f\x9e\xc0\xd3Z\x18\x030E\xde\xfa\xe6\a\x18bA\xbd-K\r\xb3xD\xd2\xebf\x96!\x19[b\x8aǶ$/\x84\xa7F{!\x11\xb3=*\xb2@z\xec\xe7'`J\x13\xa80c\xa9nר[\x9b\xb8\xbe\x87\xab\\\x02\xc2\x00`I\x8eX\x18u\x88\xdfx\x06e_CL\x88\x851\xed\x04V\x01`\xd2\xec\xff\n\x00LdGU\xa0\x10\x96@J9\xbc驴\x89Y\xdev\x02i'u\x030E\xdc\xeb\x1b\x80\xa9~;\x81D\xb5p\x84\xa6Q\x8b\n\xca%\x06\x185\xba|\xc4x:t\xb4Ĥ\x06\x95{G\x97\x9c\xc6-\xcbH\f\r\xb1\x94\xecX&\x13q\b\xd2c_'\xac\x06\x1c(\x85V\n),Z\xb2A\xa0m||\x10z\b0\x89-\x8c\x98maL;\x81\xea\x03L\xda#\xbe\x92\x00#AhA\x10\x04h\xa5\t\x82`\b.B\x17{$K\x83\x98\xc5\x02L\n\x90\x98A.\x06`\n\xb8TM;\x81\xe2\x8fiE\x01F\x015\xa1\xa9K\x85N\x12\xd4;\x02-z4\xcbh\x98A4\n$z\x12\xa8\x8c[\x93\xf1Tj}\f@#)\xd7\xfat\x10\xd2\xf1\xbf;H\xd1\x1e<^\xa9X\xfc\x8c\nӳ\xb5\xc6\xd2\"\xb4:\x96\x86\x9a\x00\xcbBh=\xdb\xc2 \x91\xea\b\xbf\xf5\xf4D\v#R\x9d\xdcY?{\x030\xb9\xaf\xcf3\xddN@\xa0T\x80rÒ\x05\x83\x831~>\x88\xf8\xd4S\xc5.\u058bl'@\x02\vc\x00&\xeb\xc15\xd5x\xcb\x00\x98eN\xa5>q\x86(I\xbd\xe6c[\x1a\x7fF\xbb\x81ɩ\xd4c\xf1)\xa8\x11\xb89\xf5k\xc6\xe0'\x9eJ\xad\xf5\x89\x8eէZ\x97Xjv\xf2\xf4\xec\xb1Tm_\xa1]\x8d\xa8+\xac\x86\x8de\xcb\xe154\xdd\xc2\xcc\xceH\xca\xe0Q\f\xc0\x98v\x02\xa5\x01\x8c\x10\x82 P(\xa5P:@(\xc2\x06\xa5B\xcc>\x17+\xa2c\xecb\x01&\xbbF1\xed\x04\xb2\xf3\x89\xa9\xc6[\x1c\xc0\xa4\x1f#W\x03`\x06\xe7Q\xc3\n\x10b\xd6\x04<)\x95ZM\x81\n5\xe1뱔i\xa6\xfc|j\xc5\xdeɅ\xf0\x18+\x84\a\x93+\x02O\xef\x9e}\xfcz\b\x8d\x0e\x14A\xd7\xc3\xef9Ԛ6\x8d\xf5\x06V\xbd\x16\x16\xae\xd3\xfa\xd8\xc4\x10\xc5´^\x9f\xd2\xc2\x18\x80\xc9z5\x9aj\xbc\x19\x01&\xb6T\xa4\x94B\a\n%\x86a\xe9Y\xb8\xbb:\x10c\xda\t,\x10`\xb2\xa0\x8ai'P\xcav\x16\x8a\xd9M}\xa6\x86F-\x18\xb6\x1b\x90\xa7^;㠡\xa7,\x13\x1d[\x8f\xd1T\xea$\xbd\x92\x92Ŷh\x1dA\xd5H\x8c\x8c\x9a\x02SS\x96\xb3&e9\xa1\xa3R2\n簇\xd3\xeeRo\xd5imo\xd0hգ\xb6\a\nD\x00\xc2\xc2k\xbep\xc2\xc2\x18\x80\xc9:\xf9\x99j\xbcE\xb5\x13\x90\x12\xf4\x00\\<E\xa0\xc2\xf3{\x90\x8d\x97\xe5c\xa9$\xc4d\x9aJM5\xde\xfcf\nS\x8d7-\xc0\xa4\xb9\xe0L5\xde䟻\xb0\xa0U\vf\x00\x8c\x9a\x9d\xf6\x1cO\x93F\x17\x93J\xadc\x90\xc1\xa4T\xea\x93\x05\xef&e&\xe9\x18\x00鑺2\xa7-a\x81\xb0\x04ZkzG=z\x87\x1d\x1a\x1bM\xb6v7ilnb\xa9\x03\\\xfbi\x94}u\xc4\u009ce\x80\xc97/\x1a\x80ɛ\x81&\xa5D\xeb0(\xd7\xf7\x15\xbe\x1f\x80\xd2\b)O\x94\x10(\xc2G/\x1cbL1\xbb\xec\x00\x93\x86RL5^\xd3N\xa0j\x00\x13\xdf,\u00a0^5%\xa87q*\xb5\x9e\x95J=\xcbތ-\x0fq\x9c\xd54=\x95zʲ\x95\x8eU\x00\x8e\xbfNT\tx\xc4&1+\xe8X#-\x81V\x82\xdea\x9b\xdeA\x9b\xfa\xdaCvv-\xb89کze\x01&\xeb5j\xda\t\x144\xe6M?\x90\x03p\xd1*\xc0q|\x02?\x88\x80_ %\bY4pTC\xcbH\x0303\xb9\"\xe3q1\x00S\xd89o\xaa\xf1R\xccP8}ߕ\xb2\xb0-\x8d=\xa5\xf1\xe3\xc96\x01\x13:Q\xab\x18\b\xc4;V\x9f\xb0,zB\x9b\x80\xb1e\"}\xbcLt*(\x8d\xf4M\x9aP\xe9w\x18\x84\x1c\x8b\xe3\x19_\xe6JS\rx\b3\x16X\x92\ue757\xe8\xf0\f\xba~\r\x19\x84\xd5yW\xba\x1f\x92\xa9ƛ\x1b\xdc\xd2\x1e\xe3\xd3F?)%B\n\x94V\xb8\x8e\x8b\xd3w\xe8\xf5\x1c\\\xd7\x1b~Ʋ\x94\f\xa2\xea\xac+\xd9\x06`R\xe9\x18\x030%\x9cæ\x1ao\x81cD\x86e$\x10(\xa0i\x05H\xa1\t\xc6_a\x04Z\x18\x99\xdc5cK4S3\x7f\xd4h\xed\x18\xadb\xc0\x13[.R\xc7\xcbEyR\xa9\x87\xcfS\xe3K[\t\x96\xad\xa6u͎\xf7qR\x1aջ˹\xa7\xbf\x94\xb5\xa7\xff\x10\xbes89\xa3ô\x138\x13\x00\x93\xf6B\xce\x13\a30.J\x05\xb8\xae\x8f\xef\xfb\xa8 \xbc\x8e\xa4%cE\x1a)\xfb\x8c\xa8\bĘv\x023\xb8\xc5\x00Lz\xf5i\xaa\xf1\xe6\x02\x98\xacCaF\x80\x19m70\x1aԛ.\x95zzCF=\xb0%#\xa9\xd4\f\x81\xe0D\x8a\xf5\x94\x94\xec<\xa9\xd4\xd3ӳc\xcbS#\xc1\xc2\x13\x82\x97\xa3\xf7\xeb\xf7\xee\xb2\xf1ؗ\xb3\xf6\xbao\xc1w:\xa0\x83\x93\xe7\xfd\x1c\x01&\xbd\xdc4\xd5x\x8b\x9c\xa0\xcb\x0e\xe4=\x06\x17\x85\xe3\xb8\xf8\xbe?\xbc\xde\x066F\xb0$͎\x8a\x87\x98%\x03\x98,`b\xaa\xf1f\x9e\xc0\xd3^\xe7\x06`\x8a\xbf\xd1)\x13`\x88\xb5\x1bh\xd4\xfc\xb1\xa0\xde\xc8<\xa4J\xa5V'\xc1@\x8f\xb5\t`v\x9b\x80\x89\xaf9Ɏ\xa4H\xa5\x9enob\xfb7\xf2\xbeFa'|\xbc\xc0\xefݡu\xe3\xcb\xd8|\xc3\xff\x80\xdf\x7f\x04ڟ\x03\xc0\x14w\xee\x19\x80)\xc2@\x95\x0f0!\xb8\x80R:\x02\x17/\xea\xff\x15-#\tQ`\x80\xee\nB\x8ci'`\xda\t\x9c\"\x12\f\xc0d:\xac\xd5\xc9D\x1a\x7f\x86\x14Д\xa3\xf10\xc7q%\tS\xa9'\xa5I3\xe3\xe7\x89S\xa9c)\xd3j\f\x882\xa6R\x0f\x9f;%\xcb\xe9\x04\xf0\b\x81߾C\xf3\xca\x17\xb0\xf3\x86\xf7\x13\xf4\x1f\x81\xf6\"\x97\x95\x13`\xb2\\\x9f\xa6\x9d\xc0ʵ\x13\b\xcb\xfb\x870\xed8.A\xa0B\xeb\x82F\x02\"\x82\x97*l\xb2\xca\r \xe7\x060\x89yƴ\x13\xc8rԒ[\x18\x030y\xad\xca2\xa5R\x8f?C)\xa8\xd9\x01\xb6\x1d\f3\x93\x12\xa7R듵UFҡ\x87F#G*udHN\xa4m\xe7N\xa5&\xd1\x12V\b0\x92\xa0{\x97ƅ7\xb3\xf3\xec\x9f \xf0ۑ\x81I\x060\xf9\xec\x9b\x01\x98\xdcxR\xe1T\xeaA_\"\xa5\xc2\xe0\\\xcf\v\b\x94\x8f\xd6a\x1fyY!p)\xf33*\fb\xcak'p\xfa\xfck\x00&\xbbm2\xd5x\x8b\x05\x98\xb43\xc52\x03\f\x80BR\x97\x1e\x96\x88\x92\x9a\x93\x1a\x92i-\x03\xe0\xf4ؓ\xd3*\xf7\x9eH\xa5\x8e/g\x9d\x96J=\xe5u2\xa5R\xab\xd1`d!\t\xba\xf7\xa8\xed<\xc9\xee[\xbe\x13\x15\xf4!\xf0R\x9dL\xa6\x1ao\xce\x1d\xc8aa\xaa\b0Cp\xd1*2.\x1e\xbe\x17\x9d\x7f\x12\xa4\x88\x96\x8b*\x18\xe7\"\x8ad\x80\xa2!ƴ\x13\xa8V;\x81\xa4\xf4b\x00\xa6\xd8{\x84U-f71E3ZXi\xd6\xfcX\x9a\xf2\xc9Tj\xad\xa7ĝ0\xab\xa4\xff\x84\x00_&\xa4UO\xedJ\xcd\xc4Tj}\"\x95z\f\xac\xd4\xd8\xeb\xa7M\xa5\x1e<WH\x82\xfe\x03\xec\x8d\xeb\xec>\xff\x81\x10\x88|g\xa92\x91L;\x81\xc5\x01\xccDpQ\n\xcfuq<\x0f\x15D\xcdG\x01)\xad\xa8\x8anu7\x91p\xde_\xa8\x89IB(\xa6\x1aoB\x80\xc9B\x7f\xa6\x9d@y\x17\xdf\x19\xae\xc6;\xed\x19\x83\xa0ަ\x1d\x8c@\xc6\xcc\xe6\x8a\x13\x96aNo=p\x12vF\xe0(e*\xf5\xc46\x01\xb1ؖ\xd9\x19S\tR\xa9\x85@\xf5\xf7\x91\xcd\xf3\x9c{\xdb\xf7\"\xa4\x85r\x8f@X\x06`2\x93\xca\xd9*f7\x02.\x8e\x8b\x13\xf8\x04\xbe\x8fR\xe1\xc8mI9|\xcc\xd2mUk\x00i\xaa\xf1f\x01\x98L|b\x00&/\xc0\x98j\xbc\x85\x00\xcc\xf0\x0eQ@]\xfa\x04C\xeb1%>%)\x18\xe8\xd1>F\x13\xbf.:\x95:Q\xa7\xeb\xf1T\xea\x98=RcYRB\xa2\x9c\x03Dm\x9ds\xcf\x7f/\xa2\xb6\x86r\f\xc0\xe4\x9b\xe3\xce\x06\xc0H\x19\x16<TJ\xe1x\x1e\xbe\xe7\xe1y>J\xa9\x10l\xac%\x06\x97\xf8\xbeWd=\xc96\x00c\xda\t,\f`2^<\x06`\x8a\xdb\x14`[\x015ˋBG\xa6\x94\xe8\x9f\x15?\u0094\xf8\x94\x819I\x93J\xad\xcbK\xa5\xd6\xe3Հ'\xb4D\xd0H\x94w\x04\xb2\xc6\xee[\xbf\x17\xabu\x1e\xe5<\x02!M;\x81\xcc\xd7\xfdj\x03\xcc\x00J\xb4\xd6x\x9e\x8b\xe7\xfax\x9e\x17-\x15\x85\xc1\xb9\x03\xb8Y\xfa\xadj]\xacM;\x81\x04\x8f5\xd5x3\x01L\x9a\xd1ƴ\x13(v\xa4HjaP\x92z\xcd\xc3\xd2\nW\x8b\xe3\x18\x97Y\xf1#\xa7\xa5R3ֹ:I\xa0\xb0\x8eA\x13\x13\xecH\xaa`\xdc\xf1T\xea\xd8\xcfO\xad\xe0\x1b\xc6&(\xaf\x8bV\x01\xe7\xde\xf6\xbd\xd8\xebWW\x16`R\x9e\xd8\x06`\xc6~:\x04\x174\x9e\xe7\xe1:\x1e^\xe0\x1dW\xcf\x1d.\x15\x99Z.\xa5\x9b\x98\xb3\x0003t\x8c\x01\x98\x12(\xdbT\xe3\xcd\n0i\xf6?\a\xc0D\xe7]\xd3\xf6\"x\x91\xc9S\xa9\xf5\x84\xda*S\x97\x9b\x12t\xbaf,5z|\x19\xea\xb4\x14\xe8\xa4]\xa9g\xbc&H\xb4\xdfE\a}v\x9f\xff\xd3\xd4v^\x8b\xea=X*\x80\xc9t}\x1a\x80I\xbcɨ\v4\x80\xe7\xf9\xb8\xae\x1b\x1a\x17_\xa1\xe5\x00\\\x84\x01\x97\xb9C\x8ci'`\x00&\xd1\xeb\x9aj\xbc\xb9\x00&+\xfe\x95\x040Ჶ\xa0!\xfd\xa8\xcfΩ\x8a\xe3Uk\x99\xd4>\xe0\xb48\x94\x19\xa9\xd4\xf1\xe5&\x9db\t\xab\xf0Tj\x8dV\x0e\x81\xd7a\xe7\xb9\uf83e\xf7,\xaawo\xe1\x00\x93^n\x9aj\xbc\x89\x01&\xe1q\x1aƯh\x8d\xe7\xfb\xb8\xae\x87\xeb\xba\xf8\x81BFu^\x84%\xa2\x94h\xb3\xcd\x1fb*\x060\xa9\xc0\xc4T\xe3\xcd\x050iF\x16\x030\x05L2\x15\t\xe4\x8d?BH\xa8Y.j\xac\xf6ʉ\xec &,\xf7$\x89C\x19>~Z*\xf5\xe9ui\xcaO\xa5V\xd1{\b\xf0\xfb\al\xbf\xf1\xdbh\\~\x11՝\x17\xc0䰙\x06`\x12\x02L\xfa\x83;\x8c_\xd1:4.\x9e\x8b\xeby\xf8\x81\x8f\xd0\x02$X\x96\xc4`KUL\xcc\xc2\x00f&\v\x18\x80\xd1\xe5\x05\x81\x9bv\x02E\xa9\x93\xe5\xc9D\x8a?B\t\xa8\xa1\xa8\v?b\x92q`P\xa3\xcb3q\xb32\xabx]ܬ\xa8\xb1\xe6\x91c\xb0\x93'\x95\xfa\xd46\x013R\xa9\xc3ǂV\x01~\xff\x01[\xaf\xff#\xac\xddx\x17A\x91\x00\x93e&5\xed\x04\x16\xd2N``\\\x84\xd6\xf8\xbeO\xdf\xf5\xf0\\\x17\xdf\x0f\xc2:\x04H\xa4\xb40튢\xe35\xd2gm\xe1\x10Sn;\x81\x99\x88a\xaa\xf1N\x05\x98ԗ\xaa\xa9ƛ\x177RZ\x98\xe5\x04\x980\aTb\xd9.R\xf8xJ\xc4\xe2JԔ\x92\xfe\xea\xa4!\x99\x14cB\xb6ؖ\xf8\xf3&\xa7R\xab\xb1%\xac\xc9\xef3i*u\xc86\n\xbfw\x8f\xcd'\xff\x00\xeb\x8f}5A\xf7n\xaaBv\xf9\xec\x9b\x01\x98\xbc4\x987\x0eF\x86\xf4\x82\xd0\x1a\xcf\x0fp]\x17\xd7q\xf0Tx\x8e\f\x97\x93\x84\x89s\x99x#T\r\x88\xc9\x060Iu\x8ai'\x90\x05`\xd2\x1f~\x030\xc5\xdc\x04\x9f\x19\x80\x19\xb6\x1b\xf0\x11z\x10ԫ\xf2\xa7R\x8fU\xf2M\xd4&`|\xe9ijl\x8b\x9e\xdct2\x063̨'\xc3X\x00\xb1\u07fb\xc7\xc6\xe3_\xcbƓ\xdf\x18\x19\x18Q\xdc)d\x00&\x13\x9f\xcc#\x90wЏ\xc8\xf3|\xdc~\x0f'\n\xd0\xd5\x03\xc3`Y\x18\xe5R\x18k\xce\xc3\xc4d\xe5\x14\xd3N 1\xc0\xa4=\xba\xa6\x9d@\xe1\x97\xceY-fw\xda$֔.\n\x85֢\xa0TjҧR\xa3\xd1*y\xcc\r\xb1\xa2zIR\xa9\x99\x92J\xedw\xee\xb2~\xe3\xddl>\xf3\xcd\x04\xfd\x87 \x14 M5\xde<\x00\x93sf,\x13`\xa4\x15\x81\x8b\xef\xd3\xef\xf5p\x1c\a\xcf\xf3\x8f\xad\xcc`9\xc9pJ\x8a\x0fm\xf1G\xcb\xceba\f\xc0d\xb7S\xa6\x1ao\xb1\x88o\xaa\xf1\x8a\xec\x87Uh\xea\x96\x1b\xc5ڪ\xe9\xa9\xd4\xe3\xad\x01\x12W\xee\x1d\xebb\xcd\xd8\x12\x93\x9a\xb0\xf4\xa4g,=\xc5\x12\xaa\xf3\xa4R{\xbd;\xb4\xae\xbc\x83\xad\xd7\xff1\x02\xe7\x00t`\x00\xa6\b.\xa9X&\x9250.ʧ\xdf\xef\xe1\xf4\xdd\xe3깂Jv\x88^\x1e\x80\xa9F\xc9^\xdb\x00L\xfe\x83h\xaa\xf1\x16\x000i&{\x030\xd9\xd6\xd0\xc6\x1e.\x85\xc6\xc2E\abJV\x10'k\xaf\xccj\x130\xa9X\x1e\x13R\xa9O\x81\xa5\xb4\xb1-\xa9R\xa9\x85 \xe8ޣ\xb9\xf7\x1c\xdbo\xfa\xe3\x04~\x1b\xb4?\xf9\xcc7\xed\x04\xd2Y\x98\x8a\x00\xcc\x00L\x94\xef\xd3\xeb\xf5\xe8\xbb.\xae\xeb\x86\xe0\"%\x96\x94X֒\x97\xfd_8\xc0Tg\xb3\xcf4\xc0\xccx5S\x8d״\x13X\xd6v\x02\xb3&2\x85\xa4&<l\xe1\xe3\xab\xd8\x12\x10cYAI\xc0\x80\x93}\x93Fj\xb9\xccH\xa5f\xaaq\x89-g\xa9\xb1\xa5-\x92\xa6R\xc7\xf6CH\x82\xee}j;O\xb1\xf3\xdcw\xa2\x82>\x04\xde\x19\x04\x98\x04\xe7\xfe\x92\xa5R\x87\xe0\"\t\x94\xa2\xef8\xe1r\x91\x1b\x96\xfd\x97\"\x04\x1b˲\xccbQ\xd1\x00S\x01\x16\xb4W\x13`2+\x14\x030E\x90\x8a\xa9ƛ\xda*\x91\xfa\xb3\xcf\x1e\a3\x84\x18\xe9\"\xb4\x87Ǝ\xe0eԌ\xa4N\xa5\xd6\xd3J\xfa\x9f\xb2\xd44\xfe\xdcxl˔T\xea\xf8\xebL|ݑT\xea(\xdeF\b\x82\xfe\x03\xec\x8dkl\xbf\xe5\xbb\xc3\xe5#\xdfI\x95\x89\xb4\x94\xed\x04\x12\xbc\xd9e\x05\x98\xa1qQ\x8a~ߡ\xd7\xef\x1f\x1b\x97\x01\xb8H\xcb`ˊ\v\x19{\xd9\x01&\x05\x9d\x98v\x02i\x00&\xeb\xb9k\x00&\xdd\xf5=\xe7@\xde\xf8V\x17n$L\x12\xa4@\x17\x98J=\xf2\xf8\x8912\xa7\x83ˤ\x18\x9c\x13\xf6h\xb8\xdc4\x00\x98}ds\x8fݷ~\x00!m\x94\xbb\\\x1d\xa9\x8b\xb0o\xcb\n0\xe3\xe0\"\x85 P\n\xc7q\xe8\xf6Bp\t\x82\b\\\xac\x81q\xc1X\x97\xb2\x01\xa6J]\xac\xab\x060\x99p\xc5T\xe3-\x16\x92M5\xde\xe4\x00\x93\xf5\xa0.\x10`\x84\x16\xd4E\x1f%\bMEa\xa9\xd4\xd3\x1a2N\xb0.3S\xa9g4\xa0<\xa5W\xd2q\x15_\x81r\x0e\xb1j\xeb\xec<\xff\xbd\b\xbb\xb5\x94\x00s\x96\xab\xf1\x8e\x18\x17ǥ\xd7\xeb\xe18\x1e\x81\xf2\xc3R\xff2\x0e.f+}\x93\xd5\x01\x18&\xf7N*\a`R\x81\x89\xa9ƛ\v`Ҍ,\xa6\x9d@\x01\xf4\xb7\x04\xa9\xd4㏒hj\xf4\xd1At\x1eN\xeb8}\"\x95\x9at\xa01\x1e#\x93*\x95:]W\xea\x13\xa9Ԁ\xf2: kl\xbf\xf5\x03X\xcds(g\xbf\x12\xed\x04\f\xc0\x88\xd9\xc6E\n\x94\xd68\x8eK\xdfq\xe8\xf5z\xf8A\x80\x10\x02K\x8a!\xb8\x18\xdfrv-\f'{'\x15\x0503Y\xc0\x00\x8ci'\xb0P\x80Is$\x97\xbd\x16\xccɳObK\x1f)<\x02-bK=\xb1Tj\xb2\xa4RO\x89OɜJ\x9d\xb5+\xf5\x00`zh\x15\xb0\xfb\xd6\x0f`\xaf]E9\x8fL;\x81\n\x03\xcc`\xa9Hi\x8d\xeb\xba\xf4\xfa}z\xfd>\xbe\xaf@\x80\xb40KEU\x00\x98\x8amv\xcai=\x1d\xb2\x98j\xbc\x85\x00\x8c\xa9\xc6[\bn\xa4\xb40\xab\b0\"\xba\x89\x92آ\x8b @+k\xb4\x86ˉTj5\x06\tjz\xc7i\xa6ħpzp\xef\x89%\xac\\\xa9\xd4\x11\xc0\x04}t\xd0g\xe7-\xdfCm\xe75\xa8ރT\x00\x93Ͼ\x19\x80I\xfa\x808\xb8x\xaeG\xb7ߧ\xd7\xef\x11\x04\x01\x83҃a\xa3E\xb3U\x91g\xaa\U0003992b\xd8k\xaa\xf1\xe6\x04\x98\x8c\xe0k\x00\xa6л\x85\xb3\f0\xa0\xc2\xcc$zQL\x8a\x9c\xb1\xac\xa3'Ķ\x8cY\x90\x89\x95{OO\xa5\xe6\x94\xe0`\xa6\xb4\t\x98\xdcj`\fp\x00\x02\x17\xed\xb6\xd9~\xf6;\xa8\xef=\x8b\xeaMi\xe8\x98\xf5\x142\x00\x93\xe9\xc6|pU\x85KE\x12\xa5\x14\x9e\xeb\xd3uz\xf4\xfa=|\xcfGi\x81%#\xb81\x9c`\xb6,\x10c\xaa\xf1\xa6\x04\x98\xb4\x14h\xda\t\x14\xee'M5\xde4oX\"\xd0\xd4E\x0f\xa5\xe3ݜO\x01\x19\xd4\xe9-\x03ff\x0f\xcdH\xa5\x1e\xb3,z\xc6k\x8e@\x14\xa3\xc0\xa3\x95\x8f\xef\x1c\xb0\xf5\x86o\xa5q\xf9\x05Tԑ:\xcd\xc13\x00S\xe0\xf5\x19=ݒa\ah\x94\u0089\x8a\xd0u\xbb=\x1c\xcf\a4\x96\x8c\xe0\xc68\x97\xcao\x15\x8b\xeb\x9d\xd4v\xc0\x00L\x16;e\xaa\xf1f\x01\x984\xfbo\x00&\xff]\xf0\xe0k\x8d\x8d3\xd2/)I\xb9\xfe\xe4\xa9\xd4LI\xc3\x1e\xc4Ȩ\x14\xa9\xd4jtik\x00?q{4x=\x15\xe0w\x1f\xb0\xf9\xfa?\xc2\xda\xf5/\x8d\x1a:\xca\x19\x93\xb8\x01\x98\xacjj\xd6\xcbH+4.Bk\\ϧ\xdbk\xd3\xed\xf7q\\\x17\x94BJ\vے\xa5\x16{4[\xf1\x9b\xae\xd8\xfb\xb1\xcf\"\xc0d\xff\xb0L5ެ\xed\x04\xd2\xfc%\x030\xa2\x88\xc38\xf1\x93WH$\x1e\x96\x8eLLx!N\xad\xc4;\x92\x15\x94\xa5\x10\x1e'\x97\xa1N[r:\xb9\xdc\xc4X\xf5\xdeh\xdcP\xfa\xd8\xd80\xe8H}\x9f\x8d\xa7\xde\xcb\xfac_Eн;<W\r\xc0d=\x8d\xd2/#\r\x96\x8a\xb4\xd0\xf8\xae\xcfQ\xafM\xa7\xdf\xc5s\x03T\x10D\x95s%BJ\x13\x9c\xbb\x84\x9b\x88L\x8c\xaa\x1aĜ\x9dv\x02\xc9\xf9\xc6\x00Lqg\xbdi'P\xce`\x92\x16`\xa2i\x86\x9a\xd5?\x0e\xea\x1d.\xe3\x8c\xc1B\x96T\xea\x91^I\xf1\x14ku2\xb6\xe6\xb4Tj=#\x95Z\xc7\n\xd9E\x96\xc7\xef\xdce\xfd\xb1\xafb\xe35\xbf?20g\x11`\xb2\x03o\x1e\x80\x91\x12\xa4\xb4\xd1Z\xe3\xfb>\xedv\x9bv\xaf\x8f\xe7\xba\x04*\x18VΕ\xb6\x8d\xd9\xccV8Ęv\x02\x06`R\x8c\xb4\xe9^\xc2\x00L\xba\xb9\xa5\x94@\xde\x13\f\x83t\xef\xa1m\x8d\x14\x9a@\xc5K\xf4\xe7L\xa5\x8eg\"\x11\x7f\x1d\x92U\x03fB5\xe0S\x97\xb9@#\xf0;wh]{7\x9bϼ\x8f\xc0y8\xfd\xd40\xed\x04\n\x05\x18ێ\x81K\xa7M\xa7\xdb\xc5q\x1d\x82@E\xe0\"\xb1\r\xb8\x98\xad|\x13c\xda\t\x9c)\x80I\x7f\x93f\x00\xa6LS5O\x80\x11\x12\xa1=\xbc\xee\x03\x1eHI\xa3\x06\r[!# QSR\xa9u\xaaT\xea\x19\xc1\xbd\xd3\x02\x84u\x96Tj\x81\u07fbC\xf3\xca;\xd8~÷\x108\a\xd1\xfb\x10\xa6\x9d@I\x00c\xdb6B\x80\xeb\xf9\x1c\xb5۴;]z\x8e\x83\x0e\x82\xa8rn\b.f\xa1\xe8\x8cl\x15\b\x90\xb1\xe7\t0\x99\x8e\x8d\xa9ƛ\t`Ғ\x8a\x01\x98\xe2\ue02b\x91J=\xe1\xc9R\"\xfd>Rh4\x92\xae\x03}\xafN\xdd\xf6i\b\x85\x94AT\x9ae\xfarҸ5I\x93J=L\xa1\x9e`\\\x8e\x97\xa4\xd4\xe9\xa9\xd4\x03\x90\x11\x12սG\xe3\xdcsl\xbf\xe1\xdb\t\xfc6h\x7f\xe9\x00f\x19R\xa9m\xdbF\n\xf0\xc7\xc0%\b<\x84\xb0\xb0-\va\x8c\xcb\xd9ܪ\xd0\xc5z\x9e\x00c\x8a\xd9\xe5\x03\x98tӪi'\x90\x9b\xfe\x96\xb6\x16̤'G\xa5Ân\xd4\x14Q\"ehD\xfa\xbe\x85\xa3m\xeaң&\xfbX\x04(\xa5`P\xcdw\x98\x11t҈L\\v\x1aOɞ\x92J=9=[\x9fL\xa5\x1e\x83\x1f\x84 \xe8\xde\xc3\xdey\x92\x9d\xe7\xfe\x14J\xf5!\xf0\x16\a0Y\xaf\xd1\n\x03\xcc\xc0\xb8x\x9eO\xbbݡ\xd3\xed\xd0\xed;a\xd9\x7f\xad\xb1m\x9b\x9a]3\xc1\xb9gqS\x85_\n\x05AL\xc6v\x02\x06`\xb2\x03L\xae\xfb}\xd3N \xfd\xfe\xa4\xda\xf7U\x02\x98\xd87\xaaw\xe2\x85$\x80\xd08\xba\x8e\x1bԨ\t\x8f\x1a]$\xfd\x10\x1d\"\x98I\x94J=\xd1\xd8dH\xa5\x8ee\x1d\rcpԠ#\xf5#\xac\x8d\xebl\xbf\xf9\xbb@\a\xe0; \xca\x01\x984'\xd2\xf2ւ\x89&\x03K\",\x89\xe7\x05\xb4;\x1d:\x9d\x0e\xbd^\x1f/\b\x00MͶ\xa8\x99F\x8bf\x93\xc3{\x93\x8aAL\x1a\xc40\xd5x\v\x01\x18S\x8d\xb7X\x80Is\xf0\xce\x1e\xc0H\xd0\x1a\x11\xf4\xa7&I\xca\xe8g\xae\xae\xe3Q\xc7\xc6\xc5\xd2GH\xed \x85\"P\xf1%\xa6\t\r\x1cO)\x84\x97(\x95ZOȎR1\x93#\x04A\x7f\x1f\xd1<\xc7\xee\xf3\x7f\x06!,\x94\xd7NՑ:ש\xb3\x82\xc5줴\xb0\xa4$\xd0\x01ݞ\xc3Q\xe7\x88N\xaf\x8f\x1fx\b\x1d\x16\xa0\xb3-+G\xf9l\xb3\xad\xea&\xa8FUe;\xb9\x851\x00S\xb8U1\x00S\x9e\xa92\x003a\v\x10\xca\tsbO\xbd\xd1\naƣN\xc7߂\xa0GSviI\a$x\xc1\xc9*\xbc#\xc1\xbe'ҧ\x13\xa6R39\x95ZG\xc1\xba\xca9D\xd46\xd8}˟A\xd8-\x94{4\x19`J\xb1o\xabS\xccn\x909\x14\x04\x9a\xbe\xe3p\xd4n\xd3\xe9\xf5\xf0=/\xfc\xbd%\xb1\xad\x9aA\x16\xb3\x8dn\xf1e$-\xa2\xa0\xfe*@\x8c\x01\x18\xd2\xedU~\vc\xda\t\xa4\x99pL;\x81\xd4\x003\x05M\x84rA{ \xacD\xe7t\xe09(\xdf\xc7\xf1\x04\an\x1d\xa9a\xb3\xe1\xb0\xd5TX6x~\b4\x92\x04]\xa9'\xa5R\x13/ZwJ*5\x02\xe5uв\xc6\xee\xf3\x7f\x1a\xab\xb9\x8br\x0eL;\x81\x14\x00cEࢴ\xa6\xd7w\xc2\xe5\xa2n\x17\xd7u\xc3\x0e\xd1vͤC\x9b-\xf1H&*\xe4\xe5\xec\xb3\b0iޘ\xa9ƛ\x05`\xd2\xec\xbf\x01\x98l\x00\x93\xe6\x80(\x906\xc2w\x80\x00\xb0f^\xbb\xbe\x1f\xe0y>\x9e\xef\xa3|\x1f\xad<\xba\x8e\xcf\xc3C\x81\x85bo]qa[\xb0V\a\xcf\xd38J!Ʋ\x8aF\x82|\xe3\xc6FMI\xb1\x9e\x94J\r(\xaf\x87V\x01;\xcf\x7f\x0f\xf6\xda\x15\x94\xf3ȴ\x13H\x000\x83\xea\xb9\xe8и\xb4\xdb\x1d\xda\xddnX\xf6?\x02\x1b۶\xcd*\x91ٖz\xb3W\x1e`\x121\x8d\xa9\xc6k\xda\tT\x03`\xd2\xde\r%\xff\xec%\xc2\xef\x87'\xab\x98^8\\h\x81\x1f\xf8\xf8\xfe\xf1?\xcf\xf7\xf1\xbd\x00\xa5|$>}\xc7\xe7w\x0e|^\xba\xe5qq[r\xfd\x82\xcdf\xc3\xc2\xf1\x02\x1c7@\xa3\x11\x8ce\x16\x8d\xb7\tHԾ\x00\x94砃>;o\xfe.j;\xafE\xf5\x1e\x18\x80IR\xf6\x1fM\xbf\xef\xd0\xe9tiw:\xf4\x1d'ʲ?.@g\xd8\xc5li\xb6\x91դ*A\xcc*\xb5\x13H\xf2\x02\x06`\n\x9bCM5\xde\x12\xb6\xe2\xe3`b?\xd6]fq\xac\x1fD\xd0\xe2\xfb\xf8~\x80\xef\a\x04\xb1\xef=\xcfG)\x1fK\xf88\x8e\xcfo}\xce\xe3\x13\x9f\v\xb8z\xce\xe6\xc9\xeb-\xb67l\\'\xa0\xefj\x94V\x11̨a\xf3Hf,;\x1d7\x8a\x04\x15\xb8(\xff\x88\xadg\xff$\xb5\xbd7\x9ea\x80\x99>\xb4\r\xc0DZ!\xb88\x8eK\xbb\xdd\xe1\xa8\xd3\xc1q\x9c!ؘ\x02tf[i\x13SU\x80IF4\xa6\x1aonR1\xd5xK\x03\x98\xc5\x06\xf2\x0e~\x1c.)\xe0\xf7Nm\xdf6\x80\x14\xdf\x0fB\x90\tb&f\fdB\x98\t\xa8K\x85\xe3\xf8|\xfc\xa5\x0e\xbf\xf9\xd2#n\\\xa8\xf3\x86'\xb6\xb8x\xae\x81\xe7\x06t\xfb.Ji\x848\xbd\xaḛ\x9d\x81\x8a\x82\x05\x95\x8fr\x0e\xd8~\xfd\xfbi\\|;\xaa\xb7\xba\x1d\xa9ӚM\x11_*\x02\xfa\xaeC\xfb\xa0C\xbb\x1d\x1a\x17\xad5H\x19\xa6C\v\x83.f;s\x10\xb3\x88v\x02\t\x98\xc5\x00̬i,݄o\x00\xa6\\SU\x19\x80\x19@\x8c\x8f\xd0\xee\xd4\xcc$\xad\xd51\xa8x~h_\xbc\xe8\xdf\x00jF\fM\b7\x9e\xe7\x13\xa8\x80z\r\x1c'\xe07^z\xc4\xc7>y\x9f\x9b\x97Z<\xff\xba]n\\ZG)M\xbb\xd3\xc7\x0fT\b3\x8c\x17\u008bu\xb7\x06P\x01~\xff\x01\x9bO\xffa\x1a\xd7߅\xea.\x1f\xc0\x14g\xdfDT\xa6\xf08\xab\b\xc0u]\x8e:\x1d\x8e\xbamz\xdd>J),\xcb\xc62u\\\xccv\xb6!ƴ\x13\xa8:\xc0\xa4%\x15\x030\xc5\xdd\x01/O*\xf5x\x89\x18\x81\b\x9c\xb0,\xbf\xa8\x9dx\xacR*\n\xe2\rbq0\xc1\x10V\x86\x06f\xe4g\xb1\xaf\xa3\x00\xe0@\xf94\xeb\xe0*\xcdo\xbe\xf4\x90\x8f~\xe267/\xaf\xf3\x8e7]\xe0\xe9\xc7w\x00\xcda\xbb\x8f\xef\xf9\x91\xa5\x98\xd07I)\xfc\xde=6^\xfb^Z\x8f\xfd^T\xf7\xfe\xd2u\xa4\xa6\x80e\xa4\xa8\xber\xb8\x14$\xe5\xff\xdf\u07b9\xc6H\x93\x95\xf7\xfd\xff\x9cs\xaaz\xe6}\xf7\x06\xf2.\xcb\xc2\xe2\x05\f\x86\x18\x82\x1d\xdbl\xc2\x1do\x02\x96\x8dcbb\xc5N\x8cA\xe4\x82\x13ag\xa5\b\x99\xc8(\xc0\aDpP\x8c\x8d\x1d\a\v\xb3\xc8\xd9]nN\x16H\f\"F\xb6c)\x1f\x1cŒM\xa2$쾗\xb9Owϥg\xfa>]U\xe7\xe4CUuW\xdff\xba\xaaN\xcfTw?\x8f\xb4\x9a\x99w{z\xba\xaa\xce\xe5w\x9e\xf3\x9c\xff\x1f\x04\xc0\xeb\xf5Po\xb7q\xdah\xa0\xd3=C`\x02(\bH%!\xa5L\xbd\xb8\xe1\xe0X2\x88a;\x81\xab\x02\x98t\xd3*\xab\xf1榿\xa5ւ\x19\xfdg\r\xc0\x05\x82N\b\t\xa3\x93\xa5\xc6P\x11\xaf?\x042!\xccx\x89\f\x8d\xe7{C[M\xbe\x1f\f\xbe\x8f\xb6\x99\x82\xc0\xc75\x17\xe8\x91\xc0w6\x8f\xf1\x17\xffo\x1f\x0f>\xe7:\xde\xf0\xd7\x1e\xc0_\xfd\xde{\xe1^wq\xd2\xec\xe0\xec\xcc\a\x81@48z\xedw\x0fp\xfd\xbb\x7f\f\xd7_\xf4\x13\b\xdaGW\xebH\x9d\xb5\x8ff\x04\x98xl\x91B@\t\t\"B\xcf\xf3p\xdah\xa0^o\xa2\xd3m#\b\x82ЯHIH*\x82\xd4\x18\aG\xa121\x192+\f0\xe9\xe0!\xc7<\xcc\x00c\xe1\xfe\xaf\x14\xc0$\xfe]w\xe3\xb4L\xa2&\xc6DE\xbb\xc3\xe02\x94\x81\x199\xa9\xe4{\x83\xecK\xb8Ք\xcc\xe0\x04\xf0\x830c\xe3E\xffo\xdd%H(\xdc\xde>\xc6\xffz\xba\x8c\a\xee]\xc7#\xaf~\x01\xfe\xfa\xab\x1e\xc0\xb3\uee0e\x93z\a\xed\xae\x0f\"@w\xaa\xb8\xf6\xfc7⎗\xfe4\x82\xb3\xda\\\x01&MC\x9ag!\xaf\x89\xbe\x91\x00\x84R \"x\x9e\x8fz\xe3\x14\xa7\xcd&:\xadV\xb8\r'\bJ)\xb8B\xf4\xc7!\xc3'\x8c88b\x88a5^[\x00\x93\x96L\x18`\xd8N`\xbe\x00\x13\xadփN\xc2\xf4$\xa1\x05\x93\x04\x17/\x91\x81I\xc2J\x7f\xebh\xc2VR?C\x93|\xbd\x978\x9a\x1dfg\xd6\\\t)\x1c\xec\x96\x1b\xf8\xd4\x13\xff\x03_\xfc\xc65\xbc\xe55\x0f\xe1G\x1e~\b\x0f\xdcw'\x8e\xab\xdb\xd0\xcfy5\xee|\xd9\xcf#8;\x8d4b,\xf9!]x\xaf.\xb9\x907.r!\xc0I\x82K\xbd\x8eF\xa3\x85f\xab\x05?\b\xb7ܤ\x10p\x1dg\b\\888\x86 \x86\x01\xc6f\xb0\x9d\xc0\x9c\xee)\x03L\x0e\xe03\x80\xeeFE\xbd\x06\xc6\x10\x82IZ0C50\xfeX\xcdK\f'\x83\xad\xa6d\x86\xc6Kl;%\x81&z}\xb4\x15\xb5\xe6\x12\xd4]k8<n\xe2SO\xfc\x19\x9e\xfc\x83o\xe3\xad\x0f?\x17\xefx\xc7O\xe2\xc1\u05fe\x0f\xa7ǵPU\x18\xc2^S*\xc0I\xa4\xd8\x11\xca\x01 \\\x05\x12\x04\xdf\xf7qZo\xa0\xdeh\xa0\xd9j\xa1\xe7\xfb\x90$ \x94\x82\xeb8\xe7\x0f\x87\x1c\x1cW\x1d\x05I\x03\x8a\x95\x03\x98\xb4\x83?\xab\xf1Zo\xb9\xac\xc6k\xd3N\xe0\x82\xb7#\th\x0f\xa4#0 B\xa0\xfd\xe1\f̔\x9a\x98\xe1m\xa4(ے\x84\x9f \x18Ӕ\x19\xdaZ\x8a\xe0%\xb9\x15u\xd6\xf3p\xd6\xf3PR\x84\xfb\x9e\xb5\x8ef\xa3\x8bO\x7f\xe9/\xf0\xda\x1f\xff\x17\xf8ڗ?\x8f;\xae\xaf#\bL\xaa\x9bW\xe4\xa3\xd4\xf1\x958Ja\xcdq`\x88\xd0h5\xb0\xb5\xbb\x8b\xa7o\xde\xc6\xe6\xf6.NN\xeb\xd0dPr\x1c\xb8J\x15\xcd$\x98\x83\xa3\xb0\x00\x83\xa4w\xd2r\x02\xcc\xf4\xdfa5\xde,\x00\x93\xe6\xfa\x19`\xb2\x01L\xfa\x81\x83\xce{\xeeB\x80\xfc\x16\x00\x1f\x84R\xa2\x9e%\x18\xabs\xf1F\x8fPO\xd8J\xf2\xbc\xc1\xb6\xd3\xe0}\x92p\xe3\r\x17\xfd&\xdf7\xf0\x87\xb6\xb0\xbc\x9e\x87ҚD\xafu\x8c7\xbd\xe9\x11\xbc\xf9\x91\xbf\x85v\xa7\x03I\xb4\xd0Z0\xc6\x18\x10\x855,D\x04\x1d\x04h\xb6Z89\xad\xa3\xd9j\xc2\xf3\xfdp\x05\xa9$\\G\rej4O\x8f\x1c\v\xc20\x85\xf2NZ\x16;\x81Y)\x87\x01\xc6.~3\xc0\\\xa5\x9d\xc0y\xcf=:\xa8\xebw@\x06\xf0aF\xf4^\xa6\x81\xcb\x04\x00\x19\xdaJ\nF\xde\xc3Kl\x1d\x05\xc3ٗ \x18\xe8\xcc$\xeae|\xcf\a@\xb8\xf9\xf4\r\xbc\xfe\x8d\x8f\xe0\x89\xa7\xbe\x85\xb3n\x17\x8d\xc6I_\xc4m\x91\x00F\x1b\x03A\x04%\x04\x84\x94\bbp\xa97\xd1l\xd6\xd1\xf5<\x00\x04G\b8*\x06\x17\xe2l\v\xc7b\xd2K\x81\x1a\xae*.\xc0d\xe2\x13\x06\x98)\x00\x93\xa6\x91\xb2\x1ao\xe6\xa4H\xce,\x8cM\x80I\xbcJw\xa0\x8d\x99\xb0]4y+\xc9\xf3\x86\xeb]\xbc>\x8cL\xdbv\x8a\xb21^0\xac\xea;T\x17\x13\xf4=\x98|\xdf\a \xb0\xb1\xf14^\xfd7^\x8b\xcf}\xf9\x1b\xe8tZh\xd6\xeb\xe3bmEՂ!\xea\x9f\x0e\x8a\xc1E\x1b\x8dV\xbb\x83\x93\xd3\x06\xea\xcd&\xba\xbd3\x10\x85\xff\xbf\xa4\xb8ƅ\x83\x19fn\x99\x98\xcb\x06\x984\x94\xc2j\xbcv\xfc\x90X\x8d\xd7\x1e\xc0\xa4\xbd\x01W\a0\x02d4\xe0\xb7\xc3m \xad\x87O\x12\r\x89\xd8M\x81\x93h\xbb(\xb4\x1a\b\xfa\xdbB^\xe2H\xf5p\xa1opNmM\b2\x86\x046o\xdf\xc4\xf7\xbd\xf2\a\xf1\xf8\x7f\xfc\xafЁ\x8fF\xfd\x14J\xaa\xc2\x03L\xa8\x8c+\a\xe0\xa25Z\x9d.\xea\xa7u\x9c4\x9b\xe8\xf6\xba\x00\f\x94Pp\x95\x82\xa40\x1ff\"\xe4\xe1#\xd1\x1c\x1c\x97\f1l'p\xc5\x00\x93\x19\x99\xd9N\x00Ko'pQ\xe6-Ԅ\xf1{\x1d\xf8\x81\x19у\t\xc6N\x0fM\xcfЄ[@A\xf2(v2\xd32\x047\xdeX\xfd\xcb\xe0\xefx \x02\xb67n\xe3\xc5/}9>\xff\x95?\x82\x94\x12\xb5㣾\xb3r\x11\x01&\xde*\x92B\xa0\xe4\xba\xd0Z\xa3\xd3\xed\xe2\xa4^\x0f\xb5\\\xbag0ZCI\x19\x16\xe6\x12AG\x03\t\u05f8pp\\!Ĥ\x01\x18V\xe3\xcd\a0iS(l'\x90'5\xb5|bv\x93\xb6\x0eI\b \xe8\xc2?k\xc1\xd34\x96\x19\xf1&dIF\xb7\x91\xbc\x89\xf51\xc1\xe4\x9a\x1ao\xf8X\xf6\xf0V\x93\x0f\x90\xc0\xf6\xd6&\x9e\xff\x82\x17\xe2\x8b_\xfdc\xac][\xc7\xf1\xe1A\xbfF\xa4H\x00\x13\x83\x8b\x10\x02\xae\x940\x00:\xdd\x0e\xaaGG89m\xa0\xd3\xedB#\x04\x17GJ\x90R\x91\x89e\xf8\xbb,CǱ\xfc!\n\xd1Ε\x8d7a\x80\xb1\xb1\xbag5\xde\\\x00\x93\xee\n\x96\x1a`\xa8\x9f\x03\x100\xbd&<\xaf\aߨ\xbe\x8d\xc0ԭ$oB\x9dK\x10\f\xd9\vx\t;\x82\x89p\xe3M*\xfc\xf5\x01\"\xec\xedn\xe3\xde{\xefǓ_\xfdc\xdc\xfd\xacg\xe3\xa0Z\xbe\x1a\x80\x99\x05\\\x84\x80!\xa0\xd3\xed\xe2ਁ\x93z\x03\xedn\x1b\x81\x06T|\xf2H*\xc4)\x97yd\xac98\x8a\x1d\xc5h\xf3*o\x16f\xb5\x01&\xc5$\xcaj\xbc\xf9\x92'\xe9\x93++\f0\xd1:I\bt\xbd&z\xbe\x8f\xc0 \xb1u\x14\x9c\x9b\x91\x19ʤx\xde؉%\xdf\xf7\x13byA\xa2F\xc6\x1b߮\xf2\xc2\xe3\xc4\xfb\xfb{\xb8\xf3\xce{\xf0\x85\xaf\xfd\x11\xee\x7f\xee\x03\xa8\x94w\xe1(\xc7:\xc0 e\xbb7Z\x03\x91;t\f.ݳ\x1e\x8e\x1au\xd4N\x1bhu:\xd0A\x00\x11\xc9\xfe\x97TX\xc0\x1b\xfe\xae\xb1ۆ98\x16&\x03c\x8a\r1\f0\xf9\xd6\xfa\f0l'pU[H\xa3\x7f\xc5k\x9f\xc2\xf74\x02\xf8SE\xec|\xffb\xa8\xf1\xe2\xba\x19/q\x82i\fn\x86\xc5\xf0<߇\x01\xa1Z-\xc3uKx\xf2\xa9o\xe1\xc1\x87^\x88\xf2\xee.\x1c\xc7\x02\xc0\\x\xaf\xa6\xf7-cLX\xa0\xeb*\x00\x02ݳ3\x1cՎCpi\xb5\xe1\x9b\x00R*\xa8h;IG#E`4C\v\ag`\n\x14j5\x01&\xe3\xbc\xcav\x02\xf6\xef)\x03\x8c\xb5\x94\x14\x8d\xac\x96\x8c\t\xd0\xeb\xd4\xe1k\x8d@\xeb\xf1\xc2\xdesN%yc^I\xfe\xf8V\xd3\b\by#Y\x1e\x80ptX\x05\x8c\xc1\x13\xff\xe9\x0f\U000525ff\x1c\xe5\x9d\xf3\x00\xc6f\xf6m\xf2\xff\xd4Ơ$%\xa4\x948\xeb\xf5P=9A\xed\xe4\x14\xcdV\x1b\x9e\x0e \x89\xe0(\x05%d\x7f\x91\xa3\xe31\x91\xe9\x85c僆LdE\x01xF-\x1b\xc0\xccL\x92\xac\xc6k\x1d`X\x8d\xf72\xed\x04.\xc89\b\t\x1dt\xd0\xeb\xb6\x10\xf8\x80\xaf\xa7\xf9!\r\xbe\xf7F\x84\xea\xfa\x85\xbdA\xe2\xf7\x82\xf1b\xe0x\xdb)\xb9]e\fptt\x84\xb3\xb3\x1e\x1e\x7f\xea[x\xe5\x0f|?v\xb6\xf7\x86<\x812\xe4Q\xb2\x01L\"\xf3\xb2\xa6\x14\xce\xceΰ\xb5_\xc1\xe1\xf1\x11</\x80R\x04\xa5$֔;\x18\x1b\xb88\x97\x83\xe3\xe2\x99T\x14\bb\x16\xdeN`\xe6\xd72\xc0̋\xcf\x19`l\xa6\xa4r\x00\f4\x84p\xe1u;\xe8v\xdaФF\xb6\x7f\xfc\xa9\xea\xbb\xe3\x19\x16\xbf_\xdb2\x04-\xa3Ǩ\x13\xbfg\x8c\xc1\xc9\xc9\tZ\xcd:>\xf7\xa5o\xe2\xe1\xd7<\x8c\xed\xcd=\xb8J\xcd|\xf3l\x01L\f/\x8e\xab\xd0\xeb\x9ea\xb3RA\xf5\xe8\x18\xbe\xe7\x87\xe0\xb2\xe6\xf6ו\xf1\x18\xc8\xe8\xc2\xc1q\xf1\x90E\x05\xe9(\nl'\xb0\x1a\x00\x93a\x16e;\x81\xa2\xda\t\\\xf0:\r\b\xa1p֪\xc1\xf3{0$FN\x12\x05\x13\xb6\x96\xfc)[M\xc3\xdeI\xbe\x17\f\xb6\x8cF\xe1\xc6\v\x10\x18\x83F\xfd\x14\xb5\xda\x11>\xf3\xf8\xd7\xf0\xc6Gވ\xad\x8d\xbd\xf1SHs\x06\x98$\xbc\x9cu{\xd8\xda\xd9C\xe5\xe0\x00\x9e\xef\xc3u\x1c\x94Jnx$\xda\x18\xe8y\xed\x97rp,[楀\x9fI\xb1\x9d\xc0b\x03L\x1aNa5\xde,\xcf\xddF\x16\xe6\x12\x01&\xca*\x10\x11\xba\xedSx\x9e\x0f\xa8\xd1#\xd0#\x99\x96\xf3ܫ\xfb\xdbJ\u07b8\x15\xc1\x88\x18\x9e6\x06\xedz\x13\x87\xd5*\xfe\xddc\xbf\x8f\x1f\xfd\xf1\x1f\xc5\xc6%\x03\x8c1\x06RD\xf0\xe2\x87\xf0R=:\x80\xd7\xf3\xa1\x94\xc2Z\xa9\x04\xf0\x91h\x0e\x8elK1\x13\xd9\x0e\x14\xc8{`6ہ\x19\x01&\r\xa5\xb0\x1a/\xdb\t\x14\xd6N`\xc6;[D\x80At\xb4\x9a\xa0\xd1i\x1c\xc2\xd7\x00z\xfe\x14\xe3\xc7`\xf2)%o\xfc\xf5\x93\xbc\x93\xbcDv\xc6h\x83V\xab\x85ry\x17\xff\xf6\xb7\x1f\xc7O\xfeݷckso\\\x89wN\x00\xd3ϼ(\x85^\xaf\x87\xad\xbd0\xf3\xd2\xf3}\xb8J\xa1T*\xf53/D\x9cu\xe1\xe0\xc8<,\x16\xcd\x00rn\xa9&V\xe3\xbdh\x1a\xcb؈X\x8dwf\x80ɂ-\v\xa6\x053\x05c\x10\xf8=\xb4\x9auh\r\x04ڛ~\xb2\xe8\\5ށw\xd2\xc0^`\xfc\x18\xb5\x0e4ڝ6vw\xb6\xf0\xf1O~\x06?\xfb\xae\xbf\x8f\xcd\xdbe\b\xa1\xc6?\xabe\x801\x06\x902t\x86\xee\xf9>\xb6\xf6\xf7Q\xa9V\xd1\xf3<8q\xe6%\x82\x17\x0e\x0e\x8e勋\xbd\x93f\xcc\xc20\xc0\xd8Y\xe8\xb3\x1a\xafE6Yr1\xbb\x89\xa15\x84\xe3\xc2\xf7\xea\xe8\xb4O\xa1\x8d\x1a?I4\xc92\xe0\\[\x01oxk)\xb1\r\x15\xe8\x00g\xbd\x1e\xb6\xb76\xf1\xe1\x8f\xff\x06\xde\xfd\xde\x7f\x18\x01\x8c\x98+\xc0\x18c \xa4DI9\xf0|\x1f\xdb\xfb\xfb(W\xab8\xf3<\xb8\x11\xbc\xf43/<\xcesp\xac&\xc40\xc0XH\x9c\xb0\x1ao>\x80Iw\x05\xab\r0\x00\xb4\x00\x94RhՎq\xd6큤H\x14\xe0\x8eo\x1dM\xf7G:\xaf^&>\x8a\xad\xe1y>6o\xdd¿\xfcȯ\xe2\x17~\xe9\x17\xb1\xb9Y\x9d+\xc0\x84\xf0B(\xa9\xb5\x01\xbc\x1c\x1c\xe0\xacۅ㺜y\xe1\xe0`\x88a\x80I5\x89\xb2\x1a\xaf\xd5\xe4ɬ+q\x06\x98iM\xdf@)\x85f\xfd\b\xbd\xde\x19d\xc9\x19\xabc\x19\xf6:\x1a\xf7N\x1a\x88\xd6\x05\xc3G\xa8\x83\xe1\f\x8c\xef\xfb\xb8}\xf3\x06\x1e\xfd\xc0\x87\xf1\xcf\x7f\xf9\xfd\xd8٪\x02Z\x87Ɠ\x96\x01\x06ƀ\x84\x80\xeb\xba\xf0\xfd\x00\xbb\x95\n\xf6*\x15t\xbb]8\x8e\x83\xb5\xb55\x18\xc3Ǥ98\x18b\xd8N\x80\xc5\xecr\x02L\x9a\x9b\xc7v\x02\x17\x03L\xaa\xfbk\bd4\x9a\xb5\n\x82\x00\xc0\x14-\x98\x8b\xb6\x96\x82\xe4ɣ\xb8\xd0\xd7\v\xfa\x19\x9d иu\xe3\x19\xbc\xf7\xd1\x0f\xe0\x03\x1f\xfa\x10vw\x0e\xa0\xb5\x86\xb4\x010\xc9̒1PB\xc0q]\xe8 \xc0^\xa5\x8a\xbd\xfd\n\xbag\x9d0\xf3\xb2\xb66\x92ya|\xe1\xe0Xi\x88a;\x01\x06\x18\xcb\xc9\x13\x06\x98\x9c\x99*J\xf1\")B\xbb\x81\xfa\xc91\x02\x00\xa67M\xd4\xce?\xa7\xb8\xf7\x9c\xfa\x98 \x80\xd6\x067\x9f\xf9\x0e\xde\xfd\xde_\u0087>\xfa1\xec\xed\x1e\"\xf0\x83s\x00&}\x032\xc6@\b\x81k\xae\x8b \b\xb0_\xa9b\xb7ZE\xa7݆\x922̼\xb0@\x1d\a\aC\xccr\x01\f\xab\xf1\xce\x03`X\x8d\u05fe\x9d\xc0,\xcf=-\xc0\x104\x84,\xa1\xd7i\xa2Q?\x86\xd6\x04_{\x93ݫ\xbd`\x04l\xc6\xc5\xf0\xe2\xad'/q\xbc:\xd0\x1a\xb7n|\a?\xf3\xce\x7f\x8c\x8f~\xe2\xd7Q)\x1f\xc3\xf7\xfd\x9930\xe7g\xa0\"x!\x82\xeb\x96`\x82\x00{\xd5*v\xcb\x15t:\x1d8\x8e\x1cμ\xf0Qi\x0e\x0e\x86\x98b\x01L\x9e`\x80ɷ\xdeg\x80\xc9\x060\xe9S(V\xeb`\x12\x8fFk\xc0q]\xb4\xeae\xb4\x9a-\x90Z\x1f\x11\xb4\x9b\xa4Λ,\xfa\rF^\x1f\x02P\x10\x1d\xaf\xd6\xc6\xe0\xf63\xcf\xe0'\xde\xf1s\xf8\xd5O\xfd\x0e\x0e\x0eN\xe0\xf5zV\x00\xc6\xc0@\x80\xe0\x96J\b\xb4F\xb9z\x80\xddr\x19\xedv\x1bJ\xaa\xa8\xe6\xc5p慃\xa3@Q\x00\xeb\xa4\xc8v\xe02\x00fV\x10ɔ\x85a;\x81\xd4s+\xdb\tX\xbb\x9bWV\xc8;\xe1\xd1H\xa5P?.\xa3{\xd6CI\x94\xc6E\xed&l%y\x13\x15z\x87\xb33\x06\xc0ƍ\x1bx\xcb\xdb\xfe\x0e~\xfdӿ\x87\x93\xe3S\xf4\xba\x1dH)s\x01L?\xf3R*\xc1h\x8d\xf2\xc1\x01v\xf7+h\xb7[\xa1ac\\\xb0\xab\r\x93\v\aG\x81\xa2(\xddQ\xb1\x9d\xc0\x82\x03\f\xab\xf1\xce\x05`\n\x7f\x12i\xecфR\x9aR\x12N\x8e*\xf0\xfd\x00\xb2\xbf\x8d4A\xc4n\xc2VҘWRt4\x1b\x00nݺ\x81\u05ff\xf9-\xf8\xcd\xdf\xfd2\xea\xa7\r\xb4;amJ\xd6\x01o\b^\x8cF\xe5\xe0\x10;\xfb\xfbh\xb6\xdap\x94\x1c˼0\xc1pp\x14\v`\fD!\xfa\xa5\x9a\x05`\xd2P\n\xab\xf1\xda)\xe6d\x80\xb1\a0\x99W\x18\v\x050\x00 `\xb4\xc1\xf1\xe1\x1e\xb4\xa1\xc96\x03\xded\xd1:o,#\xe3E\x19\x18\xc2\xc6\xed\x9b\xf8\xe1\x87_\x87\xdf\xfe\x0fO\xa1\xd3n\xa1\xddn\x8eg`0\xdbI\xa4X\xf6\xbf\x14\xc1K\xf5\xf8\x10;\xbbe4ZM()\xb1\xbeV\n\xfb4\xeb\xbcpp\x14\x12^\x86\\\a\xe8\xea\xfbi:\xef$\xb0\x1aoV\x80\xb9\xf8\x03\xb2\x1a/\xd8N \a\xc0h\b\xe5B\xf7\x9a\xa8\xd5\x0e`@\xe8MTߝ\"b7A\f\x8f \xb0\xb9q\x03\xafx\xd5\x0f\xe23O~\r\xbe\xef\xa3\xd9<\x85\x94\xe9\xed\x04\x92\xf0\x02cppx\x88\xed\xf2>\xea\x8d\x16\x94\x92X\x1f:*M\x9cw\xe1\xe0(:\xce\x14\xa4\x93*V\xe3\xcd\x060\x99W\xf7\f0\x16\x80oE\xc5\xec\xa6\x02LX\xd4\xeb:\x0eڭ2\xea''\xd0T\x82\xee\xf5&\xfb!\x8d\xfd<\xbc\x95\xe4\xf9>\x8c!lo\xdd\xc6\xf7\xbc\xec\x15x\xec\v\x7f\x00\"\x81\xfa\xc9qj\x80\x89O\x11\xc5\xf0rx|\x8cͽ=4\x9aM(\x11\xc1\vXa\x97\x83c\xe1R2\xc5r\xb1f\x80Aj\xed\x0eV\xe3\xcd\x050鮀\x01\xe6\x1c\x80\x89\xfb\x85r\x1c\x1c\xd5\xcah\xb6\xbap\xaf\xb9\xf0\x92n\xd4\xde\xc5:1q\xb6\x86\x88\xb0\xbb\xb3\x89\xe7\x7f\xf7\x8b\xf0\xd8\x17\xbf\x0e\xd7]\xc3\xf1\xf1!\x94\x9a\x1d``\f\f0\x04/\xdb{\xfb8m4 \xa5\xc0z\xa9\xd4\xcf\xd0\x14hQ\xc7\xc1\xc115\xf4`\xfc*ТC1\xc0\xa4'\x1a\x06\x18\v\x19\x15V\xe3͕\x8c\x9a\xd45\x94R8\xaa\xec\xa1\xe7{P\x89\x8c\xcb\xd8\xe9\xa3d\xa1\xaf7\x10\xb1\xf3<\x1f\x00a\x7fw\x1b\xf7\xde\xf7\x00>\xf7\xc5o\xe0\xae;\xef\xc6\xe1auf\x80\xd1&,\xf7\x8b\xe1\xe5\xb8V\xc3\xc6\xee.N\x1b\r(!Co#\xc2p\xc1\xae\xe1\xba]\x0e\x8e\x85\xc9\xc0\x14,Բ\x02L\xaeg\xc3\x00\x93\xfbN\xb2\x1a\xef\xc5\x00\x93\xb6q\x9e\xdbb\x88 ȠZل\xef\xd3\x05'\x8f\xfc\xb1\xa3\xd71\xc0\x94\xcb{\xb8\xf3\xeeg\xe1\xb1/}\x1d\xdfu\xdfspP)\xcf\x0401\x94\xac\x97J\xa0>\xbc\xec\xe3\xa4q\n\x12\xc0Z\xa9\x04\x02\xb1\xce\v\a\xc7\x02\x861\x80\xd6\x06Z/\x00\xc4\x14\x16`\xb2\x8c\xfb\xac\xc6k\x15\xaaY\x8d7\xe7s\xcf\xf2\xdaY\x00\x06\x00\x84\x82\xf6\xbb8\xa8\x96\x01\bx\xbew\xae\x16\xcc\xe8\x89%\x008\xa8VP*\xad\xe3\xb3_\xf8:\x9e\xf7\xfc\x87P)\xefB)\xe7\\\x80\x89\xa1\xa4T*\x81\x88P\xabհ\xb1\xb7\x87\xda\xc9)\x14\xd0ϼ\xc4\xdbK\x1c\x1c\x1c\xc5\t\xad\x01\x90\x1e\x1bcHP\x7f\xaa\"\"\b!\xa0T\xf8\x9f.\x18ɨ\xe2\x03\xccP\"\x86\x01\xc6\x12\xc0\x9c3K2\xc0d\x04\x18\xcc\xf8ܭ\x03\x8c\xd6Pn\tg\x9d\x1a\x8e\x0e\x0fB\x88\xe9yc\xa7\x8e\xbcI\x19\x98H\xc8\xee\xf0\xf0\x00D\x84\xdf\xfd\xfc\x7f\xc1\x8b\xbf\xe7\xa5(G\x00s\xde\xcal\x00/@\xadv\x82ͽ=\x1c\xd7j \xa2A\xe6%긜y\xe1\xe0\xb8:@\xe9\x8f\x1e\x86@\x92`\x00H\"H\t\b\xe1\x80$A\x12\x85\xc0\"\t\x92\x04(\xfa\x99(\x1c\x84\x89\b\x80.\\6F]\x0e\xc0\xe4Jd1\xc0X\x99E'\xbd\x03\x03\x8c\u0378\xdcB\xde\xc4`\x05\x835\xd7\xc5\xc9n\x05\xa7\xa7M\xa8\xd2]QQo0l\xe48\xe2\x9d\xe4\xfb>\x8c1\xa8\x1d\x1f\xc3\xeby\xf8\xec\x17\xbe\x8e\xef{\xc5+\xb1\xb7\xb3\a\xe98\x13\x1f\xb31\x1a0\"\x84\x17\x00\xb5\x93\x13l\xec\xed\xa3v|\f!(\xf46\xeaC\x0e\xd3\v\a\xc7|\x00E\x8f\f+\x14fM\xfa_\x05\xa4\"\x90P\x90$ \xa4\x80\x10\x04\"\t)c8\x89\xc1d\xf0u\xf0n\x1aZST\xb2f\xa6j7\x15!\xbb\xaa\xc0v\x02\x85\x05\x98L\x93(\xab\xf1Z\xba\xadE>\x894\x1e\x8e\xe3⨺\x85v\xe7\fw8\xc1\x85\xb6\x02!\xc0\x00\xf5\x93\x1aZ\xad\x16~\xe7\xf1\xaf\xe0\a~\xf8\x87\xb0\xb7\xbd\a騱\xbf\x1ao\x06\x95\x9c\x12H\n\xd4N\xeb\xd8\xdc\xd9\xc1\xd1\xc9\t\xc8\x00\xa5\xb5\xb5\xc1\x91jVz\xe1\xe0\xc8<S\x1a\xad\x13c\x00!\x92N\x82\x14\x04D\xf0\xa1\x1c\x05)\xa2\x8c\x89\f\xb7{\xa4\x90\x11\xa8$\xffK\xf4F\x1a,{\xe2lJ\xdc_\x93\x802\xcb\xe9i*\xa4\xed\x00\xd8N\xa00\x00\x93f\xb2g\x80\xc9懴\xb0G\xa9'\xff\t\xa5\x80\xf2\xcemx>&x%\x8d[\fhcP\xaf\x9f\xe2\xe4\xf4\x14\xbf\xf5\xd9\xdf\xc7k^\xffz\xecl\xefA\xaaa\x80\xe9\u05fc\xb8\x0e\x84\x10\xa8՛\xd8\xdc\xd9\xc1\xe1q\rD&\xacyI\x1c\x95\xe6\xe0\xe08'{\x92\x18\xafL,xK&\x02\x0e\tA\x04\xe5(\bA\x90RB\x880\x8b\"I\x80\x04A\x90\x00\x89p+\x88\x04M8\xe9l\x86\x00%\xec\x96\x06fF]\x17\xca2\b\x17!\x133\v\xa5\xb0\x1a/\xdb\t\xb0\x9d@1\x01\x86H\xc2\x04=\xec\xedmEv\x03\x1e<oX\x8d7)jg\xb4A\xb3\xd1\xc0\xd1\xe1\x01~\xedӟǏ\xbc\xe5ob{k\x0fR\x0e\x00\xa6\x0f/\x8e\x03\xa1\x04j\xf5\x06\xb6vvqpT\x03`\xfa\xdbI\xac\xb0\xcb\xc1\x11\x02\xca`\xec\xa2\xfev*\t\x8a\xb6x\x04\xa4\x92\x90\"\xdc\xd6\x11RB\t1\x00\x958\xab\"\xd0ϢL\x92b\xd1&\x04!m\f\x10\x98\vG\xa44\xeb\xbbY\x01\xa6h}]\x9d\x9f\x85a\x80\xc9\xfd\xe4X\x8d7uV\t\xa9\x9f\xfd*\x88\xd9M\xfb\x13\x1aB\xad\xc1?\xabc\x7f\xbf\f!$<ϛ\xa0\xc6\x1bn#im\xd0l\xb5Q\xad\x94\xf1\xf1\xdfx\f?\xf6\xb7\xdf6\x040}\x91:ǁP\n\xa7\xf5:6vvP=:\x02\x01(\xb9%\x80\x883/\x1c+\a(&\x91=\xa1\x04\xa0\b\"(\xa9 \x14AF`2\xf4U\x10H\n\x10\x04(ʺ`H/.\x1cw\xb4֡c\xbb1\xd3g\xca\x14\x83\xee*\x00\f\xc6\r \x19`2\xaf\xee\x19`R\x02L\x9a\xebg\x80\x99\xf6'\xb4\x06J%\x17\xcd\xda&\x8e\x8ej\x00\xae\xa1כ\xbc\x95\x14\x04\x06\xddn\x1b\xe5\xbdm|\xe4\x13\xff\x1e?\xf53?\x8d\xed\xcd2\xa4P\xfd\x11\xd5q\x1c(\xa5P\xafױ\xb1\xbb\x8b\xca\xc1!4\x80\xf5\x92\x1b\xad\x0e\xf9\xb4\x11ǲ\xc0I\xb8\b \"D߆\xde\xcc\x04\x90\x18\x9c\xceQJA\xcap[GI\t)$T\x94A\x91QV%\x04\x1a\xf4\x01\x9f&\x80\x90\x81\x9eP\xf0\xde?×s|\\M\x80\xc1\xb0wҊ\x00̅\xef\xcbj\xbc\xb9\x00&+\xfe1\xc0d\x1b*\x8c\x81[Z\xc3^y\v\xa7\xf5\x16\xd6\xefX\x9fXī\xb5\xc6\xd9Y\x17;[[\xf8\xe0G?\x89\x9f{\xd7\xcfc{\xb3\x1c\xbd\x85\x81\xeb8PJ\xa2^o`cg\x17\x95\xa3Ch\x03\xac\xb9\txai]\x8eEʞ\x80\xa0)y\xca\xd8D\x9a'\xd4\xff*\x85\x82Ra\r\x8aR*\xdc\xe2Q\x11\xa0\xc8\xe1BY\x81\xf0\x882`\x90xKhh\x18m2$L\xc8\xd2\x02\xef\x8a\x00\xa60\x06\x90K\x0e0in<\x03\x8c\x85F\xcaj\xbcs\xedߣ\xa2\xbf\x06\x80#\x05\xf6wn\xa2\xebi\x94\xf4\xb0\x88\x9d\xef\xfb\xf0u\x80\xdeY\x0f[\x9b\xb7\xf1\xfe\x0f~\f\xef\xf9\x85\x7f\x82\xad\x8d\x10`\\ׅ#%\x1a\xad&n\xef\xec\xa1R\xad\"0\x06\xa5\x92\x03E\x02\xdap\xd1.G\xf1\x00\x85\"@A\\,\x8b\xe8䎠H\xffD@\n\x05\xa9\x04\xa4\x10\t@\t\xffM\b\tE\xf1i\x9f\xe8\x14\x0f\x99D\x86&\x91A\x892+z\xc6\x01ne\x00\xa6X\x06\x90\x8b\x030\xb9\xe6T\x06\x98\xdcw\x92\xd5x-\x02L\x8em\xa4A\bH\x11`w\xeb\x16\xbc\x80\xc620\xbe\xaf\xe1y>6o\xdf\xc4/\xbe\xff\xc3\xf8\xa7\x8f>\x8a\xad\xcd\n\x94R(\xb9\x0e\x1a\xad6\xfe\xef\xf6\x16\xf6\xab\x870Ơ\xe48p\x85\x80\x81\x81f\x89]\x8e+ɠ`\xc4\xd9<*\x8e\x95\xe1W%%\xa4TPJ@\b\x01G\x85\xd9\x14)\x04\x94\x10\x10j\xfc\xa8q\xff=ut\x8c9\xfe[\xf1ߘi\x12g\x80)R\x06f\x02\xc4,\x06\xc0\xb0\x1a\xaf\x15\xdcH\xd9P\x19`\xe6q\x02-?\xc0h\x90t\x11\xf4\xea\xd8\xda\xda\x06\x91\x1cք\xd1\x01\x82\xc0\xc7ƭ\x1b\xf8G\xef\xfbe<\xfa\xfe\x0f\xa0\xb2\x7f\x8ckk%4\xdb\x1d<s{\x03\xbb\x95\nt\xa0QZs\xa0HB\x1bÙ\x17\x0e\xeb\x11\xeb\x9f\x18\x84\x82\x89\xc9S<$B\x95XAa\xc6D\xaa\xb0PV\n\x05G\x85\x90\"\xa4\x84R\xe1\x89\x1e\"\x11eN\x06\x80b\xa0A\x11\xa0\x80\x06\x19\x94i}k\xf6\xb9\x81\x01\xa6衮\x0e`2\x8e\xfb\f09RQ\f0Y\x00&\r]̻\x90w\x84a\xa0J.:\xcd}\xec\xedUAB\xc2\xef\xf5\"5^\x8d иu\xe3\x19\xbc\xf3=\xefï|\xe4#8>h\xa0\xdb\xe9`s\x7f\x1f{\xe5\n\x02\xad\xc3SH\x8e\x13e^8\xf5\u0091-{\xd2\xdfn1\xba߿\x8c1 !!\x04 H\xc2q\u00ad\x1c%\x15\x1c! UXD\xaedX\x87\xa2D(\xdcF$ L\b*&j\xfb1\x00\x85Bmzl\x82\x19\xea\xf7\xe6\xfc\x1e\xc9\x00\x83\xecZ\x16\xc5t\xb1\xb6\b0iٜ\xed\x04\xac\xb7\x10V\xe3]\x1e;\x01\\`|\xada\xb0\xee\xae\xe1pg\x03\x87\xb5\x06\xc4\xda\xdd\xe8\xf9>\xfc ,\xe4\xbdu\xe3i\xfc\xec\xbbދ\x8f\xfdڿ\xc1\xcd[\x87\xb8y\xfb6\xca\a\x15x:@\xc9)\xa1\xe48a\xe6\x85\vvW>\b\x80\xc0`\xab%\t(&\xcet\x98ر\x1c 2\x10BD\x02m\x12\x8e\n\xc5\xd9T\xb4\xb5\xa3d\x04-BA:\x02J\n\bH\b\x89\x11\r\x14\x03\xa3\xa3\xd39ZC\aa\xcb\x0e\xa0g6}\x9f\xde\xef\x19`\xac\x8ey\x13\xfeQ\x14`\xdcP\xb3\x02\xcc\xcc9CV\xe3\xcd\xd1@g\xbd~\x06\x98\xec\xf8\xb7\x98G\xa9'\xf6\"c\xe0\xb8\n坛\xa87\xbb\xb8ǽ\v\xbe\x1fֲlݾ\x85\xbf\xf7\x0fރ\x7f\xf5\xb1O\xe2O\xfe\xfb_\xe2\xf6\xc6\x16\xb41(\xb9\x0e\xd6\x1d\aƀ3/+\x04(\x88 E\x8f\x00J\x94\xdbH\xa4<\f\x10\xb9\x16\v\"\b!\xe1(\x01)\x15\x1c%\xa1\x9c\xe8\xab\faőrȗgp\x92-~;ݯ?\xd1\b\xa0\xfd\x19?3\x03\xccl\x00\x93\tL\xec\xd4\xc1\x14E\xe2R\xcd\xfaBV\xe3\xb5\x000\x19\xa6a\x06\x98ն\x138?\x04J\nؼ\xf9\x1dxZ\x020贛(\xefn\xe3\xcdo};\xde\xf9\xcf>\x80\xff\xfc\xcd?D\xb3\xd1\xc4\xfa\xfa\x1a\xa4\x10\x91\x149g^\x96&{\x92`\x8fA\x86npĦ\xef\x8d\x03\x80\x8c\x81!\xea\xab\xc6J\x19ՠH\tG9p\x9d\bL\x9c\bR\x84\f\x8bd\xe3\x02YA}1D\xc4\x1e?\x00\x8c6\b\x02\x03\xc0ϳ\xba\x18\x8c;\f0\x96ƻ\xf9\x02LQ\x96@\x8a\xd5x\xd9N\x80\xed\x04\x16\x11`4@\x0e\u0df0\xb1\xb1\x81V\xab\x8d\xa0\xd7µ;\xee\xc1O\xbd\xeb}x\xdd[ߎo\xff\x9f\xff\r\xa3\x03\xdcy\xc7\xf5\xfe\x86\x11\x81\x00a` \"\xdd\v\x03\x88\xe8\x8b\x19\xdfNบ\x10\xa2\xffh\"-\x92\x91\xec\x89\x0e\x8f\x04\x1b\fT\x96Uh\xaa\x03I\"\x02\x13\x05\xe9(\xb8J\xc1Q\n\x8e\x13fN\xa4\xa3\xa0\x94\x03\x19\t\xbaIA\xa1\x1amB\xa4-\xaeA\xd1Z#\x18i\xb6:\xca\xeaP\x8aN4k.ue\x01&%\xad\\\xe5I\xa4\xa2\x99\x8c(\x06\x98\xacm\x8a\xd5x\xd3\x00L\xea\xee\xcaj\xbc\x172\x8crK\xf0:G\xb8q\xf36\x1ex\xf0E\xf8\xa1\u05fe\x01\xdf\xfb\xaa\x87q\xfd\xae\xbbQ\xadV \x8c\x06\t\x82\xe7\a\xfd\xbfL\x88f\xa0\x18b@\xd1ϑ\x98W\xe2\xdf\x04ѐ\x03n\xb2u\b\x00&Z\x99CH\xc8T\xcfW@ĳ3D\xf8\x16b0N\x18#\x12?\x87\x0e\xda\xc2\b\xc4\x1f\xd0\x00\x10\x89_2\xf1%E\xff\x16\xbe\x1e}\xef\xedI\xb5\x1eW\x99>\x11\x82\xa2\xb1P@\x18=\x04(\x04\x82\xd6\x06&0\x83\xec\tBa6!(\xd4:\x11\x12*RVv\x95\x84\xe38!\xa0(\a*ʮ\b! \xa3m\xa1$\xe8\x80\x06\x06\x811\x10\x05\xc1$0\x99\xde)\x18`\xf2gF\xd2ea\x18`f\x87\x98U\x03\x98\xacm\x91\x01&u\x7fe5^\x8b\x00\x13\xad\x86ם5\xd4*\xb7\xd1\xe8\x00\xf7=\xf8\x10^\xfcҗ\xe3e/y\tNjG\xb8\xeb\x81\xfb\xc3>\x17OTFGZ^&R\x18\x8d\xbaeTث\xe3\x8el\u009fLxN5\xac\x9d\xe9O\x82\xe1\x0f\x86\"<0\x83\x82L_\xeb\xfeކ\xd1\x1aD\x02\x03\x8cH|\xe8\xe8\xc4\t\xf5\a\x84\b\x8aF\xdab\xff^P\fM\x14\xfd^\xb2\x9d\xd0\xc8S\x8e\xc1\x86\x06\xefG\t8\xa3\x10\xba\x84\x10\xfdߥ\xfeی<\xa5\x90\xd2\x00A\xa9\x00mړ\xd6ZC\x1b\x02\x19\x1d\x8a\xb4\xc5pb\x00!\t\x14m\xf1\xb8ҁr\xc2\xed\x1d\xe5H\xb8\xcaA\xc9q \x95\x82#\x9d\xe8\x14O\b'\x94\xd8K2ѽ\xd6\xda\f\xfe\x1e\x00\n\x82\xf0\xab\x06\x8c\xd0\x104\x19Uf\x05\x180\xc0\xcc\xdc\x02\x96M\v\xa6\xa86\xafj\x15\x00&\xed\xcc\xca\x00c/\xed\xc9v\x02y\x97hS\xae\xcb\x18\xb8%\a\xfb\a;\xf8\xf3\xff\xf9m\xfc\x95\x9e\x83\xd7=\xfc!|\xd7}\xf7û\xff\xbe\xa8~a0A\xf7\x8f\xc0\x92\x01\x19 V\xea\xe8\x17ajݿN\x93\xfc>\xca\x13\x18\f\xbce\"\x1bݘy\xa2\x13N\xe1\xf1\xda\xf0\xa4I\xf8[\u0084E\xc6!\xf0D\xa7\\t,\xa2g\xa2\xfa\x9c\xa8\xb04zom\xe2\xf1B#\xd4\"\x1b\x88\x9e\x85\x85ȑ\xc4{\x7f\x8b%\xfa\x9d\xd8</1\xe6\xe8\xe4أu\xb4e2(m\x8dOf\xd1\xe0dp\x04}\xf1\xef\x19@\x9b\xc1\xf5\xc5t\x13\xbfG\xdcVMr\xb4\xd3\xc9\\\x15Dt\xc4XJ\x85\x92\x92\xfd\x8c\x89\xeb8p\\\a\xaeTa\xb1l\xb4\xd5C\x89\xa2\xdaA\x06\x85\xfa\x80b\x00\x04Z#0\x1a\b\xe2m\xa5\x04\xbfMh\xa7Q\xe2-\x02˔\xe7\"W\x15`r\r}\f0\x97\x0f1\xcb`'\x90v*c\x80\xc9\xcb&\f0\x16S\xcaD\xe9Z\xb36\x84\xb5\x12\xf0g\x7f\xfaM<\xf7\x05/\xc6\x13_\xfd\x13\x94\xd6J8:<\b3\r\x93>F\xbc\xaf\"\xc4\xd0\xd1H\xba\xe8sQ\x94\xe7\xa0đ\xca\xc8\xc1wh%\x14\xbd\x0eb\xf8\xee\xd3\xd0\xfbMi#$.\xbe\x8d\x94x\xdfi\xed1Q?\"\x12\xaa\xc3\x14\x1dI\xef\x7foFF\xb0~\x01l\xb4\x01\x15A\x0f(\x84&\x1d\xeb\x9e\xf4\xa1(\xceX\x85\xbf\xab\x13\x19\x91\x10\xd0\x002\x04ǉ\x8f\x1a\x87\xa7xb\xd7\xe3>\xa0P\x84>\xd1\a\r\xb4F\x10eQB\xb0\x14\U0004d7d8-\xe9\x83\v]|\xef\x18`\xf2-pWU\x8d\x97\n~\b@\xb1\x9d\xc0\xf2\x02L\x9a;\xc9j\xbc\x96\x00&\xc3*/-\xc0\x00\x00I\x89^\xa7\x01\xbd\xf6<\xfc\xd6\xef}\x05\u05ee_\xc7Ae\x1fJ\xa9\xf3\xc7\xfa4\xd7\x14'\x19\xfa\x99\x96\x01\bL\xfbmM\x80\xd0\x18d~\xe2\xbd\f\xd0\xf0\x11\x9a\xfe$,\x86\x8e\xe4\x9e\x171\x83\r\xbe\x19\xfd\xbf\x88\xb2\x1fb\x96\xb9n\xf8\xb6\xf4\xdbl\xb4yD\"\xe2*\t\b@&k\x8a\x12\xf7q\xe8\x19\x8b\xc1{Q\"\xd3\x15g\x97\xfc\xa86)\xfeY\b\xc0P\xbf\xc4z\xfcj\xa2\xad\xb1\xb1\xee\x9ev\xe1\xb2B\x00c\xa5\x7f2\xc0,\f\xc0`\xb4&\x86\xed\x04\x96\v`X\x8d7\xe7s\xcf12\xce\x13`@a\rI\xa3y\x86\xb7\xbf\xfbW\xa0$\xe1\xe8\xa0\x02y\x11\xc0 \x1d\xc0\\0\xacN|\x81\x1c\x9b\x88\x13\x801\xe1b\x87\xbdm.\xfe8D\x17\r\x02\x94\x1a`\xfac\x9a\xa1\x81?1\x8d\x88\xaa\xcc\xd0V\xb4\x06\x044H\vh\"\xc0\xe8~Vl\bP\x88b\xbf\xc2\v&\tJ\xd9\xf6W\t`\xf2\xce\r\f0Vƺ\x020\x8e\xb2\x0f0\x19\xc7}\x06\x98\xf9e\xaa\x18`2\xdc\xc0\"\x15\xf2\xd2\xc4\xeb7F\xc3\xefu\xe0\x19\r\x9a\xb6\x85t\x89\x00\x93\xfe\x16S\xba\xb6<\xc3å\xcc}\x9e\xf2]\x1e\xc5\xdb=a1\xb0\xc0\xe4-\xb2ٟ=\x03L\xda\x11\x8e\x01\x06V\xed\x04袛X\x10-\x06\x01\xb6\x13\xb82\x80I\xdf\xc6\xd8N 5\xc0\xa4\xbdO\v\x020\xc3\xefuY\x00\x93\xf1~.\n\xc0Xɾ\xcdОW\x12`r̫l'p\x89\x8b\xdbtWU\x04\x8e\x11i>)\xab\xf1fm\xa0\xb3^?\x03L6\x80I?\xb3.\v\xc0\\<\xce\xe7\xab\xeb\x99u\xa4_\x14\x80\xc9xy\f0\xb9\xef/\xab\xf1\xce\v`.\xbd\x0e\x86\xd2\x02Ĝ!\x86\xd5x-\x00L\x86Y\x94\x01\xc6\xf2\x91c\x06\x98\xfc\xe4\x97q\x1b\xa9p\x00\x93\xaa\x8f\xe4\xd8FZU\x80I\xcd3\f0sW+\xbf\x02\x80)Jɯ`\x80\xb1Ӓ\xd8N`\x8e\x1dt\xe5\xc4\xec(\x7f\x06*5\xb8/\n\xc0\xd8̾1\xc0使\f0v{e\xa1O\"\x15\x10`F \x86\xed\x042ea\x18`\xd2uWV\xe3͖Uʙ\x85Y\x14\x80\xc94\xe83\xc0d\xcfn\xb2\x1aoj\x80\xb1\xb3\xc0e\x80\xb1\f1l'\xc0\x003\xdfF\xca\x00c;u\xb28\x00c/\xfb\xc6\x00\x83\\zE\f0\x99\x9f{\x96\xd72\xc0\\J(\xb6\x13Xq\x80ɱdc;\x01̯\xb6gI\x00&M\x06\x86\x01&\xcfx\xc7j\xbc\xa9\xae'#Y3\xc0\x14/D\xd1\x00&\xe3\x90\xcf\x00\x93\xf5\x1e\xb3\x1aoj\x80I\xd5F\x8bz\x12\x89\x01\xe6\x8a\x01\xc6Rr\x8e\x01&\xf7\x02\x97\xd5x\xed\x8dwW\v1\xf3\x04\x98\xacm\x8c\xd5xs\xdfSV\xe3\xb5\x040\x19Vy\f0\xab\t0\x99[\x11\xab\xf1\xce\x060Y\xfb'\x03\f2\\Q\xe1yF\xcc\x13`Ғ\t\x03\x8c\xad\x15\x06\xab\xf1.\xaa\x9d\xc02\x00L\xa6f\xbbD\x00\xc3j\xbc\xb0\xd4\xe7\xd3Ϩ\f0\xd6\x19\xfb\xa2\x91\xae\x00\x10\xc3v\x02l'\xb0d\x00\x93f\x86Y)1\xbbK\x02\x98\xa5\xb2\x13\xc8\u05fc\x18`,\x8cpl'\x80˳\x13X0\x80A:\xc1=V\xe3\x9d\xc7Cd5^\xb6\x13\xb8z\x80\xb19\x89/\x06\xc0\xa4Ͼ\xb1\x9d\x80\xad\x89\x8c\xd5x\xafbq\xbb\x9c\x00\x83\xe9\xdeI\f0y\x00\x98\xd5x\xd9N\xa0P\x00\x93m\xb9\x96\xfa}\vk'\x90e\bf\x80I\xf9\xec\x19`l\x03LqO\"%:G\x01XF0\xc0\xd8\x1d\x06\x19`\xd8N\xe0\xb2\x01&mSe;\x01\x06\x18\x9b(\xc8\x003\xcf\xccJq\x01\xa6\xa0\xb6\x03\xab\x010\xc8\xddH\x19`\xactP\xb6\x13Ȟ)H=8\x17\x1d`lf\xdf\x18`\xf2\xd6O\xb0\x9d\x80\xddE\xeerh\xc1\x14\x0f`.\x80\x98%\xb3\x13\xc80\x911\xc0\xd8\xcf\xc20\xc0\xe4\x9d`\xd8N\x80\x01&\xe7ʞ\x01&?\xc0\xac\x9c\x98]1\x01\xe6\x1c\x88a;\x01\xb6\x13`\x80\x99\x9b\x9d@ڶ\xccZ0\xab\t0Y\xc7<\xb6\x13\x98\xeb\x98\xc7\x00S\xacPE\x04\x98\xb4\xbd\x96\x01&ψ\xc8bvY\x00&m\xc3]U1;Jװ\xd9N`\xc6\xc1\x85\x01\xc6\xc2\n\x8d\xd5xg\a\x98\x02\x87\xb8,\x80\xc98\xe43\xc0X\xbaǬƛ\x06`f\x99\xc4\x19`\x18`\xe6\x9d}c\x80\xb1zO\x19`2<\xf7\xf4s\xc3\x15BL^\x80\xc9;\xa01\xc0\xb0\x9d\xc0\x15\x00L\x86'\xc1\x00c\xe3v.+\xc0\xb0\x98\x1d\xdb\t,\x8e\x9d\xc0\xac\x1d\xa4\xf8\xb6\x03Y\xed\x04fl6\f0l'p\xd9\x00\x93\xa1\x9f2\xc0\xcc#\xb3\xc0~H\f0\x16\xd6\x0e\f09\xe8/ӳ_\f\x80A:\xc5\xde\xf3\x1e\x14\x03\x8c\xbd\xe1\x8f\x01\xe62O\xa01\xc0\xd8\xcf°\x9d\xc0j\x02L\xda\x1e\xc9v\x02v)aU\ny'@\f\xab\xf1\xa6\x02\x98T]\x96\xd5xst\xb9\xccY\x18\x06\x18\xfb\xd39\xdb\t\xac&\xc0\xd8ɾ1\xc0\xe4\x9bG0õ\xaf&\xc0 6\x80d\x80\xb1\xbb\xc4g\x80a;\x81b\xd8\t\xccr\xed\x8b\x0609\xbb'\x03\f\xdb\t\\!\xc0\xa4\x19\x84\x19`f\x84\x18\xb6\x13H\x91\x85a\x80\xb16\x93\xb2\x1a\xaf\x05\xf2[\x01;\x814\x17\xc1\x00\xc3v\x02\x97\r0\xd9g\x17\x06\x18{\x99\x98%\x02\x98\xfc\x00\xcc\x00ce\x15\xccj\xbcV\xee\xe9r\xd8\tP\xe6\ve;\x01Ks(\xab\xf1η\x80\x9d\xc5\xec\x8a\r1\x85\x06\x98,\xafe\x80\x99K/e\x80YM;\x81\xb4\x00\xc3j\xbc\x19f\xbft\x9f\x84\x01\x06l'\xb0*\x00s!\xc4\x14\xc1N`\xd6ц\x01\xc6ƒmҵ3\xc0X]\xb51\xc00\xc0d]\x8c\xb1\x1a\xaf\xa5э\x01fY\x00\xe6\\\x88a;\x81E\xb7\x13H\xd3A\x19`\xb2\xdeԕW\xe3e\x80ɳB`\x80\xc9\x000i> \xab\xf1ڞH\x16\x04b\xd8N\xa0\xa0\x00\x93\xee\n\x18`2\x00\f\xab\xf1\xc2\xd2s_^\x80ɟ}c\x80\x99K\xa6\x8a\x01&\xfd=\xa5\xc5\xc7\x19\x91u\x02O\xdfP\x19`,P \x03\xcc<Uf\x19`\xac7\xd2e\xf4Cb5\xde\f\x997K\x03\x1c\x03\x8c\xc5>Jˑ\x8f\x11\xa9\x1b\x16\x03\f\xdb\t\\!\xc0d\xe8\xa73\xb5\x18\xb6\x13\xb01\x81\xb3\x9d\xc0\xea\x01L\xbe\xd4\t\xdb\tXZ\xf1\xaeX\x1d\xccT\x88a;\x819\x0e\xfa\f0\x97z\x02\x8d\x01\xc6~\x16\x86\xed\x04V\x13`\xd2\xf6HV\xe3\x05\xdb\t\\\x05\xc40\xc0\xa4i\xa4\xacƛ\xa3\xcbe\x9ea\x18`\xecO\xe7l'\xc0\x00\x93\xfd\xd93\xc0X]\x8c\xf1I\xa4\xec\x10\xc3v\x02\f0v\x00&}\x0fg;\x81\x9c\xa3a\x8e\xf9\x9f\xed\x04V\r`rt[\x06\x98tw\x8d\x8fR_\x1eİ\x9d\x00\xdb\t\xd8\x1c\xf2X\x8d7'\xc0\xa4Ǆ\x85\x03\x98\xb4\x14\xc6\x00\xc3v\x02\x97\r0\xd9g\x17\x06\x98\xcb\xcfİ\x9d\xc0\n\x03L\xde\xc1\x8c\x01\xc6\xfa*xY\xfc\x90R\r\xe4\f0l'0\x7f\x80I}kY\xccnA \xa6\x88\x00\x93\xe5\xb5\f0ֲS\f0`;\x01\x06\x98\xcb\x03\x984\x13>\x03Lz\x80I7\xc31\xc0,&\xc4\\.\xc0\xa4\x19m\x18`\xec\xae\xf9\xd9N\xc0~\xac\xb2\x1a/\x03\x8c\x8d\xf1\x8e\x01\xc6\xce\xe8\x96v~`\x80Y\x12\x88\x99\xa3\x9d@\x96\xf5?\x03\x8c\xc5\x0e\xca\x00\x93\r`X\x8d7\x13\xc0\xa4j\xdeKh'\x90e\xe9\xc1\x00\x93\xe1ֲ\x1a/\x03\xccX&&ߜ\xcaj\xbc9\x01&\xebP\xc8\x003\x87\xf5\x1b\x03\xcc\xfcO\xa0\xb1\x9d\xc0j\x01\x8c\x8d\xc5-\x03\f\xb0:`b\x05b0sCe\x80\xb1\x9d\x9ab\x80\xb1\x1bl'`\xbf\x91\xb2\x9d\x00\x03L\xe6\xaaZV\xe3E\x86+J\xb5\xba[%\xd8\x113&\x12\x18`2\xf5WV\xe3e;\x81\xc5\x00\x18\xb6\x13`\x80\xb1\xb9Dc;\x01K\xb7\x93\xb7\x91\xd2C\xccj\x03L\xe6\xe4\t\x03L\xce{\xcaj\xbcv\x01\xc6^\x06\x82\xed\x04\x96\x15`,\xac\xcf\x18`r\x8dy\\\ac\x1db\xd8\x0f\x89\xd5xst\xb9\xcc3\f\x03\x8c\xfd\xe9\x9c\xed\x04\x18`lfa\x18`r4`.\xe4\xbd\x1c\x88Y6\x80I\xdf\xc6\x18`luP\xb0\x1a/\xac5Յ\xb7\x13 \xe4\xba%\f0l'p\x85\x00\x93\xe6\x83\xf3I\xa4\xab\x83\x18\xb3\xd8v\x02\x17M\xe4\f06\xee&\xab\xf1\xe6\x04\x98\xf4\x98\xb0\\\x00\xc3j\xbc\xf6V\xf69\xb30l'\x90\xa5\x8f\xf2Q\xea)a\xae\x1eb\x8c\xa9\xf6?LQ\x01\x86\xd5x\xe7\x020\xac\xc6[\x04A\xb3%\a\x18b\x80\xc9\xd3VX\x8d7\x0f\xc0d\x9f\x1b\x18`f$\x18\x83\xad+\x87\x18Ǒ\xff-0\x1a \xf8s\a\x98\x8c\x83?\x03̜2U\f0V\xd6hXq5\u07b450l'\x00V\xe3\xb5\x010Y\xfa(\x03\x8c\xad\x90\x00 \tO]uFF<\xf8\x9c{\xfeu\xb7\xebC\x10\x1c\x00\xde\xe8\x87a;\x81+\x02\x98,ݕ\xd5x\xe7\x03z\x17\xdc8\x06\x98\x1c\xf7t\xc1\x01&[\xf6\x8d\x01f\xbe\v\\\x06\x98y&_\x00\xf8\xa1y\xb4\xf9\xf3\xf5\x92\xfa\xd3h\f\xf2\xaf\fb^p\xff=\x7f\xf9\xc2\xe7=\xfbm\xf5v\x0f\x811\x8e}\xc1m\x06\x98L\x9d\x99\xd5x\xe7\x000\xac\xc6k\xdbN \xcdE,\xb6\x9d@ڶ\xcf\x00\x93\xfe&\xb2\x1a\xef\x02d`H\x03*0f\xfb\x8e\x92z\x03\x00\x18c\xae\xf4#\x911F\x12Q\xb0S=\xf9\xfe\xcd\xfd\x93\x0fz\xbe~\x13\x11\x9e\x1d\xf6\x11V\xe3\xcd\x050`1\xbby\x02\fX\xcc\xce\x02\xc0`I\xd5x)\xf5\xc4\fV\xe3\xcd\a0`1\xbb\xf9\x00La \xc6\x03\xb0-\x05=\xb9\xe6\xc8O8J\x9c\x1ac\x04\x11髄\x98\xff\x0fT\xe4L\x85Ck\xbc\x03\x00\x00\x00\x00IEND\xaeB`\x82"),
 }
+var resourceSheetPng = &fyne.StaticResource{
+	StaticName: "sheet.png",
+	StaticContent: []byte(
+		"\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x01\x00\x00\x00\x01@\b\x06\x00\x00\x00S*\xa2\n\x00\x00\n\x85IDATx\x9c\xecݱ\x91\x1aA\x10\x86ѕ\x8a\\\xe4\x13\x87\"\xc0\"\x02|E\xa04\xb0\x88@q\xe0+\x9aS\x95\xaaƹ;8`wf\xbb{\xdfg\xb5q\xf6\xbb\xbf\xc6Xv\x87\xc3\xe1m\x92t\xb3\xcb\xe5\xf2\xad\xdd\xd5\xfa\xde\x0eI\x1f;\x9dNS\xe5\x7f\x92\x00\x00\x00\x00\xee\x00P\x1d\x01\x00\x00\x00\x00_\x00P\x19\x81];Z\xe7\xf3\xb9\x9d\xd2\xe6\xbb^\xaf\xed\xfc\x8f\xc04Mo\x95\xde\x04,\x00\v\xc0\x02x`\x01T]\x02\x00\x00\x00\x00\x9e\x00\xa0\x1a\x02\x00\x00\x00\x00\x9e\x04\xa0\x12\x02\x00\x00\x00\x00^\x00\xa0\n\x02\x00\x00\x00\x00^\x04\xa0\x02\x02\x00\x00\x00\x00f\x00\x90\x1d\x01\x00\x00\x00\x003\x01Ȍ\x00\x00\x00\x00\x80\x05\x00Ȋ\x00\x00\x00\x00\x80\x85\x00Ȉ\x00\x00\x00\x00\x80\x05\x01Ȇ\x00\x00\x00\x00\x80\x85\x01Ȅ\x00\x00\x00\x00\x80\x0e\x00dA\x00\x00\x00\x00@'\x002 \x00\x00\x00\x00\xa0#\x00\xd1\x11\x00\x00\x00\x00\xd0\x19\x80\xc8\b\x00\x00\x00\x00\x18\x00@T\x04\x00\x00\x00\x00\f\x02 \"\x02\x00\x00\x00\x00\x06\x02\x10\r\x01\x00\x00\x00\x00\x83\x01\x88\x84\xc0\x87o\x02\xfe\xf8\xf5\xb3\x9dR\xf9\xfe\xfe\xfe\xd3\xce\xe1E\xf8Ơ\x05`\x01X\x00+,\x80(K\x00\x00\x00\x00\xc0\x8a\x00\xac\x8d\x00\x00\x00\x00\x80\x95\x01X\x13\x01\x00\x00\x00\x00\x01\x00X\v\x01\x00\x00\x00\x00A\x00X\x03\x01\x00\x00\x00\x00\x81\x00\x18\x8d\x00\x00\x00\x00\x80`\x00\x8cD\x00\x00\x00\x00@@\x00F!\x00\x00\x00\x00 (\x00#\x10\x00\x00\x00\x00\x10\x18\x80\xde\b\x00\x00\x00\x00\b\x0e@O\x04\x00\x00\x00\x00$\x00\xa0\x17\x02\x00\x00\x00\x00\x92\x00\xd0\x03\x01\x00\x00\x00\x00\x89\x00X\x1a\x01\x00\x00\x00\x00\xc9\x00X\x12\x01\x00\x00\x00\x00\t\x01X\n\x01\x00\x00\x00\x00I\x01X\x02\x01\x00\x00\x00\x00\x89\x01\x98\x8b\x00\x00\x00\x00\x80\xe4\x00\xccA\x00\x00\x00\x00@\x01\x00^E\x00\x00\x00\x00@\x11\x00^A\x00\x00\x00\x00@!\x00\x9eE\x00\x00\x00\x00@1\x00\x9eA\x00\x00\x00\x00@A\x00\x1eE\x00\x00\x00\x00@Q\x00\x1eA\x00\x00\x00\x00@a\x00\xbeB\x00\x00\x00\x00@q\x00\xee!\x00\x00\x00\x00`\x03\x00\xdcB\x00\x00\x00\x00\xc0F\x00\xf8\f\x01\x00\x00\x00\x00\x1b\x02\xe0=\x02\xbb\x1b\x7f#\xa9p\xed\xa7\xc9\x01\x00\x00\x00\xdc\x01`\xbf߷\xb3D\xc7\xe3q\xf2\x06\xe0\r\xc0\x1b\xc0F\xdf\x00\xde\a\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x82\x02\xf0\x8f\xbd;\xb4A \x86\xe28l`!\x96a\x9b\x8a\x1af\xc13\a\x9eY0\xa8G\x9a\\N]\xfbrm\xbe\xaa\v\xae\xe6\x97\x7f\r\x9f\x05`\x01X\x00\x16\x80\x05`\x01X\x00\x16\x80\x05`\x01X\x00\x16\x80\x05`\x01X\x00\x16\x80\x05`\x01X\x00\x16\x80\x05`\x01X\x00\x16\x80\x05`\x01X\x00\x16\x80\x05`\x01X\x00\x16\xc0\x7f\x01l\xfe\x0f\xe0\xf3xŧ\x05`\x01X\x00\x16\x80\x05`\x01X\x00\xab.\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\xc8\n\xc0e\xe7\xf7n\xa7\x94\x12\x9f\x8e\xe3\x1c8\xb5\xd6\xf8\xb4\x00,\x00\v\xc0\x028\xbe\x00<\x01<\x01<\x01<\x01\xc6=\x01\xda\xf3\xfc\xbe\xe3s\xdas\xbf\xde\xe2s\xc8$\xcb>\xed\x13\xcd}\xce}\x1f\v\xc0\x02\xb0\x00,\x80\xae\v\x80\r\xc8\x06d\x03\xb2\x01ـl@6 \x1b\x90\r\xc8\x06d\x03\xb2\x01ـl@6 \x1b\x90\r\xc8\x06d\x03\xb2\x01ـl@6 \x1b\x90\r\xc8\x06d\x03\xb2\x01ـl@6 \x1b\x90\r\xc8\x06d\x03\xb2\x01ـl@6 \x1b\x90\r\xc8\x06d\x03\xb2\x01ـl@6 \x1b\x90\r\xc8\x06d\x03\xb2\x01ـl@6 \x1b\x90\r\xc8\x06d\x03\xb2\x01ـl@6 \x1b\x90\r\xc8\x06d\x03\xb2\x01ـl@6 \x1b\x90\r\xc8\x06d\x03\xb2\x01ـl@6 \x1b\x90\r\xc8\x06d\x03\xb2\x01ـl@6 \x1b\x90\r\xc8\x06d\x03\xceo\x03\xee\xa1\x1a+\x9c\xd1h\x83\xfb\xb8O{\x1f\v\xc0\x02\xb0\x00,\x80\xae\v\xc0\x13\xc0\x13\xc0\x13\xc0\x13 \xe7\t\xc0\xd2c\xe9eZz\xe7\xbfϏ\xbd;\xb4\x01\x00\x06@ \xb8\xffֵ\xb5M(\x82\xdc\b\xb8G\xdd\xdb\x1e\x05\xa0\x00\x14\x80\x02\x88\x16\x00\x1b\x90\r\xc8\x06d\x03\xb2\x01ـl@6 \x1b\x90\r\xc8\x06d\x03\xb2\x01ـl@6 \x1b\x90\r\xc8\x06d\x03\xb2\x01ـl@6 \x1b\x90\r\xc8\x06d\x03\xb2\x01ـl@6 \x1b\x90\r\xc8\x06d\x03\xb2\x01ـl@6 \x1b\x90\r\xc8\x06d\x03\xb2\x01ـl@6 \x1b\x90\r\xc8\x06d\x03\xb2\x01ـl@6 \x1b\x90\r\xc8\x06d\x03\xb2\x01ـl@6 \x1b\x90\r\xc8\x06d\x03\xb2\x01ـl@6 \x1b\x90\r\xc8\x06d\x03\xb2\x01ـl@6 \x1b\x90\r\xc8\x06d\x03\xb2\x01ـl@6 \x1b\x90\r\xc8\x06ܲ\x01\x7f#\akh\x83=\xf6\xdc{\x14\x80\x02P\x00\n Z\x00.\x80\v\xe0\x02\xb8\x00\x9d\v\xc0\xd2c\xe95-\xbd\xb5=\n@\x01(\x00\x05\x10-\x006 \x1b\x90\r\xc8\x06d\x03\xb2\x01ـl@6 \x1b\x90\r\xc8\x06d\x03\xb2\x01ـl@6 \x1b\x90\r\xc8\x06d\x03\xb2\x01ـl@6 \x1b\x90\r\xc8\x06d\x03\xb2\x01ـl@6 \x1b\x90\r\xc8\x06d\x03\xb2\x01ـl@6 \x1b\x90\r\xc8\x06d\x03\xb2\x01ـl@6 \x1b\x90\r\xc8\x06d\x03\xb2\x01ـl@6 \x1b\x90\r\xc8\x06d\x03\xb2\x01ـl@6 \x1b\x90\r\xc8\x06d\x03\xb2\x01ـl@6 \x1b\x90\r\xc8\x06d\x03\xb2\x01ـl@6 \x1b\x90\r\xc8\x06d\x03\xb2\x01ـl@6 \x1b\x90\r\xc8\x06d\x03\xb2\x01ـl@6 \x1b\x90\r\xc8\x06d\x03\xb2\x01ـl@6 \x1b\x90\r\xc8\x06d\x03\xb2\x01ـl@6 \x1b\x90\r\xc8\x06d\x03\xb2\x01ـl@6 \x1b\x90\r\xc8\x06d\x03\xb2\x01ـl@6 \x1b\x90\r\xc8\x06d\x03\xb2\x01ـl@6 \x1b0i\x03\x1e\xf6\xee\xd8\x04\x00\x18\x86\x81`\x93\xfdg\xce\x0e\xc1\x11\xc2\xdc\bn\x1e\xb9:\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x80\x0f\x018\xac\xb6w\xab\xcd=\xeeI\xdec\x01X\x00\x16\x80\x050\xba\x00\xbc\x00^\x00/\x80\x17 \xf3\x02\xb0\xe7\xba\xed9\xf7t\xdfc\x01X\x00\x16\x80\x050\xba\x00\u0600l@6 \x1b\x90\r\xc8\x06d\x03\xb2\x01ـl@6 \x1b\x90\r\xc8\x06d\x03\xb2\x01ـl@6 \x1b\x90\r\xc8\x06d\x03\xb2\x01ـl@6 \x1b\x90\r\xc8\x06d\x03\xb2\x01ـl@6 \x1b\x90\r\xc8\x06d\x03\xb2\x01ـl@6 \x1b\x90\r\xc8\x06d\x03\xb2\x01ـl@6 \x1b\x90\r\xc8\x06d\x03\xb2\x01ـl@6 \x1b\x90\r\xc8\x06d\x03\xb2\x01ـl@6 \x1b\x90\r\xc8\x06d\x03\xb2\x01ـl@6 \x1b\x90\r\xc8\x06d\x03\xb2\x01ـl@6 \x1b\x90\r\xc8\x06d\x03\xb2\x01ـl@6 \x1b\x90\r\xc8\x06d\x03\xb2\x01ـl@6 \x1b\x90\r\xc8\x06d\x03\xb2\x01ـl@6 \x1b\x90\r\xc8\x06d\x03\xb2\x01ـl@6 \x1b\x90\r\xc8\x06d\x03\xb2\x01ـl@6 \x1b\x90\r\xc8\x06d\x03\xb2\x01ـl@6 \x1b\x90\r\xc8\x06d\x03\xb2\x01ـl@6 \x1b\x90\r\xc8\x06d\x03\xb2\x01ـl@6 \x1b\x90\r\xc8\x06d\x03\xb2\x01ـl@6 \x1b\x90\r\xc8\x06d\x03\xb2\x01ـl@6 \x1b\x90\r\xc8\x06d\x03\xb2\x01ـ+m\xc0\xcb\xde\x1d\xdb\x00\b\x84`\x18\x8d\x89S\xb2\xff\x1eZ\xb9\x80!\b\xf8\xca+\xafy\xf9\xa9>\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x80M\x00\x9cZm\xef[m\xfe\xe3?\x95\xff\xb1\x00,\x00\v\xc0\x02H]\x00N\x00'\x80\x13\xc0\tPs\x02h\xcf\xf5n\xcf\xf9O\xef\xffX\x00\x16\x80\x05`\x01\xa4.\x00m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m@m\xc0Qm\xc0\r}6\v\xc0\x02\xb0\x00,\x00\v\xc0\x02X\xb7\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00|\n\xc0\x11\x11\xd7\xf3\xb0\x00,\x00\v\xc0\x02\xb0\x00,\x00\v\xe0'\v\x00\x00\x00\x00\x00\x00\x00\x00\x80q\x00\xdc\x1aeP\x81\x01\x18\x00k\xb1M;\xeb\n'1\x00\x00\x00\x00IEND\xaeB`\x82"),
+}
+var resourceSlidePng = &fyne.StaticResource{
+	StaticName: "slide.png",
+	StaticContent: []byte(
+		"\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x01\x00\x00\x00\x01@\b\x06\x00\x00\x00S*\xa2\n\x00\x00\n\x06IDATx\x9c\xecݱ\x91\x1aA\x10\x86ѕ\x8aP\x14\x01\x86rP\nXD@LX\xa4\xa0\x1cd\x10\x81r9U\xa9j\x9c\xbb\x83\x03vg\xb6\xbb\xf7}V\x1bg\xbf\xfbk\x8cew8\x1c\xde&I7\xbb\\.\xdf\xda]\xad\xef\xed\x90\xf4\xb1\xd3\xe94U\xfe'\t\x00\x00\x00\xe0\x0e\x00\xd5\x11\x00\x00\x00\x00\xf0\x05\x00\x95\x11ص\xa3u>\x9f\xdb)m\xbe\xeb\xf5\xda\xce\xff\bL\xd3\xf4V\xe9M\xc0\x02\xb0\x00,\x80\a\x16@\xd5%\x00\x00\x00\x00\xe0\t\x00\xaa!\x00\x00\x00\x00\xe0I\x00*!\x00\x00\x00\x00\xe0\x05\x00\xaa \x00\x00\x00\x00\xe0E\x00* \x00\x00\x00\x00`\x06\x00\xd9\x11\x00\x00\x00\x000\x13\x80\xcc\b\x00\x00\x00\x00X\x00\x80\xac\b\x00\x00\x00\x00X\b\x80\x8c\b\x00\x00\x00\x00X\x10\x80l\b\x00\x00\x00\x00X\x18\x80L\b\x00\x00\x00\x00\xe8\x00@\x16\x04\x00\x00\x00\x00t\x02 \x03\x02\x00\x00\x00\x00:\x02\x10\x1d\x01\x00\x00\x00\x00\x9d\x01\x88\x8c\x00\x00\x00\x00\x80\x01\x00DE\x00\x00\x00\x00\xc0 \x00\"\"\x00\x00\x00\x00` \x00\xd1\x10\x00\x00\x00\x000\x18\x80H\b|\xf8&\xe0\x9f_?\xda)\x95\xef\xe7\xef\xbf\xed\x1c^\x84o\fZ\x00\x16\x80\x05\xb0\xc2\x02\x88\xb2\x04\x00\x00\x00\x00\xac\b\xc0\xda\b\x00\x00\x00\x00X\x19\x805\x11\x00\x00\x00\x00\x10\x00\x80\xb5\x10\x00\x00\x00\x00\x10\x04\x805\x10\x00\x00\x00\x00\x10\b\x80\xd1\b\x00\x00\x00\x00\b\x06\xc0H\x04\x00\x00\x00\x00\x04\x04`\x14\x02\x00\x00\x00\x00\x82\x020\x02\x01\x00\x00\x00\x00\x81\x01\xe8\x8d\x00\x00\x00\x00\x80\xe0\x00\xf4D\x00\x00\x00\x00@\x02\x00z!\x00\x00\x00\x00 \t\x00=\x10\x00\x00\x00\x00\x90\b\x80\xa5\x11\x00\x00\x00\x00\x90\f\x80%\x11\x00\x00\x00\x00\x90\x10\x80\xa5\x10\x00\x00\x00\x00\x90\x14\x80%\x10\x00\x00\x00\x00\x90\x18\x80\xb9\b\x00\x00\x00\x00H\x0e\xc0\x1c\x04\x00\x00\x00\x00\x14\x00\xe0U\x04\x00\x00\x00\x00\x14\x01\xe0\x15\x04\x00\x00\x00\x00\x14\x02\xe0Y\x04\x00\x00\x00\x00\x14\x03\xe0\x19\x04\x00\x00\x00\x00\x14\x04\xe0Q\x04\x00\x00\x00\x00\x14\x05\xe0\x11\x04\x00\x00\x00\x00\x14\x06\xe0+\x04\x00\x00\x00\x00\x14\a\xe0\x1e\x02\x00\x00\x00\x006\x00\xc0-\x04\x00\x00\x00\x00l\x04\x80\xcf\x10\x00\x00\x00\x00\xb0!\x00\xde#\xb0\xbb\xf17\x92\n\xd7~\x9a\x1c\x00\x00\x00\xc0\x1d\x00\xf6\xfb};Kt<\x1e'o\x00\xde\x00\xbc\x01l\xf4\r\xe0}\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00 (\x00\xffع{\x1b\x86A0\f\x83MF\xc9\f\xec\x90-\x18\x8d-\xd8!30L\x8a\xe8sc\xb9\xb0\x04\xf8\xef\xa8,\x0fpz\xa1x,\x00\v\xc0\x02\xb0\x00,\x00\v\xc0\x02\xb0\x00,\x00\v\xc0\x02\xb0\x00,\x00\v\xc0\x02\xb0\x00,\x00\v\xc0\x02\xb0\x00,\x00\v\xc0\x02\xb0\x00,\x00\v\xc0\x02\xb0\x00,\x00\v\xc0\x02\xb0\x00\x96\x05\xb0\xea\x01\xa4\xda\xe2\xd3\x02\xb0\x00,\x00\v\xc0\x02\xb0\x00,\x80\xbb.\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xd0\x13\x80\xd7\xc6\xffn'\xe7\x1c\x9f\x8e\xe3\xec<\xa5\x94\xf8\xb4\x00,\x00\v\xc0\x02\xe8\xbb\x00\xbc\x01x\x03\xf0\x06\xe0\r\xc0\x1b\x807\x00o\x00\xde\x00\x06\xbc\x01̾\xd38Ε\xcf\xec73W\x00W\x00W\x00W\x00W\x00W\x00W\x80'^\x014\x015\x015\x015\x015\x015\x015\x015\x015\x015\x015\x015\x015\x015\x015\x015\x015\x015\x015\x015\x015\x015\x015\x015\x015\x015\x015\x015\x015\x015\x015\x015\x015\x015\x015\x015\x015\x015\x015\x015\x015\x015\x015\x015\x015\x015\x015\x015\x015\x015\x015\x015\x01\xffM@\xc79\xf2|?\xef\xf8\x9crRm\xf1i\x01X\x00\x16\x80\x05p\xee\x05\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xc6\x00\xf0c\xef\x8em\x00\x00a\x18\b6\xec?3\x1b\xd0EF\xf1-a}\n\x0e\x05\xa0\x00\x14\x80\x02P\x00\n@\x01(\x00\x05\xa0\x00\x14\x80\x02P\x00\n@\x01(\x00\x05\xa0\x00\x14\x80\x02P\x00\n@\x01(\x00\x05\xa0\x00\x14\x80\x02P\x00\n@\x01(\x00\x05\xa0\x00\x14\x80\x02P\x00\n@\x01(\x00\x05\xa0\x00\x14\x80\x02P\x00\n@\x01(\x00\x05\xa0\x00\x14\x80\x02P\x00\n@\x01(\x00\x05\xa0\x00\x14\x80\x02P\x00\n@\x01(\x00\x05\xa0\x00\x14\x80\x02P\x00\n@\x01(\x00\x05\xa0\x00\x14\x80\x02P\x00\n@\x01\xb4\x16\xc0\xf1w\x9d\xbf\xeb~\xfe\xbbN\x01(\x00\x05\xa0\x00\x14\xc0P\x01\xf0\x00x\x00<\x00\x1e\x00\x0f\x80\a\xc0\x03\xe0\x01\xf0\x00x\x00<\x00\x1e\x00\x0f\x80\a\xc0\x03\xe0\x01\xf0\x00x\x00<\x00\x1e\x00\x0f\x80\a\xc0\x03\xe0\x01\xf0\x00x\x00<\x00\x1e\x00\x0f\x80\a\xc0\x03\xe0\x01\xf0\x00x\x00<\x00\x1e\x00\x0f\x80\a\xc0\x03\xe0\x01\xf0\x00x\x00<\x00\x1e\x00\x0f\x80\a\xc0\x03\xe0\x01\xf0\x00x\x00<\x00\x1e\x00\x0f\x80\a\xc0\x03\xe0\x01\xf0\x00\x9e\x1e\x00\xbf\x80_\x90\xf4\v\x9c\x00N\x00'\x80\x13\xc0\t\xe0\x04p\x024\x9e\x00<\x00\x1e\x00\x0f\x80\a\xc0\x03\xe0\x01\xf0\x00x\x00<\x00\x1e\x00\x0f\x80\a\xc0\x03\xe0\x01\xf0\x00x\x00<\x00\x1e\x00\x0f\x80\a\xc0\x03\xe0\x01\xf0\x00x\x00<\x00\x1e\x00\x0f\x80\a\xc0\x03\xe0\x01\xf0\x00x\x00<\x00\x1e\x00\x0f\x80\a\xc0\x03\xe0\x01\xf0\x00x\x00<\x00\x1e\x00\x0f\x80\a\xc0\x03\xe0\x01\xf0\x00x\x00<\x00\x1e\x00\x0f\x80\a\x90\xf1\x00\xbcW\xdf\xf5^]\x01(\x00\x05\xa0\x00\x14\xc0P\x01\x18\x00\x03`\x00\f\x80\x010\x00\x06\xc0\x00\x18\x00\x03`\x00\f\x80\x010\x00\x06\xc0\x00\x18\x00\x03`\x00\f\x80\x010\x00\x06\xc0\x00\x18\x00\x03`\x00\f\x80\x010\x00\x06\xc0\x00\x18\x00\x03`\x00\f\x80\x010\x00\xc5\x03pٷc\x1a\x00a0\b\xa3\v\x9a\xd0S%\x1d\xaa\xa4.0\x83\x18\xa6\x13@\x02\x94\xc2c\xea\xc8\xf4\xe5\xe5O\x8e\x00\b\x80\x00\b\x80\x00\b\x80\x00\b\x80\x00\b\x80\x00\b\x80\x00\b\x80\x00\b\x80\x00\b\x80\x00\b\x80\x00\b\x80\x00\b\x80\x00\b\x80\x00\b\x80\x00\b\x80\x00\b\x80\x00\b\x80\x00\b\x80\x00\b\x80\x00\b\x80\x00\b\x80\x00\b\x80\x00\b\x80\x00\b\x80\x00\b\x80\x00\b\x80\x00\b\x80\x00\b\x80\x00\b\x80\x00\b\x80\x00\b\x80\x00\b\x80\x00\b\x80\x00\b\x80\x00\b\x80\x00\b\x80\x00\b\x80\x00\b\x80\x00\b\x80\x00\b\x80\x00\b\x80\x00\b\x80\x00\b\x80\x00\b\x80\x00\b\x80\x00\b\x80\x00\b\x80\x00\b\x80\x00\b\x80\x00\b\x80\x00\b\x80\x00\b\x80\x00\b\x80\x00\b\x80\x00\b\x80\x00~/\x80%\x8f\xaf|\xeb\xb6\xe7\xe9\x7f\xfd\xeft\xffK\x00\x04@\x00\x04\xf0\x98\x00l\x01l\x01l\x01l\x01l\x01l\x01l\x01l\x01l\x01l\x01l\x01l\x01l\x01l\x01l\x01l\x01l\x01l\x01l\x01l\x01l\x01l\x01l\x01l\x01l\x01l\x01l\x01l\x01l\x01l\x01l\x01l\x01l\x01l\x01l\x01l\x01l\x01l\x01l\x01l\x01Nl\x01J)y\x12\x00\x01\x10\x00\x01\x10\x00\x01\x10\xc0(\x01\xb8\x01\xb8\x01\xb8\x01\xb8\x01\xb8\x01\xb8\x01\xb8\x01\xb8\x01\xdcp\x03\xe8\xbd\xe7\xe9\xbb\xf0\xab\xb5\xe6\xf9\x8a\xaf\xb5\x96'\x01\x10\x00\x01\x10\x00\x01\xcc \x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x00\x01\x10\x80\xf1\x018ػc\x14\x8ba\x18\f\x83\x04rJ\xdd\xff\x1eI\xe5֍0\xc2Ҕ[-\xbcb\xf8D~\xb0\x02P\x00\n@\x01(\x00\x05\xa0\x00\x14\x80\x02P\x00\n@\x01(\x00\x05\xa0\x00\x14\x80\x02P\x00\n@\x01(\x00\x05\xa0\x00\x14@\xdf\x02x\x8b\xfe\xaf\x17z\x0e\xbd\xd03\xedw\xf0\"Q\xeeE\"'\x80\x13\xc0\t\xe0\x04p\x028\x01\x9c\x00\x13O\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x80\xdd\x0e\xc0w\xe8\xdcwh\x05\xa0\x00\x14\x80\x02P\x00E\x05\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00@7\x00\x9e\x88\xf8\xd6\x1f\n@\x01(\x00\x05\xa0\x00\x14\x80\x02\x18R\x00\x00\x00\x00\x00\x00\x00\x00\x00\\\a\xc0\xafQ\x06\x15\x18\x80\x01\x00 _\xf1\t\xf9\x04ih\x00\x00\x00\x00IEND\xaeB`\x82"),
+}
 var resourceUnknownPng = &fyne.StaticResource{
 	StaticName: "unknown.png",
 	StaticContent: []byte(
@@ -60,6 +70,11 @@ var resourceVideoJpg = &fyne.StaticResource{
 	StaticContent: []byte(
 		"\xff\xd8\xff\xe0\x00\x10JFIF\x00\x01\x01\x00\x00\x01\x00\x01\x00\x00\xff\xdb\x00\x84\x00\x05\x05\x05\x05\x05\x05\x05\x06\x06\x05\b\b\a\b\b\v\n\t\t\n\v\x11\f\r\f\r\f\x11\x1a\x10\x13\x10\x10\x13\x10\x1a\x17\x1b\x16\x15\x16\x1b\x17) \x1c\x1c )/'%'/9339GDG]]}\x01\x05\x05\x05\x05\x05\x05\x05\x06\x06\x05\b\b\a\b\b\v\n\t\t\n\v\x11\f\r\f\r\f\x11\x1a\x10\x13\x10\x10\x13\x10\x1a\x17\x1b\x16\x15\x16\x1b\x17) \x1c\x1c )/'%'/9339GDG]]}\xff\xc2\x00\x11\b\x01\xc4\x02\x80\x03\x01\"\x00\x02\x11\x01\x03\x11\x01\xff\xc4\x00/\x00\x01\x00\x02\x02\x03\x01\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\b\t\x06\a\x01\x03\x05\x04\x02\x01\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xff\xda\x00\f\x03\x01\x00\x02\x10\x03\x10\x00\x00\x00\x99`\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x06.e\bo\xa0K;\xc6j\x7f\xa0\xb6Oj\xa0y.]R\xbbğ-E\xb7@\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1d\x1eEq\x9b\xb6!\xfc\x00\x00\x00\x01\xbc\xf4`\xb6LҠ\xacT\xdd\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00<Mfntt\xfb\r\xfe\xd7;\x10\xfd\x00\x00\x1ew\xa3\x00\x8dm\xab@\x00\x0f\xdeTbL\xbb\x15:\xc0\x03\xd0\xf3ŝ\xedڐ\xb5#\xdd\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\a\xcb\x03\xc9#\x10tp\xef\xe9\xe0\x01\xceS\x8a\x89\x91.i\xfb+-\xb5\xa7w\x10\x06\xa1\xacm\xf7\xa0\xc0\a\xaat\xcb\xfdᶌg&\xf0\xe2\x9921\xff\x00ߺD\x18qp\xbaȫG\xb9\xe1\x80%TU\xfbK\x8bc\xb9\x10\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xe8\xef\x84Ƶ\xd1@\x01\xceRb\xad\xdf\xe6\x1a\x8d\xf5|\xa0\x1f}\x8f֏\xbe[ω\x8e\xe9\x12\r|\xfc\xf0\x00\x9eжێ\xe7\xe3_\x98mo\\\xb6\nk\xcd\xfa\x89\x84\xb3U휑\xea\xbdnZ\xa7\f0\x00OII^\xf6\x10\x00\x00\x00\x00\x00\aX\xecu\x8e\xc7X\xecu\x8e\xc7X\xecu\x8e\xc7X\xecu\x8e\xc7X\xecu\x8e\xc7X\xecu\x8e\xc7X\xc2*\xaeVD\xa0\a\xad\xe6Y9\x8dI\x0fޚ7*\xa2\xad\xa8\xf1 \x9d\x89u\x94\xd6\xdf:\x18\x03\x97\x00\x007\xfd\x8c\xd7Ղ\x91\xb6\xbe\xad\xda1\x1boq\xf9\x9e\x98\x8cRtVݎ\xf7\x85|\xd84\v\"\xd8\x00\xdb\x16\x81UV\xa0v\xba\xc7c\xacv:\xc7c\xacv:\xc7c\xacv:\xc55\x80rp\xe7\x80\x03\x91\xc0\x0eG\x00\x1c\x9c9\xe0\x1c\x9c9\xe0\x00\x007\xb5\x91Dipx\xbfEI\xcf\xf3\x8d\xdf\xe9yD\x1d\x9a\x90Ns\x1e5TܽG\x18\xc8\x00\x00\r\xb1h\x14\xd9kFrk\xf3\x1c\xda4\xf55\t\x87^6\x1d[f\xe4\x98\x10\xaaj\x8aҰ*\x9c>P\x00\x00\xe4\xe1\xcf\x00\xe4\xe1\xcf\x00\a#\x80M\x18]c5\xcczVG\\\x17\x04i\xf8\x1fj\x15\x8aj\x19=\x18f\x99\xb9u\x94\xad\xd3\xc5d\xf1Ȳϯo\xf2V&\xa2\xdfZ\x14\x92r\x97E\xcdB.\xc0\xabL\xab1c\xb5\xc5o\x86\xba\x86\x96W^\xc4p\xdc:zK\x11\xab\x8eޠ\t\xcb,\xeb\xca\xc3Mc\xb0~\xba\xf2,?\xaf[\xeb\xb3qe\xd5\ro\"\xa5lꦏ\xc0\x00\x00\x06\xf0\xd1\xe2\xe5?U\xd9>\x8d\x13\xbb2P\x03\xf1\xe6@\xe3Ώ\xe0\x00\xfbO\x89\x9b\xe1\x04\xa4\x92\x9a\x86_\x11:\fٍg\x19E\x87\xd7\xed\xb1\x1a~\xbd-\xae\xa8\x8c\x12[ĉ\xe8lM714\x11\\\x9fw\xc3\xe8\x96\x11\\\xd3\xe2\x04\x1e\xad\xc1S\xbc\xfa$ub˨\xbehy\xa7\v$9b:{\x11\xd7\xc48\x05\xcbs\x1a\xbe\xd24\xe8]\x9f\xacI\x875+\x96h\x9f\x9a\xb3\x9ap\xb0\xf5\xad\xae\xb4&ٺ\xab\xda@\xc4\xd3P\xc9\xe8Ź\xccs]n\xbd(\x0eI\x0f1\xa3\xa4i-\xaa\x11\xc8\x1d\xb8`\x99\xa7x\xc23\x7f\x8e\x16\x9e\fc\x00\x1d\xbd[\b\xfc\xe06\r\x0fMr\x0f[\xde\xed\xdads\xf7<A)\xf6\x84\b\x13\xdfY\xc5A\x90c\xdc\xe4\xe6.ɱ\x90\x04\xaa\x8b\x93\x84\x8d\x9a\xab8\xc1ɵ/\xab\xcf\x7f\x99=gM\x98\xfac\xb6\xc5_S|ɪ\x8a\xc1\xeb\xa4\xc4\xe7\xa4\v\x93\xa4\xfb\xd0^n\xac\"O\xa3\xe7}\x84\xfe\xef\x80Ҡ\x9a\x0f?\xd8<\xedY\xb94\x89\x14\xf4\x0f\xbd\xe0\x80l\xbd\xf5\x0e\x85\x89\xef\xdd\x19\xbe\xcc_!\xe7\xb8\xe8\xd6[R\xb6\x8cK]p'\x16\xe1\x81\xf6\x84y\x0fhk\xfdy \xe2q\x16\xb5\xe86\x1e\xbc\x16[\xb4\"\xb4\xc4<?p\x00\xf3#\x9c\x9f\xd5\x06\x87ö/\xdcB\x8f.ǣ\xe1\x19\x00\x03\x9e\x04\xb8\xdc0~\xce\a\xdb\xed\x0f\x15\xed\x0f\x17\x06\xdab;\xef/C\xb8\x880\x97qi\xd0\x00\x1d\x99=\x85\x11bTn!\xd7\xd8\x00q\xe2\xfbb/\xc4+]\xf9JqKH\x96\x00\x98\xd0\xe7d\x16\x85\xf7\x03Wm\x1f\xc1\x05\"\xad\x85נ\x035¥\xd96;|\x7f`\x03\x00\xaa\xa9S\x14\x806\x9d\xa2\xd5բ\x80\"\x84\xaf\x8a\x04\x16\x00\x13\v\xcd\xf4\xf4Ad\x99G_`c\x99\x18q\xd4w1\x9c\x98~?b2@{\x93\x86\xc40\x00\x19ͯ\xd5\x05\xaf\x80\x00\x00\x15\x93\xa7w\x0e\x9e\x00e\xf8\xbd\x9d\x19&f\x00\x0e\x0e^\a\xa2}\xc0\x01\x0f\xa6\x0f\x054$\f~\x00\xb3m\xc5^\x96\x16\x01\xe3\xd6ş\xc6\x12\x02\x83\xee\x9bZ\xb3z\x12<\x1d:\xd3\xca\xd2\xc4L\xf2@\r\xa7h\xb5uh\xa0\b\xa1)bY\t@\x04\xc2\xdb\x1a\xcac\x1d\xa0\xf9\xeb\x82\xc9\xeb4\xc0\xf6.\x90\x13wL艖g2S\xce\xf4G\xcf\xf4\n\x9b\xc2\xe6l2\x00\xcem~\xa8-|\x01]\x16/ZF\xb5c\xc3!c\xdbX\xd6\x1d\x1c\xf0\x0eI1?\xf4\xee\xe2\x005\x8e\x87=\xf8y\x88\x8e{\xba\x06\xfe\x9d\xf5%\x9a\x96\xc6\xf0\xbd\xd0\r3Z\x96\v\x18\x8d \x0f\xaa\xdaj2i\x13\x18\x0f3\xd3\x14\xf9\xe5\xca\rfHYG\xa7w\xe8>CT\xc09Y\x06\x00\x00\xdav\x8bWV\x8a\x01\xabt$͊\x04\x16\x00\x13j_D\x19|\x00\xac\xdb2\xac\xd3L\x81\x95✗.\xf0}\xe0\r+ZV\xb5T\xa7\x00\xcem~\xa8-|\x01\xacvpҭ\xd44\xaf\xb7\xb3\xf5i\x01u\x9f<\x0f\xa7\xe6\xcb\xcba\xfa\x00\rwW\x16+[\xa0\x00\x01.'\x05Vڐ\x045\xf8\xf6\xfe\xa4!\xc8\x19~ .O\xb6?H\x10\rM\xa7\xa5\xd7\xc0x9h1\xec\x87^\x10oGvu\x80\x01\xb4\xed\x16\xae\xa7\x11\xb8\xc0\x8a\x12\xbe(\x10X\x00M\xa9}\x10e\xf0\x02\xb3lʳM2\a<d%\xaa\xe4\\r\x0f\x88\xc4\xea\x86«\xd4\xe0\x19ͯ\xd5\x05\xaf\x80\x00\a\x89\x83\xeb_\\\xae\xee$\xee\x80<\x1c\xcf\f\xf4Ky\xfb\xa3\xa4\x89?@\xd0U\xc9l\xb58~@\x00\x19ݮ\xd7}\x88\x00tD\x89}\x12\b<\x007}\x94\xd3]\xab\x99\xe8\x00\x01\x04f\xadJ\x9eP\x00\x03i\xed\xbdIhG\x8f\xed\x82(J\xf8\xa0A`\x016\xa5\xf4A\x97\xc0\nͳ*\xcd4˟X\xf2&OT\xd0;\x00\x84sr\x19\x1f\x98m\xb6\xb5(\x06sk\xf5Ak\xe0\n\xed\xb1*\xd15\xeb\x18\x19<\x86\x8a^\xc9\xf7\xf8\x1fw\x8e9\xe0M\f\xa3\x02\x8fE\xb10\xdc\xc8W\x9d\x86y\xe5;\xa4\xccm:\x1c\x8e>\xac\x9ax\x99\x0e\xd6\x00\x15\xf1=\xaaL\xf1\x00\x02ZD\xbfp\xb7\xc6\r\xf2\x9b\f\x03\xac\x89\xd0s9\xc1\x80\x00\x03i\xda-]Z(\a\xe2\x1eHȺD0\x016\xa5\xf4/\x97g\xaa\x06\xa5\xdb_)\x17$\xe7\xd3\xf4\x00\x01\xf3E}\xe7X\xe6:\x003\x9b_\xaa\v_\x00k\xfd\x805\x0e\x90\x99\xbf)\f3i1\xf3\x11\x12\x1b\xee\x1d:\x01\xeeK\xa8S\xbb\x8d\x997\xa0n\xd5$\xe8\x1e\x1f\xb85?\xa5\xb1\x87Wh\x005)\xa6a?\xdf\xf0\x04\x89\x8e\xc1\xf6K\xc2\x1b\xa6Dg$\xe6\xbb\xf4\xb7\xf9\xbf\xc0Л\xee!\x10\x912D6g\xd8\bn\x1d\xd0Cd\xa1\x8ef}h\xb0Vu\x00G\xcdU\x93\xe6\xa5q\xa6O\x90D\xd6]\xb1͝\xf1n}TM\x06\x9f\xdc\x00\x03\x83\x97\x87\xa5\t\x0f\xa2\xa2v\x8d2<p\x00\x1d\xa6i;\xe3\x9evK\xff\x00\xd4>ȉ>\xc3s \xf1\xe3Y+\x18nbVV\x9d\x971T\xf8\x00$A\xe3\xed\x98\xc3\xf93\xab\t\xd4\x7fa\xbf\x00\x00\xe0\xe5\x81\xebc֯\x19c\xaf\x88\xe8}%\x88W5\x8c\xd71\xeb\\\x15>\xdc\x10\xac[:\xacS\f\xb1\nĚ$\xc7՛OO\x11/_k\xe1r\x1d\xfcrW\x0e\x85\xdfZ\x14\x98\x93R\x15\xcdCZV\r\x9fU\x99\xbe,b\x9b\xed\xf0\xf6\xe14ٯc]\xd8\xd5M\xd8q\"q,\xb7\x12*\xc7/ւ\xd9\xf3\f+5!\xect\xdcqD\xd9mh6/\x85\x8b\x8f\xd7\x1c\x00\x00\x01\xd9\xd67\xc6Í\x19\x89\xf2\xb5\xa0\xd9mh6[Z\r\x97\x95葽\xb5\xe6\x19\xbf\r\x06\x05\x89Wm\x89\x11\vUm]TM\xa9}\x10e\xf1\xae\xa2̅\xac\xe2q\xcbJ\x9d\xb6![6MTF\v<\xe0\\\xf4%&\x82ߺ\b\xaeOG\xce\xf4I\xdb\x00y\xe0\xf5\xad\x86\xa29-ַ\xf5P\xe2`\xc3\xeeKv\xd4u\xca8\xe7\x81m\xdfMD\x8dߣ\xf9\xe0\x96s\x12\xa2E\x95֣\x83\x9bZ\xaaNKv\x81\xb1\xecq$cw%\xbbb5n\x0e\x05\xa0\xe6\xd5\x12$\xf4`\xe7\x80\x00\x00\x00\x00\x00\x00\x00\x00\x01\xcf\x00\x04\xfc\x80|\x9b7X\xf3\xc11\xe5\x85D\x8b\x16\xae\x97\x06eh\xb5\x11\xc9n\xd5\x7f\x84\xf0'\x04\x1f\xe4\xb7m\x15_ÏG\xce\x1c\xad8U\x8aӅX\xad8U\x8aӅX\xad8U\x8aӅX\xad8U\x8aӅX\xad8U\x8aӅX\xad8U\x8aӅX\xad8U\x8aӅX\xad8U\x8aӅX\xad8U\x8aӠI\xa9\xd6\x1d\xb0\n\xb1Zp\xab\x1e'\xf4\x028\x00\x00\x00\x00\x00\x01\xca\xc8rҬV\x9c*\xc5i¬V\x9c*\xc5i¬V\x9c*\xc5i¬V\x9c*\xc5i¬V\x9c*\xc5i\xc3d\x80\x00\x00\x00\x00\x00\x00\x01\xf8?l\aY\x92)\t\xb5\x99d\x9c\xd4^\xd2,\x89\x1f\xb0r\\\xab\xe3Y\x96\x87\xae+\x8bi\x9b\xee\x19ʝ\xb4m\xdfH\x00ӕ\x97f\x95\x96p\x00\x00\x00\x00\x00\x02\xd9\xf3\x1c;1\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x11bS袷x\x00\x0evy\xe4oy\x99\xee\x11\xb7lgC\x8e@\x00\x00ǡ\xac네D\xb0(gk\xe4u\xafˍ\xa8\xe3\xf59\xa2핑\xe9!Dz\xd3s\xa6/\x10\"@G\xfd\xc6K\x0f\x96G\x8al\x94\x9a_}\x13+\xd3\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x06\xbc\xd8\x7f\x82\xa0v]\x9a~\x88/\xb3d\xd8\xd6\x1b\x1b\xe9\x00\x00\x00\x00\x00\xad;+\xa8Cw\xd8lE\x97G\x83QV\x17]\xe6ش\n\xa3\xb5\xc0\x04F\x970̆\x7fO\xcd\xc9p~\xa6\x85\xdfE}b\x9b\xf6\x19\x17\x00\xfc\xfe\x80\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xc1\xaa\x86\xc2\xebز\xcd\xd3\xe3\xfb\x04\b\x8bٖ\x1ad\x96\xe9M\xf7 \x00\x83ӆ\x04\x91{(\xc5\xe5)\xf4N\xaa\xa6\xb5\x93OVM\xc2\xd3\xf1j\xb9\xf4q\x91\xc0\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00B-\x03\x95\xe4\xe5\x84`Y\xf4T \x9eq\x8bJ2'\\=;\xdbi\x95\x01^\x96\x17[\xa6\x90\xb1\xaa\xfc\xb6b\x9f-\x9e\xa8\xa7q'\xaa\xb2\xd5+\xf0\xc8\xe7\x05fY\x98\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x03\xf1\xfb\xc0ʻ\x990~\xccM\xcd\\\x96'P\xc6\xda\xdc\xdfO\xa6@\xdbV\xaa\x9b<6\xd8\x15\x97f\x95hx\xd6\xc7V\x16\xa0VvI\xb2\xe2Ao1:W\xea\"\xb6\xee\x02\x9a\xedX\xd8 \x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00G\t\x1f\a\x88\x99o\xf5{jơ\xacy\x91\x17K'\xd7r7G\x95\xb1d\xb5\xb5a\x04\x90\x02\xa7킢\xcc\xee\xcek^\xca\rYWw'QE\x93l(\x9f0Jm\xb0\b\x81\xbbI\xc8\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x05m\a\xa3b\x81Z^\xc0X\xae\xa2\nĞ\xe1(A\xc5<\x86\xf3\xb1@V\xf8vX\xe0W\x97\x84\x16T\x00\x00\x00\x00\x00\x00\x00?\xff\xc4\x005\x10\x00\x00\x05\x03\x03\x02\x03\x06\a\x01\x01\x00\x03\x00\x00\x00\x03\x04\x05\x06\a\x00\x01\x02\b\x10\x11 7\x14\x16\x17\x12\x15\x1801P\x13!456@A3`\"2p\xff\xda\x00\b\x01\x01\x00\x01\b\x00\xff\x00\xf5>h\xe2\x81\x12\x01~)\xc1dX\xf8\x0f\xc8@\xa4\xc8\xe3+\xd2{\x91\xbc\xaf{Y7\x9f\xfcK\xa1\xe8\xd6f\x15\xb1\xa7\x03\xafU\x96\xb5\xc4\x01\xa8\xbb3In\x1b\xe5cC\x18\x18ȹ\x8a6\xdc\xd2+\xf1\xe6ܾ\x1e\xe8l\xea\x91\xe8\x99|\x03^eN\x11\xfb\xd30\x8b\x83\xff\x00\x846t\x9aqQ͜\x9256%\xee:k\x15EIA\\\xe0\xe7\x94~Lw<\xbc\x18\xd7\x00\x99\x96D\x80א\x13|r'\xfe\x05\xcc\xe6Gh#\x1bXX\x94e\xe5\xe9 \xed\xc2\xcb\xe6 8\x16Zꅕ\x91\xa2)\x95.H'\x89#\x7f\x7fZYM@K<\xa8\xa5)ɪ\x92J\xeefE\xf9ɪG\xd1ϔPO\x87eR\x92Z\x1d\xecc\xee+\x0ef\xeb\x7f\fsY?\xa8(\xa0\x8f8Z\xfa\xa2\x8d\xf0\xbf\x16'\xa9x\xc0\xcd\xeda\x91\xe5\xd8\xcdw+bL1\x03\x14<3ïQRvnE\xbcک}V\xc6\xf9^ֱF#\xdc\xfe\x16\x10\x99\x96\v\xe4\x96\x17\x10\xd6xg\x86Yc\x97S=ت\xcap\xa7.\xa5\xb5\\i\x8e\xe4\x04\xb5\xe4߷H\x13\xa31\x89\x90\xc4\xec\xed\xd4\f\x86踁\x000\xe3\x18\x171\x86ߛ\xd3m\xec\xech\x8daPY\x1a\xa6\x1b\v\x82M\xe4\x84\xe0Ds&\x04\xa6\x8b\xd16?2a\xb2M\x8eR\xf7\xe7\xa598\xfa\xb1\xd2\xc4\x13\xd8Z]\xe40\x0f=\x9b\xecƣT,CC⸥\xf6\x9b]\xd0\r\xc2[~\xe9l\xb0\x81\x8ey\x94\xac\x90\xa6\x82\xa0i5S\xa7LO뤯\x98h\x9d\xfba\xe3䒉\x9a>~V\xd42\xa3\x8f3H\xed?\x92\xd2y\xb8\xd9\nx)!Es\x1a\x14\x90RŲ\xdfQN\xfb\xb9d\x13d\x01\xe8FFRp\xaa\x90IK\x8a\xa2dh\xd92\xd9Z\x9c\xae4\xb6\x9a\"\x82\xe2\xb5\xf5i\x87\xbcxŮ\xe5Gw\xa0\x10\\H\xdaM\x8b\x90䔋\x82i\xc4\xdeUj\xad\x1fFV\xe8O<i0\xf13\xc4\xda\x0e2\xceƺ\x1a\xe9\x7f\xb5\x1b4X\x89S&\xcdL\xb3\x01\xd9\rG\"\x04>Zz\x81䣥O\x90\x86e\u0092:U\xca\x1e\xa7*\xc8M\xd6\xf2\xe2ȦL\x0ep\xc0\xe6Lti\xae:\t\x15\n\xee\xf3\xfbj5\tMn43t\xee/ZlESE\x8dp\xcc\xfe\xfa\x8c\x8eCs63r\x91\xe9ҫ\x8b3\xede\xc4\x11~թI?3F\xf3c$t\xa02\x1dΫ\xda\xe8\x81i\xdeY\x12\xdc\xe6\xa9\x05ʩ!d0\xc6ɛ `R\xc6\xfa\x1bN%V\x9a\xe2r\xdaKvJi/5R\\\"NR\xd3-I\x8a\xb0\x80\x89\xd0\xd1@\x15\xd0\xe7AC\f\xa1r䊖*XQ\x03\x041\x04\x14\xac\xbd\x1a\x19T\xb2`5\x94c\x1ed\xa7u+֣%U\xf6\xc9\xd2-t\x06|\xc6\xfcj+\x97;DN\x00\xa0L\xa1\xc2\xe2\a\x80\xb8g\x86r\vk\xca\x0fW\"\x15\xba4\xbe\xafr\x12(\xa4\xaf\xf6\x99-\xe2\x13\r\x9a\xae\xb9s&L\x1d2`ɝ\xd1QU\\JdҒc\x8d9\xb6۠\x97>\xe8\xc0 \xc1\xc3\x00\x83\xd9\xce\xcck<\x89\\\xa2\xf4\xb3\x03*\xb0\xf0\x19a\x1f\xe5i\xa9;\x13\xb2\x81!\xefZ\xa1USNa\x91*S\x9b\xd4\x06\xac\xa6\xaf\x17\xa0\n\xa1\xb4\xff\x00\x0e\xad=\a \xe2n\xb3\xe0\x17\xf3\x85X\xb8*EK\x00L\xb1r\xa0V\xa8\xd3\xc3'\"\x930\x1fD\x1cn\xe4\xa5f`\xd5o\xa5\xbe\x7f\x88\x02\xf6\xb5\xeb\xc4\x03^ \x1a\xf1\x00\u05c8\x06\xbc@5\xe2\x01\xaf\x10\rx\x80k\xc4\x03^ \x1a\xf1\x00\u05c8\x06\xbc@5\xe2\x01\xaf\x10\rx\x80k\xc4\x03^ \x1a\xf1\x00\u05c8\x06\xbc@5\xe2\x01\xaf\x10\rx\x80k\xc4\x03Z\xa9u\xdc\xe2\xd2\x13d\r\xf1\xc7,\xf2\xc7\x1ca8\xb0\xb4~\x80\x11õ<:\x16Z\x91\xe1\xe3h\xe8.\x95\xf6\xd2\xd0+idLfl\x913\x19С\x868B\x84,\xed\x16\xe3\x1f\xaf\x86y3\xe4\xe9hl\x02\x91\x8f\xda\xf4\xecj\xa3<\x90\x8e\xa1\xac\x01\xa4\xb2\x16?a\f#$' %\x11JM\xdb\xf2\xabq\xbe\xab\x84\xb5\xde\xe8\x00\xf4\xc4\xfc\xfa\x94ū\x18\x03\x8bW\x88\x06\xbc@5\xe2\x01\xaf\x10\rx\x80k\xc4\x03^ \x1a\xf1\x00\u05c8\x06\xbc@5\xe2\x01\xaf\x10\rx\x80k\xc4\x01\xf5\xbf\xf7\xb4\xf2\xd3\r\xcd!\x920f\x94\x9c\xad\xc4c \x95T[DIr$\x1eIVB\xd3\\x\x8a\xb2\n\x9eK*\xa4\xd0R\x14Վ\x98\xd5;\xda\xea\xf72Y\xa0\xe7$\xf2m$/\x90\x95\x1aA=X\x8b\xe9?*\x11[\xc1\x06Oi\x98\x17u\x89\xea1ET\xcd8tu\xb4\x97\naE4\x8a\x92gǂ\xd2\xf9\xf2\xed\xf8\x0ekq//\x84\xd4r\xed\xa8\x15\xbc\x16\xa5\x05\xeb\x05\xf7-&\x91\xc4$\x97\x8a\x8deͭ\x7feyEYQeL\xeaƙԕ\xcfF\xbe\u0085/\"\x96p\xa1\xab\xa4\x1b5\xa6i %\x8b\x92\x01\x92\xd6,\xcbj\xa2\xb7\x8bSЀIO\aZx? 13\v<\x04\x0e6y\x00\xfbg#\xad\xe1R\xb1\x85\"\x91\xc3\xcct\xdb֓\f\xa8\\\xb3ԾU$\xc2o\x06\xc2\xfa\x85\xd2\xf4\xfb\x119\x13\\\x80;W\xe9\xf2\xeb(\xc9j\xac/\x9a6l\xc1\xe3fM\x99\xfe\xa4\xe9\x14\xb0\x19\xcc|\xd5\x10i$\x10\x8c\xaa&\x807\xc3\xf4AY\xe9\xee\x1f\xc2\xd5,\xa0\xa56d72:Ei\xd6=g\xbe\xf0w\xdd\xc7}?C\x950Ñ\xbbZ7q\xad\"\xecZ\x00\x88\xc4.\x0ey\xdfO\xd0\xe5M\x8d\x946\x93\xf8\xfaJ%i݄\xd2|\x9au`㾟\xa1ʔ!\x88ͺ\xc2s\xab%_\xebz\xb5 @\xf1A\xe4$cF/\xa7\xe8r\xa7\x96\x9by\x98\xf6\x011\x02\xa1\xe6\xba#\xa8\xfb\xc8\x05k\xf4i4\xee\x02 \xbbIlㆣWJ\xaeJ\xcai\xc9\xc4\x11ȕOO\xa1̗&\\c&\x10\x9f̷)\xc1H\xa3\xd5\xe9\xfcl3\uf5d9\xc0\xbeL!)^;pd\x01\xf0\a\b\xc8\x00\x8e_<0\x17\x01\x03\xcdgL\f%\x151\r\x95i\xb4PY(\xc0#\xa1\xee \x81\x84\x18\x82\t<J\xb6~\xad\x06\x94\x95קx\xed\x9a\xfa(\xeb\xcd\xc7}?C\x952C\xf1\xc3R9_YE\xa6Jy5w\x93I4\xed\xf4\xfd\r\xd5\xf4\xfd\x0eS\xed8\x923\xd1ؚB\xb4\xfb\x19\xb1\xde\xed\xb5\xb3\x8e+\xe9\xfa\x1c\xa9\xc2&\x8f\x99\xecA\xd5\x10\xa98 \xc7>H!5;\xdbAvB\xfd\xe9\"\xad\xfe\xd5\xfe\x97\xa9\xe3\xbbO=\xb4\x8d~\x01\x90\xb6\x9f\xfb>\xf2\xdd;\xf4\x85\xf6ԏt\xd56\xd2G\xeb\x1f[N\x1d\xadzU\xfe\xb7\xabS_\xf8\xc3{mQw \xae\xda^/\x81\xb7\x9b\x98\xb6b\x87\x98Bf\x18\x9b\xe9\xa5т\x1b\xfa\xe9CЂb\x16\x19\t\x9b\x93Rr\x11\xf5\xa3\x06\x11\")\x039\x19\x9a\n\xb1\x8dP\xddZ\xd1\xe1O\b\xda\xc9g\a\n\x1eH\xb4\xfes\x02\xceg\xaf\xae\x89\x95\xef\x95\xef{\xfc\xa8\x86tQ`\xdc$uv\xfb\x91\x01Ԛ\x1a\x92'B\xda\xf2;i4u5\x99~z:\xf5\xc4t4\x0e\x92\x04M)\x9e&@\x9c\x88\x80Q\xac\xf1ZC)ZI\xfd\v\xe3mB\xf6\x85ٴm\xdcV\x0e\xf2\x87qߛi?\xf8\x83\x8fmK\xf6\xc0\xde\xc9?\xba&֧{h.\xc8_\xbd$U\xbfڿ\xd2\xf5<wi綑\xaf\xc02\x16\xd3\xff\x00g\xde[\xa7~\x90\xbeڑ\ue6a6\xdaH\xfdc\xebiõ\xafJ\xbf\xd6\U001082a4\xe2U$\x92\x96\xdf*9$4b\xa6kT]\xc8+\xb6\x94\xff\x00\x9e\xaeԲ\x87\x93vGx\x10\xbe\xf0l|\x8a\xf1&\xefR=\x14H\xe4dV\xb1s\xb6\xca\xd6\xca\xd7\xc6\xee]+-\xe6\xb6c6\xe4p\xc5%\x1d\xb5\xcb!\x952P\xa1\xf2\xa6\n\x1c@\x8e\x18\xcds٨#V\xa2\xe4\xf0]*\xa0\xb6\x12:\x03\bA\x84\xc00\x93\"\xc9\x15c\x1b\xe6N\xf6\xbd\xaf{_dD\x93+\xeb))\x05\x1f,ux\xfdj\xc8\xcaԄ\xe3]l\x1d\xc4\xfa+wUN\xd2\x18`\x12\xe9MW2\xb3\xc2\xd7:gU\xac\x8cC䫇UNS\xa1\xe6\x12\x13\x85\xd2\xe2v\x1d\xf1˽Zd`䨶i\xe0vn\xee\xa3\xd7m$\xfe\x85\xf1\xb6\xa1{B\xec\xda6\xee+\ayC\xb8\xefʹ\x9f\xfcAǶ\xa5\xfb`od\x9f\xdd\x13jNp\x14\x95au\xa5\xa6\xf7\x14\x98`2\x8a$\f\x8bmS\xc7\x18ۋ$jN<XTML\xc3Q\x88\x86R\xa4\xf5SbT\x13*6\xe3\\\x1d6Z\xf8\xa6\x8e*R\x9fYOF\x12\xfb}7`5E\x1c\x84\x00A\xe5\xf1Y\x1bT\xb8\xf0I}=\x0e\xae%T\x17'7caܢ\xad\xb1%F\xe4\x82U`\xdad\x8d\xa8&3\xa9\x96\xe3B!\x7f\xad\xea\x15\xfc\xa56U;\xe7\x96[\x1d|\xe2\x02\xa7\xc5dmS;\xe5\x1eAv\x80\xb2\x91\xc5B/\xf4H\xe1Ф\xac\xb36\xbd\xdaR\x02\xfaj\xd2\x06\xfa_\xe2\xc82\x953\x9e+\xccU\xc2\xeb(\x91\xdc\xd4\xd1\x7f\x97\x00\xb5\xb7PSNI()\xd5\x19wP\xfe\xf7\x00\xd2\x03/\xa6-t'2߈\v\xea_\x14\x91\xb7\x16\xb5I\xea\xcc\xf7\x03\xa8\xe2\xcbWhϸ\xac:\xd4\xf7r\xb0\xae+\x8a⸮+\x8a\xe2\x99\xedU\x17\xab\x899\xbe\x9a\xeek\x9ff8T\x90\x14w\a\x10\xb3\x18\x1cFl\xea\x06&i\xa0&!&H\xce\"\x0eǳ\x85p\x85AR\xbbf5,\xe4\tnڧ\x8e8\xe2\xa4\tu\x12Qb\xaf7\x1a\xe4 \xf9YK\x1bd\x03>\n\x94\x11\x1d-\x85sc8\x15\x80\xc6\xf9bzT\xcd3+\xd8\xe3\xe1D%\x87\x93\xa9L\x1a\x83\xe6\x16\xacr\x80\xacAg\xe2\x9a8\xa9\x82qf\xbfY\x83\"\xa5Q\x01\xb0.t\xa0\xd9\xc6S\xeac\xf1\xc8\x1blQ\xa6\x92\xc9\xd2f\f5[\x13+{W\x83)G\n\xd8\x12f\xc5(rP\xc0h\xc3\a\xf1IJm/$7\x8a#\x87\xbc\\\xff\x00\x0e8p\x1bX\xcf⸝E\xb3\x17\xa9\xcbfS\x81\xb92\xbc~i*$Ք\xdc\xc4p\xf0e+\xc1\x94\xa9JJL\x8b\x11\t\x1a\xcaN~\x05\"\xb8\xf0Z\x0e\xa3\xf9\xb1\xae\xbe\xe6l\xa1\x96\xf7\x96+\x8fՔL<\xb0ܯ,7)ޞI\x01\fҢS\xe1\xf8\xd3f5\xdaN\x1c\xa4\xa7\x82k\xe1\xc7ed\xed\xa3W\x8ak\x1dŚ\xaa\x8c;!\xa1\xc8!8\xfd\xdd\xe5vͭkZ\xedv\xd5\xefn\x85dDEЁ\x01Y\xf8b\x05m\xaf\xe6\x80\xe7\xf4\xe3N\xaf+\xdfˮm0=\x12\xad\x98\xc8J\x89\n\x88g\x04$\xab\xd5j\x89\xe6\x06\xd9o\"53\x7fȤ\x12\x1f\xa4ڡ\x91n#\x8c@\xa8\x87\xbc\xb0ܯ,7+\xcb\r\xca\xf2\xc3r\x9e\xe1Y\xaeF\xc7\xd2\xe3\xb7h\x12=\xf20\x9e]\x05\x10\x90\xc1\x98-0\xcbm\xe4\xc5G\xbbHn\xac0\xccL\xf0\xc3\x066\x9b\x9e.LA6\xb8\u0601\xe3V\xce!\xde\xc0\x17\b\xb8X\x02\x0f\x16ߋR\xd3i\x01\xc8\x0f\xe0,\xbb\xf4\xba\xd6S\xc0S\r\x87\xa4r\xee`\x99\xb0K\xbd\bK\a[\xeb)j\xe4u\x10\x9aYm!\x95%\xa27\x0f\x19To!(\x1b\xd9)0\xaa3\xed\xf6\xcc:\xe6A4\xd8p,\"\x1b\xe9ӫ?\xcb\f\x12\xea#Qb\xe0\x94\xc3,\x00\xdbPO+\xba\xe4\x03ŀ\xda\x14\xee\xa3&\xb0\xc3\f=\xac\xb1\xdfU\xf8\xe3\x83q\xa5\x8d\xba4\x8f\xfaw\xef\\\x9f\x12#I\xb9\xa2\x88p}&&g\xff\x00\x04\xa8VWc\xdf\v\xb4\x0e\x19UWN\xb2T\xad \xe9\xec\xdaq+\xb8\x18\xfdQ\x97q\x98TY\xaa@\xbb\xadUϗP\x05\x8b\x94\xc3\xf0\xc0\xbf\xd2\xf5>\xf7u\xe5\xd4\xd0f\xaf\xbeV\x01IC\x8d!f\xc4x\x00&\xef\xf2O\x90 \xa6L\xc1#\xf2\xe6\x9dGE\xc0\xd2\xeb3\xa2\x136\f\x81\x18<ド@fU18\xbe{J\xa4\xc6*\x92\x96\xef#\xa9\xc6\xd9oz =\x13z#֨\xafW\x8a\n\x16 \x06\b \x84\x10\x1b\xca\x0e\xecY\f\x85իg\x9eY\xe5\x96Ym\nwQ\x93V\xfa[\xa3V_\xc7Z}:`\xe2\xe8\x12\x9d\xaf\x1a\xeaPf\xeaP\b\xee\xc6c\xa87\x92\tu\xb0>F\x01\x04\x1dĸsL\x12U\xd0\x11\xc7\x1b`P\xc4\x04A\x02\x13\xa22\xee3\v\xe4\xdf\xe9z\x9f{\xba\xf2\xe9d\xb3\x16_\x8b\xe5\x11\x12X\xacD(\xfd\f$\xa4\x8f\x99?B\x01f\x11ד_x\x9d\xe1v;\xed\r[;_\x9bZ\xfb\x8b\x80c\a\x98Y\xab\xb4\x05\\\x8e\x1eq\xee}\x10\x11\x02\xcc\xc6k\xd6LRn\x944A\t \xb1\xdd\xf5H\xf1\xb9\xf5\xf4֡}\xe1N\xea2j\xdfKtj\xcb\xf8\xebO\xa7K߰\xcaU\x14\xb0Đ^I\xe9Y\x97\x04\x12\x85\xc1,\x05\x1bw7\x93\x9c\x89m\xa3u\xcd\x181\x81`\xb2\x1b2OF\x8a\x80\xf7.V\u05f5\xf8\xbd\xb7ԜV\x1e8\x88\xfaF茻\x8c\xc2\xe9潪\xf6\xabګ\xdf\xf2\xbd\xaa|\xee\xe3Ǡ\x10E20@\x03\x10Fţ\xa6\xc0@\t\xd3{\xda\xdcތ;Z\xc4\xf2\xc8#$US\x14\xf0\xbed:o\xf4\xa9\xf63\t\x92\xbe\x12\xbaN\xf0K\xc7\xcd\xd1\xda]\xc6\xdc\xda>V^LW-5\xb4|\x9b!\xad\x94\vd\xd2\x06Ք\b\xa7\x93\x90\x01\x01'8\x82\x1fJ\xb6ƌ\x97&T\xc1\xa3(\xd2a%f\xe3\xa5\xe1e\xb5s\xab\xeb\nj緅;\xa8ɫ}-ѫ/\xe3\xad>\x9d0_\x84\x19J\xb4\xea\xcb\xc1\xb2\xc6\x05T\xc6\xc3\x12(k2\x82\x18\x9e\x8f\x9e-.;\xb1\x04\x9b\xdd\xe4\x9f\xfa4-CJ(\x99\x87\xed\xa6ɑT\xcc\x10H\x8f\x89\t\x8c\xf8\x85ԃ\xcd.\x06\xceP_M\x01}Ǳ\xd2eO\x936H\xdb\xfd\xa4;\x1d\u07b6\x82&\xf1\x97q\x98]3\xd2\xfa\xf1\ta\xd6X\xa7\x9b]5\xe6\xd7My\xb5\xd3^ltP\xe6\x064.c\x0f\xbe\x99\xd98.\xbb\f8Nt\xc9ڐ \x820\xe9-\x17#\xed\xe0\xee\x13,\xd7y\xa0G\x18\xb0\xc1\x8c\v\x1bP\uf5a8\xa0\x80\xa8\xcc|\xb7\x1f\xa8\xb8*!\xf48\xd0@y\xa6?#\xe3\x86\x00\x18\xa8\xe3\x006\xdaix\xf9y\xf1\x9a0\xfd\x1a\xa3i{ͨ\x9c\xe4/\xb6\x9aZ\x81)\xba\xcf9Närx\xba\xdd\xf2\x91\xed\xa7U3a2\xac\x86\x9b?\xa9\x94e1\x9aQ\xaa_D)\xddFM[\xe9mΞ(\x98L\xd9㺝>YY\x96\xc3R+ѥ\x12\xe0\x9bN\x91\x8b\f\\\xb8\x04\x8b\x80T\xb6\xfa\x82\xee\xfbǢ\x19v\x96\x92\xda\xcb1\x93\xb1\x19,\xb2\"BZQm\xf5^ڵ\xae\xd7s\x85\xbce\xdcf\x17N\xa2;\xc4\xf0\xe8K\x8bN\xaaG*\xef\x9c/\xd1\x037,܌\x90-}ݏ\x81Ap\xa62\x9b\xba\x85\x96\f\xa7a\x93\x11\x0f\xa5\x88\xf8Z`/\x96XJk\xb9\x92\x9d\xc8\t\x8b\x89{\xbe\x17\xeeמ#\xb3w\xd4\x1bo\x16\xf4\x98\xaf\x98[\x136d\x81\xb2\xc7\n\xb3\x1c\xc5^\rT5\xf2\xfb\xad\xa4\x12_FVG:\xb4\x92q\x05]M$\xed\x1bL;\x1fBH\r2-\xa4\"m\x84\x04tB[\f\x10n9lC\x86$\x87no\x87\x9a\xea\xe7L)\xddFM[\xe9m\xe5\xd6\x12\xe4\x80زJV\xa2H\x19I\x8ec\"\x06\xba4\x83\xff\x00\x17\xff\x00N\xa0\xbb\xbe\xf1\xe8c\xb8Ej;\x9b\xabx[\xe9Ѩ\x84\xbc\x14\"\x95\xe1o\xbce\xdcf\x17K\x8e\x1b\x8e\x9dk'\x96\x96>\x1e\"*\xf8x\x88\xab\xe1\xe2\"\xa5\x06\x94t̏\xd7\x12\x8eJv\x8e\xac\xe0'\xe9\xf6\xc5\v\np\xc9r\xc1\x11*\x02y2\xa4\xc0\xdd\xcaM\r\x9ae\xdf\"]@\xf1\xb5C\xc7O\x9d\xea\xd2\xc3\xc8B\x8b\n\xcd#\x1b\xea\xb43$ϰT\xc0\xd5\x00@\xaa\xa5GnR\xdb\xe9U\xe1l\x82\\h\x19\xe8\xd5\vG\xddN\xd2. !\x96w\x9d_\xe8ĆF\xc2\xefɝ]s=\x94\x8e\x96KN<\xa2jG[6ć\x85.k\xa6\x14\xee\xa3&\xad\xf4\xb7F\xac\xbf\x8e\xb4\xfat\x83\xff\x00\x17\xff\x00N\xa0\xbb\xbe\xf1\xe8\xb55\f\xe6}\xae\xdb9\x9e\xf2\xa1|LFϬ/}\xe3.\xe30\xbeL\xdf\xdaו_\xeb}\xe3\xf0\xb10\xfcd\x83\x95\xba5$v\xe5\"\xe5@~DF\xa0*d\x98\xc8\x1c;o\xa8\xd6\xe5ףc\x86\x81\x973\xb1\xbd<EC\xf40\xdd#\xb3\x1d\xc8+\xe0\x970\x01\xb2\xe0\x19/\xbc\xda\xd2\xf3\x84v\xb4\\(=\x10\xdb>3Yt\x17c4\xca2[I\xe8\xe0\xec\xe1'\xef\\\x13\x92\xb2\x9f\xdeWv\xbf\xcf\x00\aL)\xddFM[\xe9n\x8dY\x7f\x1di\xf4\xe9\a\xfe/\xfe\x9dAw}\xe3\xd1jg\x81\x99F\x8bT\xb0\x9b\xca\"b\x04p\xf9\xbd\xf7\x8c\xbb\x8c\xc2\xf9\x00\xb9\x9b\u00ad\x1aB\xc2k\xbd\xaf\x15\xbdj\xff\x00[\xef\x1c\xe7`\xe4\x16&y\x06\xa2TU3\xa9\x96\xdfR\xa4\xf21\x18\x1c\x1a\xddq\x89|\xcdH\xccPö爕Q\"l\x91\xb9\xcd\x14\xc3b\x0fd\xa2\x1a\xe8\xd3{\xc2\xce6&\tfz\x13\x92\xd3҈&\x90)\xbc\xa6\xec\t\x8e\xcb]\\\xc3<\xaf\x9eYe~\x98S\xba\x8c\x9a\x12eCD\x91V\x19\xab\xfb\xea\xcb\xf8\xebO\xa7H?\xf1\x7f\xf4\xea\v\xbb\xef\x1e\x86\xaa\x18\xaeW\"\x1a(8\xe3l-lq\xd9IH\x829\x03j\n\x12+\xd3\xcc\x10C\x89|;\xef\x19w\x19\x85\xd3\xce\xf3L\"\xe1\x90\xdc\xe4\x96Q\x10\xe2'\"\\:\xe1d\x8f})HT\xe4@6\xd7^UD;IG\xb2LSN?\x84\x94\xea\xc2>\x93#\xd7Fx瀘a\x9e;H\xcd\xcc\u074cgB0w\xb5\xed\x95\xed~\xad47\x05X\x90\xf0T\xbfF\xac\xcf\xfe\n397\xa6\x01xyNBN\b\x7f\x91\xaa7\x97\xbc\\I\xedR\xddP\xa7u\x195$F\xeei\x1ahq\x96Fm\xa3Y\xba\x80\x8c\x8f\xbe\xac\xbf\x8e\xb4\xfat\x83\xff\x00\x17\xff\x00N\xa0\xbb\xbe\xf1\xe8\xd3$r=\x8c\n\xf9Q\xdfSn\xf3\xean\x14\x96:v\xa1\xc5\x05\xac\xc0\x8f\x18\xe0o\x19w\x19\x85\xd3;\xba\x1c\xa9\x92\xb3\xa8\xa1\x1f;\xbc\xeb\xce\xef:\xf3\xbbΛ\xd2i `\xe7\"i۽\x9eTh٣\xc6F4n\xadO\x9c,\xfcӛYx-8I\xb6^G\xb39S}B\xc6c\xb4܃8Ht\x95*d\xf9\xa2\xe5\n\xc3\xd1\xe61\xd3H\x12C\xf4j}z\xcaR\b\t\x81\xf4a\x9eXe\x8eX\xc6.\xfc^\xect\x15\x8c\xba\x9cKd\xdbHJ\xcbG\x96UN\xae\xab)*\x9e\xea\x85;\xa8ɬ0\xc3\x0fn\xf8\xef\xab/\xe3\xad>\x9d \xff\x00\xc5\xff\x00Ө.\xef\xbc+\x8b\xd2B\x12\xca\xf9\xab\x14H\x8d\xb4\xcco1\x8b\xa9\xbeA\x00\x12\xa0\x82\\\xbe\xd7\xfaSU\xb8#\xebP\xee\xf5\xd1槎\x0fI\x01\\\xe1}\xe3.\xe30\xbau\r\xde\x17\x85qz\xe2\xf5\xc5\xe9\t\x05]̪Q\x1d!q\tU\xb6\xa8m%[}4\xb8\b\xa9\x91u\xc7\xea\xca\xc4W\xa37\xb9\xa2\xa1\xc7n\xf0\xdf,\xd4G\x05\xb6UHL^L:\x96\xab#i\xc1\xc8\xde\x18s\xeda\xc0\x18\xb0\xc2\x02?\x17\xae/MF#\xb1\xecnŐ\"XA&=\xb6\n\x8a=\n\xea\x84\xd1\x12\xd4\x15\x0f8\x96\xcd9\x17U֍\xf4\xe9a\xe3\xe0V\x95\x9a\x86:\xb5J\xf2\xf0\x89)-\"\xddp\xa7u\x195o\xa5\xb7\x14P\xc0\bQ\x84Ԛ\x9e\vL\x18\xe9W\x0e\x8d \xff\x00\xc5\xff\x00\xd2\xfddF\x01\x80\xbc\xf0r4\x95\xa2G\x10\xa3\x04\xd2I+b\xa4\v\x03n\x83\xa3\x88T\x99\x91\xc3\x7f-\x01\fF\xd6n\x95茻\x8c\xc2\xe9\\\x8a\xe3\xe7\"\xa1\xb5ec\xb0\xb4VL\xa1\x83\x18\x9fV\xd3rP\xf9\x16Q\xf3V\x96\xaa:p@g\x1eh\x805geh\x96\xc6^\xa4/\xbbuyA\xb0\xb8\x96\xb4\x9d/\xb6\x93\xa5f:T\x90\xd6\xd2\xfb\xf8\x12\a\x8f\xb3\x0f\xf4,6\x1bn\x1bce\x81\xe0ؠ\xc6w\xccD\xe8\x8a3K\xcb\xda,\b\x01\x17\v\x00\x81\xe9\xd4\xf4\x89\x80%\xc1c\xa7\xf57\x17\x0e6\x97RV\x89*ȭD6\xe2+\x91E\x95)3\xdf\xe7\xd5Ƞ\xee0\xa1\x17\fAD\x91]\xa2=\xdeK\x8b\x97\xeb\x85;\xa8ɫ}-\xbc\x9e\xa3d\xa8\xed\xecn\xf3\xa8v\n&\x88p\xe9ҹ\xac\xc9$\xc9f\x03@Z(\xe0DHX)\xb9\xf2\x04UI\x19\"|\xa1\"i\xe5\x80(K\xa6C\x91\x10\xe3\xa4<Ԕ]\x0eu\x87\x8a\xe1\xf5\xb5~\x88˸\xcc.\xb3\x84\b\x9f\v\x10M\xfb\xbc\x85\xb1\xf6,A\r\x19&\xe3\xe4\x9d>\xf7u\xe5\xd3\v\xca\xe3\xc7\v9\x97;0ĖI\xbe/\xe6$M\xa8t\xa5\xe2\xe5Q\xde\x16\xbd\xaf\xc5\xed\xf2\xa5\xb9I>5D\xbeX\xa8\xa8\x1cU>p\xf9\xee\xb8lp$\x98\x99\xdb\x1e\x9eӪ\xf1v\xa4\x98!\x05]\xf5\x14\xf2\xbbe\x82:p\x1f\"\x14\xee\xa3&\xad\xf4\xb6\xfa\x99Y\xb2dj!\n\x9e{Q\x11t\xe9{\xf6\x19J\xb4\xeb.\x95@\xbd\x99\xeb\xff\x00&L\x9d\xdb,\\\f\x90 \xe8u.<V\f,-\xf4\xc6]\xc6aS\x8ef ʑ\xfc\xb2\xe0\xc7<D\xc7\x1c\xb1\xea\xbf\xd2\xf5>\xf7u\xe5\xd5\x12\xcd\n\xb1\xd8\xd6O:\xf0\x85\x1b2\nvnع\x06F\x93\xe2\x85\x1c\x12\xb3b\xbe\x10\xdf\xcd\xf2\xeb)_\"T\x9bP\xa3\xd0\x06\"M\xc0\xe0Wt\xab\x1cWX\xdaI\x80\xf3\x8e\xdb\x17]Έ\x15\xf1\xa7J\x15\xaf\x84\x91j\xfaG\x1a\x9fmk\xb2]k-\xeb\xe9հ\xf1\xb2\xf9'jN\x10\x89[\xcc\x06\x9e\xc2m\xcdM1|\x95!\xbb\xb28F\xdaH\x1e\xf8[\x9f\x84q\xaaGd^>t\x9a@\xbdD\xd1Fr\x90ˁc\xf0\x8e5<\xf4ۛ=\xac\xb6\xbftds\xcb\xca\xc9\xe9$#8FDm>\xdb\v\n6\xfc\xadm\xb9\xb5N\xcc\t\x1eBp\x90\xc1%\xe7\x11(\xbe\x99\x8c\x14!\xbe\x11Ƨ\x0e\x97E@o.-]\x9e\xca_}\xaa\x0e\x96\x85\xf0\xe5*T;\x12\x1f`\xa0/\x06\x7f\xe1 j\x8d\xda\xefV\x01\xacP\x14\xbd\xabW\xb5j\xf6\xad\\\xdbew3u\xbfnV\x1c\xba\x97\x8fQ\xb0\x13\x04\xa7\xb4\xfc\xfcx`1Pz\x82\x0f1\x84\f<\x19p4\x92\x88\xf3j\xa9\x1d\x9c\xa27\xc3\xd5\xedeDFzF\xa5Y\xa4\x01N(t\xfe\xa8\x8da|@a\x1aw\x8c\xda(\x13\xc3ڵ.\x1fSON\x18t\xb3\x7f\x14\x03.\xddL\x06\x92\xf3\xc4\xf8_\x80\xe7\xbd\xed{^֖!Y\x01\xd5!9\x16\x12\x94\x88\x19JP>\x9cox\xd2\x04\xceEl\xfb\xf6\xcffϓ\x9dKH\x14\xd6x\xb8\xd9JVP@\x8e\xdc\xc8s\xebuL\xb3\xb1\xbf\b\b\xc4r\xd9e\x9b\xd3\xcd;d\xd6C+\x01,\xb4\x94\xear͈\xcbY5E\xd2Y\xa13\xcf1$\x98\a8\xf1\xae2\xf5\xe8\xa0\x174h\xb0\x15\xa9\xde\xda\v\xb2\x17\xefI\x15o\xf6\xaf\xf4\xbdO\x1d\xday\xd2+\xe1\xde\xdb'\x99$hU\xf9g\xcb\x1d;3U6*\xa9\"F\x0e\x95\x04\xb6\x9e\xa1\x9d\xed\xe4\x95\xf2G\xfdV\x92\xa8\xa6Wȱ|\xb2\xadH\xf7MSm$~\xb1\xf5\xb4\xe1\xdaץ\x10P:\x92|\xb1\xf2,I\x99\xda\x03\xbd\x04g)C\x05\xce\x16,h\xbdj\x11\xfc\xf3l?\v\x92E\xf5\x86O\xa6\xb2\x91\xd3I\x8cA\x8cԉ\xdb\xe7\xed \xb9\x17Z\xe7\x04:\x89\xeb\x14\x9fQ\xe9\xe3\x8alVyӵ\xa8\xf7\xbb\xb5\xac\xe4@\x01\x0f\xd6)>\xbdb\x93\xeb\xd6)>\x85\x96\xa4\xb1\xad{dy\xea\xf1S\xc2\u17fd\xef~y\xf9\x18g\x90y\xe3\x9e\f)RDS|\xb3\x89\x1c\xd4\v\xfd\xe6\xd9~؊/\xacR}z\xc5'\u05ecR}z\xc5'\u05ecR}z\xc5'\u05ecR}1\xe77b;\xa9(\xfb\x91\xeb9;\x95\x9d*\xc7ۂ7V\xd5\x1bj\xefs\x9b零57wQ붒\x7fB\xf8\xdaXs\xa9\xb3\x18KK\xe9Du^\xea\x0f\xf5\xed\x9dPf\xbe\xbe\x80\x8b\x9eҴ\x92\xfb4\xf0v\xa4e{\xde\xf5\xa4\xff\x00\xe2\x0e=\xb5/\xdb\x03{$\xfe\xe8\x9bZ\x9d\xed\xa0\xbb!~\xf4\x91V\xff\x00j\xff\x00K\xd4\xf1ݧ\x9e\xdaF\xff\x00\xe9 m?\xf6}\xe5\xbaw\xe9\v\xed\xa9\x1e\xe9\xaam\xa4\x8f\xd6>\xb6\x9c;Z\xf4\xab\xfdoV\xa6\xbf\xf1\x86\xf6ڢ\xeeA]\xa1\xd9=\x15\xe6q\x01\x04\x85H\x9d\xbe~\xef\x15v勶\xac?\x956?\xa0\xd1W.\xdfu6\x96L\xcc\x0f\xa4\xc9\rۊ\xd2o\xc9\xcdx\x81\xfd<\xe0\x8e\x0e\xfaf\xed\x8dM\xdd\xd4z\xed\xa4\x9fо6\xd4/h]\x9bF\xdd\xc5`\xef(w\x1d\xf9\xb6\x93\xff\x00\x888\xf6Կl\r\xec\x93\xfb\xa2mj1}\tJ9\x10\x024\x89\x968,%e\x9d\x9e-;Z\xf6\xab\xbc\x1a|^\xd56\x9a,vRw\x18+ZVZIH\t\xf9\xe3\xfc\xe6ө\xd1\xc6\xdd?\x14;\v\x14أ\xb5\xa9\x89b\xd8\xdf\xcem:\xd4!\xc2G\xe4\xc5!\xc9֖UR\x92ͽ\xae\xa1\xe76\x9dL.V\xe9\xd8\xcd\xe0\x01[\xfdoV\xa6ӱ\xb0\x13q\x001<\xe6ӭJ\x1f \xa5!\x16\x1c\x8di\x89I9)\xf2\xb41\xff\x009\xb4\xe9\xfc\xeal\x18a\xbd\xc1\x03h\xd9\xd0\xd9+\x1f\xb2A\x1f\xcem:\xd5\x02\x9aj\xa3\x99\xb6)\x0f\xefi\xcd}\t69\xfc\x03\xd3\x19\x92\xe7$\xc7x\xe5\xabKK(\xe9D^\x96P\xf3\x9bN\xa7\x87\x13}F*t\x17'Q\xe8\xc0\x97~\xb1\xc7\x1b\xce-<k\xcem:\x92\a\x04Ā\xf7\x1c\n\xd3\x02\xda\"[I\xc0\x12\x87\x9c\xdau\xa8\x95\xf4\x15(\xd8\xc0\x04i++b\xa4\x9f\x96\\\xef\xcds\xbd\xaf\\\xd7;\xf3\\\xf4s\\\xef\xcds\xd1\xcds\xbf5\xcf\xd89\xde\u05eek\x9d\xf9\xaew\xb5\xeb\x9a\xe7~/\\^\xb8\xbdqz\xe2\xf5\xc5\xeb\x8b\xd7\x17\xae/\\^\xb8\xbdqz\xe2\xf5\xc5\xeb\x8b\xd7\x17\xae/\\^\xb8\xbdqz\xe2\xf5\xc5\xeb\x8b\xd7\x17\xae/\\^\xb8\xbdqz\xe2\xf5\xc5\xeb\x8b\xd7\x17\xae/\\^\xb8\xbe\xdc^\xb8\xbdqz\xe2\xf5\xc5\xff\x00\xa5\xc5\xeb\x8b\xd7\x17\xae/\\^\xb8\xbdqz\xe2\xf5\xc5\xeb\x8b\xd7\x17\xae/\\^\xb8\xbdqz\xe2\xf5\xc5\xeb\x8b\xd7\x17\xae/\\^\xb8\xbdz5\x19ףQ\x9dz5\x19ףQ\x9dz5\x19ףQ\x9dz5\x19ףQ\x9dz5\x19ףQ\x9dz5\x19ףQ\x9dz5\x19ףQ\x9dz5\x19ףQ\x9dz5\x19ףQ\x9dz5\x19ףQ\x9dz5\x19ףQ\x9dz5\x19ףQ\x9dz5\x19ףQ\x9dz5\x19ףQ\x9dz5\x19ףQ\x9dz5\x19ףQ\x9dz5\x19ףQ\x9d^\x1b\x8c\xadk\xde\xf3br2<\x94\xe0LG\x8a\xe2F9\xb8\xf5\xa8mcѨν\x1a\x8c\xebѨΦ\x98Ն\x83\x18\xba\x14ҿ\xa2Ê\xa3\xb56;8\xf1\xcfF\xa3:\xf4j3\xafF\xa3:\xf4j3\xafF\xa3:\xf4j3\xafF\xa3:\xf4j3\xafF\xa3:\xf4j3\xafF\xa3:\xf4j3\xafF\xa3:\xf4j3\xafF\xa3:\xf4j3\xafF\xa3:\xf4j3\xafF\xa3:\xf4j3\xafF\xa3?\xed\xf3\xd3ͩA]% \x1f\xc7RU\x9cb\xc4{\xe7\x88\xca\xfa\xaci\x17\xc6\xf6Ju/\x8e\xeds\xad\xad戝\x8a:*Jf\x1b\xea\a\xb4/\x1f\xe9F=\xbba\xfd\xacQC\a\f\x84\x11ZT\x8eP\xf1\xcbƫjz9!|\xb0$\xad\xab%,\xed|Q\xd5u\x13))\xe5\x9f\xe1+;\xddK\xf6\xbe*\xcc9\xfd\xeeΰ\x05N\x03\xa9\x88\xcf$\xa0N\b\xad\xab$\x80\xef{$+j\x86C=l\xc3\"\xad,I\vy^\xe7S\x1b\x8e\x97@\xb9\x88\x9a\x8f\xa79EWظ\xe8\xdaL\xb7\xff\x00\f\xd6\xd0\xf4\xf3\x18!\x88\b\xb7\xe8\xd4\x0fh^?Ҍ{v\xc3\xfb^\xab\x88{m\x16\xd1\xfa\xe6\xfdL\xe8\xe9\xde\xfd\x10\xce-\xf4m(9G\xe2\xeb(\xda^`\x10\xbe\x19\xa8\xa3\xc6\x11\xea\x05\xb1\xf7u\xb1\xc7\x1bZ\xd6\xebv6\x13\x1eM\xe5\x14\x15I\xb6\"c\xc7mBj)V\xb7\xb5{Z\xc4\xf4\xc3\x1fؙK\x1b\xf8b\x8d\xbfӥ\xfc!\xc3E\xee\xcb@\xc9\xd4\xeco!\xd5\xf4\xc3\x1b}m\xf0\xb9\x1b\xd7\xc2\xe4o_\v\x91\xbdM\xd0\xdb>=g\x92WH\xa8&?gȇ\x1c)\xeb\xbf\v\x91\xbd\x18\xd3\x04yr\xe3\xd8\f\xf0\xc8<\xf2\xc38J$dH\x8dsǕQ\x12K!#\xa5$\x14\xfbV\xa4\bX\xdcV\xae7O\x15\x18F\v\x12Jφ.\xdbm\xa34\x92\t#\xa3\xfc\xcdY+{j\xad\x04kF\x88\xf7^\x7f4\x13\xafj\xbd<\xc1Ļ\xbd\xd4\x06\x1ax\xcb\x1ce֭\xafѪѭfB\x00\x1b@\xcb\xfe_\x93۹gW\xa9Q\x1e\xe82+ȅi5^\xc1,;\x91\xaf\xf6\xb9l\x85\x94cG\xb8\x19&!.-\x89pҒ\xa0iQZ\xd8g\x8aN\x94\x1c\xa3\xde\xd7WJһ \xa5\xb0\xcdI*\x18\x8bѿ2\xc5\b\x93N\x02\xc5\xc9\xfc\xddA\xab\xddVS_\xc2\xdabH\xf1\xf2E\xceޝ+A\xb7\x1bk\xcb\"\n \x83\b \xa2A\xe6<,\xac\xcb\x16\xad\xf4\xb7F\xacƾ\t\f\xd06(hr&\x8b\x1a.\x84\xae\x02\xf2\x1aB\xb0\x15\xaa\x14{\x10\x90\xcb\x1f\xc2\x00W\xf7L\xa6ܶ_k\x13\f\x05\x0f0\xc4\xc0<\x03\xc3\f0\xe3\xfa9\xe5l1\xbeY9\x15\xb2]p.+\xe5\xa4ԋ\x84\x92\xefYʵ4\xbdd\xa8\xeeɸT^=\xcb\xc8\xecL\xea\xdd\x1a\xb8\x16\u05fb\x00-\xf4\xda\xe0\xf7\xc4hP\xa6u\xab$\x9b\x8e\x84\xd3Y\xa6\xfa\xaehk\xa8\xaa\xc1\x87\x9e\x02a\x86x}\xf6OW\xb2\fz\xf1?_\xef\x15\xa7ԏtň\x17\xbdj\x9d\x7f\xc7<\x92Qp\xa6y\x8f\b\xecl\x19\xabtj\xc4nW\x1a\x00U\xa9\xe2\u07bb]|\xcaeiQ\x7f\xc29\\(Y\xd4\xf6\x91\xefx\xb1\xcdl?ڋ\x16,\xbb\x1d3O\xdbﺝW\xf0\x11\xc8dp\xc7\x1b畱Ŷ\x95d&\xfa\x12F\x17\xa9\x05\xc1槳\x9dj\xd4Xk\x960\x00\xd8\xf4j\xb8n_h\x01U\xabS\x8d\x9b&\xa8\xb3U\u008b\x9c\x1eW\x90\x1a\x8a\xb9Z\x96\xd2\xc3[DZJ\x10P\xf3\x04L\xc3\x13K\xeb\x1e>=2C?\xbe\xea\xc5[\xf1VZ(\u058b\xd1\xee\xbd!3\x93\xea\xd5(\xaf\xf9b=v*\xe3\xfeӥ\xb7d$&!\x9c\xadI\x06lm-4\xd7F\xa8\x06\xb0\xb2A|h\xb8W\x1cpB\xb6\xa3\xd0,\xb5\x1a\x1f2\x1f\xfbQ\xf3\x86ΦC]f\xf7\xa9a\x1e\xe8RCȍ\xf4\x9a\xb1\xf8\vδ\x7f\xbfO\xea\xfe\xf5\x94\xdcv\xc7L)\x16?\"\x8a\x7f:\xd5j\xff\x00\x84m\xb7PBLO0\xac\xa4A8\xae\xa8\x92\v\xa3\xda8.V\xd4\xc3\x1b\xc41\x99C\xf4j4k\x89*\xad\aMp<S\x95\xbe^\x96\x92\xc0[FUJ2t\xa1\x82\x06\xcd\x133\xa5\x85\xff\x00\x1e\xceXE\x12\xb5F\x8fb/\xf2gÀ\xd5\xec\x91)\xb6o\x9f\xdf3\xcf\x10\xf0\xcf<\xd7\xd53\\]ZV\x13I\xc9\x1f\x84\x8a\xeeY\xbdjA\x7f\xdf2Q\u0098i\xe9\x02˲r8\x99\xea\xd8\x0eI\xb1G\xab}j&3\xe2\xa3V.{\xdf\xe9S\xf8\x96\x12\\x^\xa3\x90\xac4\x82\xc5\x0e\xf5>\xb7\xfc\xbf'\xaf\xfb\x1ad_\xbaT\x8bd\xec\xabV\t\x1f\x8e\xdfj\xacY\rLDE\xa4\x85P\x82\x141B\xc0@\xfe\xf7)\xacY\n9y(W\xfbP\x02G\xbab\xc6\xef\xb4p\xd0\x04J\x1a6auTu\xe5\xa5uc\x1aP@\xb0I.\x85\xf15\\\a,\xe6᪷\xe5z\x81\fXh\x95\x9c&\xf7\xfa^\xa6Q\xfcD\xa0\xf6\u03a2 <D\x98\xc8\u00ad\xf4\xb5j\xc9\x03\x92\xedW\x10me\xb1[n4%\xa0\x82\x181\xc3\bP\xa7\x84{\xabŎ\x8cp\xff\x00j&X\xb2\xecn\xcc;\xf7\xcdO\xacx\b\xf4\xb1\f\x03\xc3!3\xc7\f\x10\x130CADI\xc2z^\xb3~0q_\x0f\xf6\xa2\x04\v\xb6\xe3v\x99\x1c\xb5H\x0f\xe2\xc7\x04\xaf\xb6\x9c\f~4V\x8a\x17D\x96-Ƒ\x1fy\xd4\n\r\x87\x96\x99\xd8^\xdfJ\x9a\xdb\xfeb\x8c\x9d \xe1P\xab\x83\xccQ\x93T\xc6K)\xa1,\xa3\xab%\x8c0B\x00(\x81\v\xa5\xb5\x7f\x1c\xc1:\x9d\x9f\xde\xf5b\xaf\xf8\xab\xcd4j\x8a\xd2.\xbb\"\xb3\x88U\xabV+\xfc\x8c\xd4n`\xccA\xc9\xce\xebo\"\xdb\v[\x1cp\xc7\x1dG\x16\xb0\xd1Jػil\x7fƎ\x0fa\xbd\xe9\xe2?\x89v\xba\fV\x9dB\xber\xcbtM\x85\b!\xc2\x10!](\x82\xb6\xdck\xa8\xa2\xe95\x7f\x92\xee\xa6藩q\x1e\xe8RS̕i9bฝH\xdf|\xd4Y\x91\x86\x96\x17\xc2\xcfLŀ\x1eN\x04A+P\xa7\a3,9\x83\x17M\x05\x001(\x16\x18Z\x9e\xc2\xc0H\x81\xe3\xcdiC;ݖ\xe1\xc3{ҶW\xcdMC+\xe9\x9b\x1b^M\n\xf7\xdbQ\xc5\x00-)+\b\x16\x9b\r\x0e\x04\xac\x92\x00U\xa9\U000a05d3-\x98zx20\x12\xcbo\x00\xff\x00\xa9\xff\xc4\x00W\x10\x00\x02\x02\x00\x03\x03\x05\a\x0e\n\a\a\x04\x03\x01\x00\x01\x02\x03\x04\x00\x05\x11\x06\x121\x10\x13 !Q\x14Aaq\x92\x93\xb3\"02RSTUr\x91\x94\xb2\xb4\xd2\xd3#3BPbs\x81\x82\xb1\xc2\a\x15$4@C\xd1DV`t\x95\xa1\xc3\x16cd\xa2epu\x83\xff\xda\x00\b\x01\x01\x00\t?\x00\xff\x00\xf6\xa5\xc8+\xc7\xed\xe6\x91c\x1f+\x91\x8d\xba\xc8T\xff\x00\xfd\b5\xfaX۬\x8b_\r\xf8\x00\xc6\x7f\x97\\'\x80\xafj)~\x89\xff\x00\x82\xb3\xba\xf4U\x86\xb1\xc6\xe4\xb4\xd2|H\xd7Vll\xf6\xf7e\xac\xc7\xeeb8\xdaې\xc4xCL\x8a\x89\xa7a\x10\ue4c9^I[\xad\x9d\xc9f>2z\x1bQ\x98\xd4D:\x88\xa3\xb0\xfc\xd7\xed\x8c\xea\xa7\x14)\xe6\xf0\xf7\xdc(\xa99\xf3`\xa633B\xfc\x9dB\x9d\xfd!r{\x11\xbd\x83\xff\x00\xc0\xb6\xa2\xafZ\x14/4\xf2\xb8D\x8d\x17\x89fn\xa00\x9a/\x03\x9bN\x9e\x826\xfaO\x8b\xb3[\xb71\xd6I\xe7\x90\xc9#\x9f\v6\xa7֦9\xb6N\xba\x03R˝\xf8\x97\xb2\x19x\xae/o\x98\xf4\ue2b2h\x96+\x93ޑ?\x9b\x87\xfc\x05qkԀu\x9e,\xecx\"\x0e\xfb7xa\x9e\x9eI\x13\xebZ\x82zI\xbd\xbb\xfa\xed\xf9j]\x80\xea\x92'\xd1`z\x99O}OQ\xc0\x8a\x96\x7f\x04Z\xcdT\x1d\x12`8\xcb\a\xfc\x01mkѩ\x13K4\xaf\xc0(\xfe$\xf0\x00u\x93\x8d\xf82\xba喅\"z\xa3On\xfd\xb27\xafے\xb5\xca\xd2,\x90\xcd\x19ё\x97\xbe0R\f\xf2\x92\x81z\x0e\xf3\xf6O\x1f\xe8\x1f\xceY\xed\n\x01\x86\xab\xddV\x12\"\xde \xc4\x13\x8d\xa5k.\xbcEj\xb3\xbf\xc8J\x81\x8a\x99\xd3\xf8\xaa\xc7\xf7\x98\xb5\x99U\xf0\xcdL\x9fD[\x1bg\x97\x82x-\x875Y\x8f`\x13\x84ë#(*\xcau\x04\x1f\x0f\xacX\xd7)\xca\xe6\"˧\v6\x97\xf9#\xe9\x8dI:\x01\x8d\x8eάFx<4'q\xf2\x85\xc6\xc6gp \xe2\xf2Н\a\xcaW\nU\x81Ђ4 \x8e\x9c\x9b\xb3\xd6\x7fT\x84\xfa\x89\xa3=O\x13\xfe\x8b\x8cI\xbfV\xe4A\xd5O\xb2F\x1dM\x1b~\x92\x1e\xa3\xf9\xbec\x9af\xe9\xa84\xaa\xb0\xd26\xec\x9a^\t\x8c\xcf\xfa\x9e\x91\xe1\x05\rb\x7fޛ\xd9\xe2V\x92G:\xbb\xb9,\xccO|\x93\xd1\xcf\xeeQ\xeb\xde1\xc7!\xe6\x98\xfe\x9cm\xaa62\xa0\xe9û\xe8\x8fI\x0e38nԓ\x84\x916\xba\x1fjÊ\xb7j\x9d\b\xe8Ϲ\x9af\x04ӡڌ\xc3W\x97\xf7\aJ\x9c\xb6\xad\xce\xfb\x90\xc1\n\x17wn\xc0\x06.\x90\xfcFYQ\xfd4\xdfc\x1b?J\x8e\x8b\xbb\xceE\x10\xe7X~\x9c\x87Wn\\\x86\x95\xe0T\xa83B\xac\xe8?A\xfa\x99|`\xe2\xf1\x8a^?Ֆ\xdfT>\bf\xfbx\xa35K\x95\xdfvX&]\xd6S\xfe\x87\x88=)\x8fq\xe6\xba\xcbS\xb1-\xa7ޯ\xe6\xdbQV\xabY\f\x93M+n\xa2(\xef\xb1\xc4\xd2\xd0\xca:\xd1\xed\x8fQf\xd7\xdd'\xadf/^^\xa1,|b\x99}\xa4\xa9\xc1\x86\x00\xa3\x9e\u009a\xcfD\x9e\xa7\x03\x8c\x90\x9e\xfa\xf4%֖F\r\x18\xc7{\x9e\aY\xdb\xcb\xf5=\x1a\x8fb\xed\xb9DPĜK\x1f\xe0\a\x12OP\x18X\xedgS\xc6\x05\xcb\xe7\xd1C\xd9\x1f$\xe6*u\x13zB\x06\xacK\x1d\x15\x14wى\xd0cbOpo驻\xf8m=\xbf\xb0\xc4\xe6Z\xb6Е\xde\x1a:2\xf528\xef2\x9e\xa3ʋ\x06i\x02\x11J\xf8_W\x19\xf6\xaf\xdb\x1fj⩂\xedI\nH\xbd\xe3\xd8\xca{\xeaì\x1e\x8c\xcd\x15\x9a\xb3G<\x12/\x14\x926\xdeV\x1e\"0\x00K\xd5RR\xa3\xafrC\xd4\xe9\xfb\x8c\b\xfc\xd7:C^\xbcO,\xd3HwQ\x11\x06\xac\xccO\x00\x06$x6z\xb4\x87\x98\x8b\x81\xb0\xde\xed/\xf2\x8f\\\xb5%kU\xe4\x12C4Gu\xd1\xc7\x02\b\xc1Hs\xfaQ\x83j!\xc2d\xf7x\xc7\xd2\xe4PR\x85)\xed\x15'\xd9sHH_\xdaq+I4\xd24\x92;qgs\xa9'\xc6z5\xf5\xcc3DҘn0\xd4\xff\x00YyQ\xe44.\xc3vtN-\fj\xca\xdeF\xf8nH\xde1\x98\xe6\x13^\xac\x8fǘtH\xd7\xcb\xdc\xe8@?\xadrhK˧\x19\xa9\x8dK\xaf\x8d=\x9fJMNYpM\x1f\x82\x1b}\xe1\xfb\xe9\xf9\xaeƕ\xe0*\xf9\xb4\x89\xc2Ix\xac\x1e$\xe9l\xe5\xeb\xa8[t\xcb\x14,b\a\xb0\xc8tQ\x8d\x9c\x8e/\x03ݯ\xfc\xaeq\xb2\x16%\x8c{\xd6X\xad\x1f\"\x16f\xc5Yk،\xee\xbcR\xa1GS\xd8\xca\xda\x11Ѱa\xb9NP\xf1\xb7x\xf6\xa3\x0e\xfa\xb0\xeaa\x8c\xe2\x96_^\xd2\x1eqmَ#\x14\xa9\xd4\xf1\x92\xe5x\x1cm\f\x17s\v\xb2A\x19\x15ø\x11,\x81\xdc\uf079\xd1,\r\xfb\xb1@̼Q\x19\xbd[~\xea\xeaq\x12\xc7^\b\xd28b^\xa5DA\xba\xa0b@\x91\xa2\x96wc\xa0P\x06\xa4\x92q\xb6y{Z-\xb8=Y\x11\x13\xd8% !\xe4\xd8̨\xd9\xde\xde\xde5\x97M{J{\ry.\xc9FY\xaa\vW.Bt\x9bI\tT\x8e7\xe2\x98\xda\v\xb7\xebo\x83b\x95\xcb\x0f<S'|z\xb2w\x1b\xf4\x86\x1bz\v\x10\xa4ѝ4\xd5$\x01\x86\x1022\x90\xca\xc0\x10A\xea \xeb\x80Dt\xee8\x83^<\xc3\xfa\xb8\x89\xf0\x94a\xd1\x7fQ\x98e\x93\xc4\x17\xf4\xe2\"a\xf9\xabt\xd8D\x11R\x8d\xb8IbN\xa4\xf1\x81ű3K<\xd24\x92\xc8\xe7Vws\xa9bO\x12OB\x8c\x96\xee\xd9}\xc8a\x8cjX\xff\x00\x00\a\x12OP\x18H\xb3\x8c\u05c9\x81ƴ\xe1\xf0\x04?\x8d\xc4j\x91\xa2\x85T\x03@\xa0p\x00\x0e\\\x9a\v\xaa\x01\b\xee4\x96?\xd5Ⱦ\xa9q,\xb9\x8eC\xc5؍g\xa9\xfa\xdd8\xa7\xe9\x8f[\xe3B\x85\xbb#\xc9\x10\xff\x00?$\x8f\x1c\x17\xf34\x82ӎ\xfa*3\x88\xf9\x1d\xe4\x92\x16\x9e\xb4R\xbfYxar\x89\xf69a\x16/ׯܶ)\xea\x10\xcb\x1a\x12\xca\xf1\xe3'\x9f)˄\xa0Y\xb5h\x04!\a\x11\x1a\x1e\xb7lG\xb9\f\x11\xa4Q\xa0\xef*\r\xd0?`\x1c\x83\xfb\xeeO^Y\x0fk\xa3\xbc]\x13\xc6\xe3\xc3\xe7\xe2x\xff\x00\xc0M\x1e\x9auh\xc3LM\x1f\x9414~P\xc4\xd1\xf9C\x13G\xe5\fM\x1f\x9414~P\xc4\xd1\xf9C\x13G\xe5\fM\x1f\x9414~P\xc4\xd1\xf9C\x13G\xe5\fM\x1f\x9414~P\xc4\xd1\xf9C\x13G\xe5\fM\x1f\x9414~P\xc4\xd1\xf9C\x13G\xe5\fM\x1f\x9414~P\xc4\xd1\xf9C\x13G\xe5\fM\x1f\x941(0Ҁݱ\xa7\x034\xfdH\x0f\xc4N\x80%\x89\xd0\x005'\x10\x03\x9f\xe6\x10\xabܐ\xf1\x81\x0fX\xac\xbf\xcf\xc94\x90ڱf\x1a\x9d\xd3\x1fS\xc0\x92\xeaY\xd4\xe34\x9e\v\xe9'8e\xdf'\x9c\xebԬ\x9e\xdd[\xbe\x0e!1<\xd0\xc7#F\xdcP\xba\x82T\xf8\xb5\xe4\x8d^7R\xac\x8c5VR4 \x83\xc4\x1cBFE\x99\x96j\xc3\xdc%\x1do\x0f\xd8\xf5\xae2\xe4vPy؛\x92\xb9\x92\xad\x8d\x0e\xaaB\xc9\x1b\xa7Zȍ\xdeu\xc6\xda\xce\xf4\x03\xeaaJa'+پ\\\x8cUZ\xf4\xaaD\"\x86%\xe0\xaa<'RI\xe2I\xeb'\xa7\xc52Do*yz?\f\xd4\xfax\x958{a\x89\xa3\xf2\x86&\x8f\xca\x18\x9a?(bh\xfc\xa1\x89\xa3\xf2\x86&\x8f\xca\x18\x9a?(bh\xfc\xa1\x89\xa3\xf2\x86&\x8f\xca\x18\x9a?(bh\xfc\xa1\x89\xa3\xf2\x86'\x8c\r:\xc9a\xfe> \xf52\x88\xcd\xf7\a\x83H\x84\b\x87\x94u\xe4\xda\f\xb6\x8d\x89@1\xc3j\xd4P\xbb\xeb\xedU\xc8'\x15\x12\xd5\x1bq\xeeK\x13pa\xae\xa0\x824 \x825\x04bL\xc6\xf8\x82Q$UnI\x19\x87Q\xed\xc2 /\x86\"\xb5\x1a\xd2ٛtjۑ)s\xa0\xc6O\x95\xa5\x00\xfdU\x1d\x1dا\x86]G\xab\xc22Az\x1d\xfem\xba\xca2\x92\x8e\x87\xb4\xa3\x021\x0e\xfd\xa1\x01\xb1K\xb4X\x80o\xa7\xd9>\xb4\xdaC=\x93NA\xff\x004\x86\x11\xd0\xcf̲\xc6\xe5&z\xf0<\xf1F~:\xe30\x8a\xe5)\xd7z9\xa3:\xa9\xed\x1e\x028\x10z\xc1\xe4\xce%\xcb2z\xf3\xb4u\x85S\xb9,\xc1\x0e\x9c\xebɋf\xf3يG\xa3p\x80&\x0f\n\x171HG\x10@\xe5\x90<9z\xc3A<p\xae\xb2\x0f8O\xe7>3[\xab_̡\x7f\xe7\xc0\x04\xe2I\x1f1\x9a̍d\xca4a&\xba\x15\xd0\xf0\v\xc0\fI#\xc5W2\x9e\n,\xfe\xf7TC\xa2\xf8\x98\x91\xc8Y`\xbfRZ\xd2\x15\xf6@J\xa5I_\b\xd7\\%\t\xe9\x17\xd1s\x13d${\xbd\xac\x9drbv\x9a:0\x152\x11\xa6\xfc\x8e\xc6I\x1c\x0e\xf0fbt\xe4]\"\xa9\x9b]\x82?\x8b\x14̣\xd6\\\xab\xa1\x05X\x1d\b#\xac\x11\x87\x1c\xfb\xc6\"\xb8\x83\xf2,\xc5\xd5 \xe4\xde\x16\x93,\x9bF^(\x9c$q\xd8Bk\xc8\\\xd0IiH\x9d\x82g\x0e\x1fNL\x8a\xe6e\x93\xcd;\xc9Rzq5\x82\x91\xb1\xd4$\xa2=J\x95\xc6]>]\x15(\xe5\x14\xe0\x9c\x18\xe7\x96YP\xc6X\xa7\x10\x80\x1eB\xa7\xb9a&\x18\xdb\xfcٛ\xaa4\xfd\xe6ĭ,\xf6%ye\x91\xb8\xbb\xc8w\x99\x8f\x8c\x9f\xf0\xb9\x00\xa9s\xbb\xab\xc5\xcfwL\xf2z\x87\xf0H\xe4r&\xfcrى\x1dxj\xac\xe0\x1e\x18\xd8\xf1\xf3\xeb\x9f{\x8d\x8f\x1f?\xb9\xf7\xb8\xab\xdc\xf4*\xcd\x1a\xc3\x16\xfb>\xe81#qrO&Oݦ\x99\xa3\xdc\xff\x00\x87\x9a\x1d\xcew\x9d\xdf\xfcK&68\xfc\xfa\xe7\xde\xe3f\xfb\x96\xfdaW\x99\x9b\xba\xecI\xa6\xfd\x94\x8c\xf5H\xe4r쎥\xa3BOw[\xfb\xdclq\xf9\xf5Ͻ\xc5\x11V\x8cu\xab:D$y42G\xa9됱\xe4\xca{\xb5jGP\xc09\xe9\xa2\xd0\xc8_\xdcY1\xb1\xc7\xe7\xd7>\xf7\x1b3\xcc]\xabT<\x12\xf7]\x997N\xf8\x1c\x1eN]\x92ߚz\x15\xe4\x91\xfb\xb6\xd8\xd5\xde0I\xd0K\x8d\x8e?>\xb9\xf7\xb8\xcb\xc5:m\x96A1\x88K$\xbe\xad\xdd\xc1:\xcaX\xf2P\ue565\xb37n\xd6\x1c\xe3ǹ<L\x81_\xd4\x15\xe8\xfb8/ט\xf8\xa6\x8c\xa8\xf4|\x9b8\xadrS\xac\xef\x14\xf2\xc0%=\xae#+\x8a\x91V\xa9]7!\x82%\n\x88\xa3\xbc\a%\x88\xe0\x82$g\x92iX\"F\x8bř\x8e\x80\x01\xdf8\xdaz\x16\xed.\xba\xc3\x1c\xc0\xbb\x01Ĩ\xfc\xa1\xca\xda\xc5c;\xbf*\x1e\xd5y؏Zv9\x16bQ.\x0e<Ïa8\x18\x9d%\x86dY\x12H\xd82:0\xd5X0\xd7PG\x03\x84\f\x8c\xa42\x91\xa8 \xf5\x10A\xc5\xdc\xcb/\x82W\xdfz\x90:4i\xe0\x8c\xba\x92\xb8\xa6 \xaa\x8c]\x89%\xdeY\x1b\x8c\x927}\x8fA\xd5\x11\x01ff:\x00\a\x12I\xc4\xc4\xe49l\x84\xc4\xdd\xebS\xf03x\x87\x04\xf5\x8c\x98]j\x92\xd4\x10\x1e~hwD\xa1\xfd\xc5\xd3\x1b\x1c~}s\xefq\xb3\x9d\xcb~\xb9\xaa\"\x9b\xba\xec˦\xfd\x84\x8c\xf5;\x91\xc9\x0f=V\xe6qF\xbc\xf1jW~9fTe\xd5t#Q\x8d\x8e??\xb9\xf7\xb8\xd8\xe3\xf3\xeb\x9f{\x88\x045)\xe6\xd7 \x82=K\xeeG\x14\x85Tjēɑ\xf7m\x883!\fO\xdd\x13âsA\xb4\xd2'Llq\xf9\xf5Ͻ\xc6\xcf\n\x97E\xdaщ\xbb\xaaľ\xa5\xfc\x129\x1c\x83Ty\xe3V\x1d\xa1\x98\x03\x8f\x85)\xff\x00?'\xbf \xf4\x83\x1d\xa7\x1d\x87\x1e\xf9\x87\xd0''nW\xff\x00\x9b\x93\xb2\x8f\xd7\"\xe5\xf7(\xfe\x88\xe4\xf7\x95\x1fE\xc9\xee\x14>\x9c\x9c\x9e\xf1\x1e\x951\xdb\xc9\xf0m?B\xbc\x9f\x02\xd5\xfao\xc9\xc2}\x9a\xb3\x11\xf1<\xd1aJ\xba1V\a\xbcGWB@\xb0gU\x8de\xff\x00\x98\x8c\xef\xc5\xc8\xc0*\x82\xc4\xf6\x01\x8b\xf1\xe5\x99hr+\xd5\x15\xa1\x94\x98\xfbei\x03\x12\xc7\x15R\v\x90Xz\x97\x12=B\x19c\x01\xf5O\x03\a\xc6\xf8\xa6sXE\xed\xcfi\xbaJo\xfe\x86\xfe9\xcf\xeb1v\x0e\xe3\xdc\xf6\\\xf6\xf8\xdd\xe4`\x1e\xadG0~\x94\xee7\"_\xda\xe4`\xeaO\x13\xebi%\xfd\x9f$\xee 뚙=\xf8{S\xb51\x9bCv\xab\xfe\\g\xadO\xb5u:20\xecn\x8ec\r:q{9\xa6m\x06\xbd\xe5^\xfb7b\x8dI\xc0\x96\x9eE\xc2Wn\xa9\xae}\x88\xfaP\xb4\xb6mO\x1c\x10D\xbc^IN\xea\xa8\xf1\x93\x82Z*\r\x14\x1b狲Ļ\xcf\xfbǓ\xdf\x14>\x84\xbc\x9eڇ֣\xe4\xff\x00xrϬ'/\xc3ه\xa7nO\x86\a\xa0\x1c\x9f\tR\xe4\xf7\xcc?Lc\xe1J\x7f\xcf\xc9\xef\xc8= \xc7i\xc7aǾa\xf4\t\xc9ە\xff\x00\xe6\xe4\xec\xa3\xf5ȹ}\xca?\xa29=\xe5G\xd1r{\x85\x0f\xa7''\xbcG\xa5Lv\xe2\xbf?z\xd3\xeeA\x16\xfa\xa6\xf3hN\x9a\xb9\x00aw&\x82\x95h\xa4]Ch\xc9\x18R5\x04\x8e#\x93\xe0Z\xbfM\xf9>\x00\x9b\xeb0\xe14A\x98\xcb<C\xb2+'\x9eA\xf2?B\xc5\xd8.d\xa2\xb4\xf4e\xab0\x88\xa4\x85dp\xdc\x0f\x02\x98t\x19\x9deH\xb3*\xdc7&\xd3ف\xeerqL\x00A\xe21\x9de\xe3*\x96B\xf1%ƕ%\x84{OP\x8e\x1f\x16\x1aw\xe7\x1e{3\x91\xb9\xcfO'\x17\xdd\xef\x00\x00\x03\x15\"\xb1^d),S x\xddOQVV\xd4\x10q\xb3\x14\xea\\mt\x9dT\xb3\xae\xbcB\x17's\x92\xc0\x93+\xca\xe5-bd:\xac\xf6\xbe\xc4]\x18\xd9ݎ\x8a\xaa\t$\xf8\x00\xc6\xc5\xe6\xa57\v\x87\x92\xbbB\x84\x0eƔ(8\x1a\x1eV\x8dl_\xb7\rXL\x84\x84\x0f3\x04\x1b\xc4\x03թ\xc4\xd5d\xb2k\xc7>\xb5\x9d\x9d7_Qŕy3[4l\x8e\xa2\xf09M\xe1\xed\\pe\xf0\x1cd\xb4\xf3U\x03\xf1\xb1\x13Nf=\xad\xa0d\xc6\xcfgQ\xbfd\"\tG\xd3Ld\x19ܲvJ \x88zG\xc6AO-\xd4\x11\xcfL\xe6ܣ½H\x98\xce,ߜj\x14\xcc\xfa\xaa\x03ލx\"\xf8\x17\xa7\a\xf6<\xafX\xa9k\xc1\xed\xbf\xdd.=\xff\x00\xfc\x8b\xc9\xef\x8a\x1fB^OmC\xebQ\xf2\x7f\xbc9g\xd6\x13\x97\xe1\xec\xc3ӷ'\xc3\x03\xd0\x0eO\x84\xa9r{\xe6\x1f\xa61Z\xc0\xafF\xecr\xba\xd8\b$+\\\xe9&\x826}4\x0f\xaf&\xa5!\xb1\x14\x8d\xbb\xd6tF\ft\xc5\f\xf3\xe6\xd0\xfd\xee ͠{\x96c\x81$\x9e\x18\x92$2\x9d\x01r$:\fuÚA\x05\xc8<\x81\x11\x1e0S\x92\xbd\xe9\x0e`i\xf3=\xcb\x12>\x82\r\xfdu\xdft\xf6\xf8\xa1\x9e\xfc\xd6\x1f\xbe\xc5<\xd5-\xdc\x15\xb9\xa3<\x11\xa4c\x9a\x9d%:\x90疆xJ\xa2\x0e\xaa\xd0\xfd\xee2\xec\xf7\xe6\xd0\xfd\xee#\xb0\x95e\x82\xba\x01aU\x1fX\x93Cԥ\xb9 \xbb \xbd\x1dU\x87\xb9cGӚ.N\xbb옫~\xb5\\\xb8!\x9e\xc5\xd8\xe3\x8a>\xb0X\x80U߀\x1a\xb6*\xe6\xa2\xd5\xc89\xa8\x9eX#X\xf5\x0f\xf1\xc9\xe4\xf7\xf8\xfa\a\x14\xb3W\xb7Y\"fj\xf0\xc6\xd1\xe92\t\x06\x85\x9cc.\xcf~m\x0f\xde\xe2+)Yr\xf8k\x95\xb2\x8a\x8f\xbe\x8c炖\xe4\x86ܐO\x95\xc9U\x05TW}\xf6\x95\x1f\xf2\xcaw\x93\x10]\x86aS\xb9\xed\xa5\x98\x92=y\xa3\xaa8\xddw\xe8{ާ\xa2\x9f\x16\x84S\xa0\xddt\x7fU\x14ў1ʽ\xf58\xb7\x1e[\x9b\xf0|\xbaĀ;\xb9\xf7\x06:\tz\x17\xa0\xa9R \f\x93\xcf\"Ɗ\x0fk>\x83\x12I\x1d9\x01K9\xa1\x05\x1eQ\xdfH\a\x15^\x92N\xd5)\x99̂\x05\r!\xe7!xƁ\x8a\x8e-\x8a\x19\xef͡\xfb\xdcEr\n\u05f5\x9a\xcdk1,|\xdc\xe7ٔ\xdcg\xf5/Ǘ\xe1\xfc\xbbӮ>\b\xa9\xfc_\xd6&\xaf\x1d\xbb\x9c\xe8\x8d\xec9X\xc75\x19\x90\xeaT1ถ\t-\xd2dY^\x06-\x19.\x82A\xa1`\xa7\x83t$1\xc4]C\xb8]\xf2\xaaOY\x03\xab];1\x96g\xa9V\x9c\"5ִ:\xb9\xe2\xce\xdaK\xc5\xce\x12d\xa9v\xcf9\x12\xcc\x02\xb8\x1b\x80u\x80O$\x17\xe47\xa5\xaab\xeeX\x92N\xa8\x83\xeb\xae\xfb\xa6(g\xbf6\x87\xefq\xb3\x9bEn\xfd\x93X\xa0J\"D\x1c\xd4\xc9)\xd4\xc4\uf34b\xb6\xbe\t\xde*ޙ\xd3\x1b:\x82\n\x19\xa5;S(\xb9X\xbe\xe4\x12\x87:\r\xfcl6w/ꤠO\xec\x06\xc8\xc7\xf4k\xb6\xa8\x80j\xcf\x1e]\x14\xe9\xf2\xc33\xe2\t\xa2\x8a\xe6ij\xc2G:\xeeJ\xab,\x85\xc0q\xdb\xc9[1\x96k7\xc4\xe9ܰ\xa3\x8d\xcel'\x17t\xc5\f\xf7\xe6\xb0\xfd\xf6*fii\xad\xc106!DM#\xf1Hܚ\xee\xc74n\xdaq\xd1X\x1cl\xaae]\xd1\x04\xaf\x03\x8bBa,\xb1\x8dJn\x88ӊ\xe3b`\xaa\x1f2J\x89|Z\x0f\xaa\xcf\xf8\x9979\xa1\xec\xf5\x18\xab\x17\x9b\\U\x8bͯ\xfac,\x82ń\x86F\x86\x17\u074cI\"\x8dU\vhwu:\rt:ceE\xc3\b\xd2\xde]$\xfb\x8f\\\xac\xbc̣x\xc6}\x81\xc6ǦVa\xbf\x1d\xae}m\x89\xb5\t\x1bǹ\xa0\x89=\xbfC#\\\xccMBJ\xbc\xc3M\xcch]\xd1\xf7\xf5(\xfe\xd3\x1fь\x1f\xf5\x11\xf7\x18\xd8(hAV\xb1\x9e{f\u061c\x0e\xf2\xa6\x9c\xcab\xac^mq\x95F\xb1\xe5W\"\xa9\xdd:+,\xd24+3\x85\xea\xea\xdc\xdf\x00\xe2\xac^m\x7f\xd3\x15b\xf3k\xfe\x98\xcaR\xf4\xf7l\x98b\xa6%\x10o\xa8]^Mw\x1f\xa8c%\x19`ZqW\x15\xc4\xc2oŖ:\xea\x119?\xa3\xba\xf5,O*B\xb77\xe1\xd5\bOe\xd5\x10\xc7\xf4}N\xd5|\xbcV\x17s\x9b-\x18]f\x84H\xa8\xaacfw\x00\xe3g\xb2ߚC\xf6q\xb3\xd9o\xcd!\xfb8\xfe\x8fhg\x12W\x05\xe4\xa9\x1aC\f\xa6 5c\x1e\xb1\xbe\xfb\x0fk\x8d\x81\xa3i3\xa8\xe3q\x00H#0\x89!\x13q掸\xc8S*\x80T\x8a\x1e\xe6B\x84k\x1e\xba\xb7\xa8T\xe5\xc8S5\x80Ԗ\x01Y\xca\x00\v\x90w\xbdZ\xb8\xc6\xc9Ó\x8af\xaa\xca\x10\xc6\xc2a0\x7fh\x89\xc0.6\x7f-\xf9\xa4?g\x1b?\x96\xf5\x10A\xeeH\xba\x8a\xf7ǩ\xe8d\xf4\xaf\xc5\x1bo\xa2[\x81'\nݠH\x0e\x87\x1f\xd1\xf8\xa4\xc6\x14\x9a;U\xe9\b\xa1\x969;\xe8j\xba\xbe6\xd8\xe5\xb37TP\x9b[\x9a\x9f\xd5\xdd\x01\xdb\x17\xeagP\x8e\b\x0fsO\xe4\xc8Jc.\xb1J\xd2u\xb46#h\xdc\x03\xc0\xe8\xdd\xe3\xeb\x1b\t\f\xb7\x8d\x9aԆc\xac[\xc1\xe5\x97A.\x86=q\xfd\x1b\xa6}\x9a[\xad\x03@\xe0žw\xcbz\x83\xbf\x1bh\x17Ll\x9eUZ\xd3\xc6\f\xd0\xc7\x14S*7}C\xee&\xf66{-\xf9\xa4?g\x1b=\x96\xfc\xd2\x1f\xb3\x8d\x9e\xcb~i\x0f\xd9\xc6\xcfe\xbf4\x87\xec\xe3\xfa/\xa3\x9eWE&\xc2\xd7\x10\xc5<`w\xc4f&2\f\x7fD\x94+eq9I\xafٖ\x10\x9b\xfe\xd20 \xd5\xdb\x195\x18&Mwd\x8e\xbch\xc0\x91\xa1Ѐ\x0fX\xc6\xc3A5\xe7\xa8\xf5Fe\xacA\x83٬4\x93C\x1e\xbe\xa3^\x9a\x16f \x05\x03RI\xc1\x19\x15\x16\xeb\x02t/m\xfcP\xfd\xbcdC3\xb48\xd8̴\xb0|\xdfTx\x89c\x8a5\xd1\x11\x06\xea\xa8\x1d\x80trJW\xd3M\x14X\x85$+\xf1K\x02W\x17\xa7\xcal\xf7\xa0\x94\x9b\x15~\xdac+h\xe1v+\r\xc8\xfd]i\xbe#\xff\x00)\xd0\xf4_v\xcd\x1bQX\x88\xf7\x8bD\xdb\xda\x1f\x01\xe0pH\x8e\xc4\x10\xc5+\xa7\x14\x0e9\xe8\tӁC\xaa\x9c\"\xa5\x9by}i\xe6E\x1a\x05\x92X\xc30\x1c\xb1\x0f\xea]\xab\xa9.mN>\be\x90s\x17b\xf0\xb3j\x1f\x1f\x8e\xa1jH\x19\xb4\xd3|!\xd08\xf00\xeb\x1d(\xb7/g\x8e.?h\x83\x84\x03\x92%\x8dY\xdeB\x14qy\x18\xb37\x8c\x93\xa9\xe5\x97~\x8eN\r\b;\v\xa1\xd6g\xf2\xf9}\xfe>\x81\xc2\x00\\\x86r\x00\x1b\xc4\x005=\xa7A\xa7@p\xcc&\xf4]\x1fo\x96\xff\x00\t\xbay\x8d\x8ar\xd0I\x944\b\x87|LGSo\xf6i\x8d\xb4\xb5\x17Ǧ\x92\xff\x00:c\xfaN\x80¿\xec\xb7#\x91 \xf3G\x9fL\x7fF)f\x98\xd4\f\xd3+\x06\xf4\t\xdb&\xe4dد\xf1\xc6.\x1c\xef&pd\xe6\x10\x89lF\x9d\xa8S\xaae\xe9\xfc?\x97zuƒ^\xb5N\xbd4,\x80\x18a\x87V!O铫t\xe0\x8e$.\xeeR5\b7\xa4b\xec\xc7N\xfb1$\x9e\xf9\xc7aǻV\xfa\xb4}*FiۮI\x0f\xa9\x8a\b\xf8\x19%n\xf2\x8cF/\xe7\x9az\xbb\xf3'\xe2\xfc\x10/\xe4z\xd58\xadV\x9d7e\x86d\x0f\x1b\x8e¬\b8\x8aI\xe8\r^|\xb3S$\xb0\x0e\xd8O\x17N\x8c\xa8g\x82\x17\x96\x80~\xf0\x98栗\x9b\x11\x85hkC\x1b(\xe0\n \x04r\xc2Z\xfe\xcb\\\x19\x90\t\xa6\xfc\x95=\x85\xb8u<\x03ō$\xa5\x9cUH\xa5\x99:դ\x8du\x89\xff\x00~.\x8e\xa2+6\at0\xe2\x90G\xea\xe5>H\xc2*E\x1a\x88\xd1\x10h\x14'\xa9\n<ZiА\vK\x173H\x1e\xfd\x99\xbdL~O\xb28bX\x9dI'RI\xe5\xf7\xf8\xfa\a\x1d\x83\xa1\xf0\x8c\xfe\x8b\xa3߯S\xd1O\x8aV\xef\xc3]\x02W\xb9X\xa1\x9c \xe0\x92\a\xd0>2\xabt\xaa\xd9f5E\xb0\x82Y\"\x1f\xe6\xee\xa1`\xa0\x9e\x1e\xb3\x1a\xa6\xfb\x16}Ѧ\xf1\xed8\xaa\x90gK\xac\x96j/R]\xff\x00I\xb0\x8c\x92#\x15t`AR:\x88 \xf4~\x1f˽:\xfa\xcfaǻV\xfa\xb4}\x18\xb5\x92M^i\x9b\xd8A\x10\xe3+\xf8\x06 \xeck6X~\x16̞\xdd\xfdv\xa0\x12&\xb2攢\xe0㉱\x10\xf4\x9d\t\n\xd32w5\xee\xc3^\x7fP\xe4\xfc_e\xd0Ete*\xca\xc3P\xc1\xba\x88 \xe0<\xb7\xf6vNw(-\xab\xbc\x95z\xe7\xa6|\x8d`\xe8\xa0\xd2\n\xd2V\xa2\x0fV\xf8\x8bB|\xe4\x9a&\x1f~\xeaU\x8b\xba\xdf\xdb\xce\xc3zF\xfd\xacOB]a\xca\xd0X\xb6;l\xcf\xf6\x13\xa1\xef\xf1\xf4\x0e;\aC\xe1\x19\xfd\x17G\xde\xf5=\x14\xf8\x04P\x8b\xfbFa \xefW\x8f\x88\a\xb5\xf8\fD\xb1C\x14k\x1aF\x83EUQ\xa2\xaa\x81\xc0\x01\xc9y\xa3ͳ\x18\x9aZps\x12\x91*\xa6\xf1:H\x14\xa0\xd3t\xea\t\xe5\x0eQz\xce\xe24\x87\xe4@N6\x9f,\x92\xc8$5~\xea\x8dfR;\xcd\x1b\x10ãX/X\x19\xbc\b>K?o\xa3\xf0\xfe]\xe9ץ\xafɍ~Lk\xf2c^\x1d\x98\xf7Z\xdfV\x8f\xa1\x13I,\x8e\xa9\x1a ՙ\x98\xe8\x02\x81ĜF\x877\xb8\x16\\\xcao\xd3\xefD?B>\x9e\xd2ep8\xe2\xb2܉\x0f\xc8[\x19\x85kJ8\xb4\x12\xac\xa3\xff\x00\xa9=8\x00ȳr^ \x9e¼\xfc^\x1f\x11\xe2\x9d\tw\xee\xe5\xbf\xd8-v\x93\b\x1c\xdb~\xd4\xe8\x00fH^\xa5\xa1\xee\x909\xdf]|1\xb8\xea\xf0\x16\xc4[\x94\xee0\xbfO\xf5V\t:\x0f\x026\xab\xcb\x11\x92չ\xe3\x82\b\xc7\x16\x92V\n\xa3\xf6\x93\x80d\x8aK\x94e\xccB\x0e\xb7\x869:݇c\xb6\xfc\x8d\xcb2CZ\xbco,ҹ\xd1\x11\x10o31<\x00\x03\x15\xcc[5\x97\U000e272f\xaa\xcdtV\x1a\xc9(\r\xc1\x1d\xc8H\xc6\x1fz\xd5\xdb2X\x94\xf7\xb7\xa5;\xc4\x0e\xc08\x01\xd0\xf7\xf8\xfa\a\x1d\x83\xa1\xf0\x8c\xfe\x8b\xa3\xee\x15=\x14\xf8\x8bK\xf9\xe6\xe5\xb7=\x95\xf8@\x9f\xcf\xcbZ9\x1e\xb4\xbc\xf4\x0e\xea\t\x8aMқ\xc9\xd8\xda1\x1a\xe2\xe4\xf1\x80\xd4\xf4\t#(\x1aՋ\x1b[\x9b\xd7\xf0Evd\xfe\r\x8c\xed3(S\xfc\x9b\xf0\xa4\xbf+\xae\xeb\xe3\"\x8b,\xcdd^j\xb5\xc2ކ\xcf\x18\xfe#\xfa\x8cg\xf7\xd3(\xb4\xe4T\xbdRw\x80\xeb\xee3\b\xcfT\x98\xda\xfb\x12d\xcc]*R\x96\x18\xa4\x96ΝFW\x99\x86\xf8NX\x12z\xd6a\x92\x19\xa2a\xaa\xbaH7YO\x80\x83\x82Y+M\xac\x12\x1f˂A\xbf\x1b\xf8ʞ\x87\xc3\xf9w\xa7^\x8ew~\b\x13\xb8\xb7#\x8aâ\rjDq\xb4\x99\x9f\xce\xe6\xfbX\xdaL\xcf\xe7s}\xacm&g\xf3\xb9\xbe\xd66\x933\xf9ܿk\x13<\xb2\xbe\x9b\xce\xecY\x8f\x8c\x9e\x84;\xf52U\r\b<\x1aܾ\xc3\xc8\xe9E\x06ay5Io?]X\x8fd`~4\xe3hn\\\x04\xea\"w݅~,I\xa2/$\xad\x14\xa8uGBU\x94\x8e\xf8#\x16\x8ey\x96\xfe\\6\xdfY\xd7\xc3\x1c\xdfo\x179\xc4\xd4\t\xe1q\xbb4\x0f\xed%^\x8e\x82\xc5t\x8a\xf6S+\xe84\x8e\xc0/\t\x1e\b\xa5G\x8c\xfe\x86#h\xe5\x89\xd9$F\x1a\x15e:\x10|G\x96]*g\x90\xf3\x1e+1j\xf1tb\xd6l\xa2~js\xdbZ\xcf\xfa?(AG!\x80\xb8w\xe1\xcf\xcc\b\x1eB\x82q\t\xd2헥\x92+\x8f\xc5Շ\xd4\x17\xf1\x90\x02r\x8ds-\xa3\xbd\x06SQ\x01\xf7c\xab\xe1\xf4\x1c\xc4R\xda\xef\x16\x86\x0e\x05\xc7l\xb2\xea\xfd\x1f\x7f\x8f\xa0q\xd89gHj։\xe6\x9aW:*$cy\x98\xe0\x93^\xe5\x83b-\xe1\xa1ܖ\x00\xe3\xa3\xd7\x1c\u0084m\xe2u\x98b%\x8e\x18cH\xd1Th\x15Ph\x14x\x00\xe8{z\x7fU\x8f\xa19\x99\xfb\x88\x9c\xba\xcb\xf5\xbf4\x9f\xcf\x01Ц?\x11F\xacU\xa2\xea\xd0\xeeD\xa1GB>;\xf9u\x97\x1ez\x1e\x87\xc3\xf9w\xa7^\x8f\xff\x00\x03\xeaqt3h\x12\xbd\x17\x91\rR\x8c]\xf7\n\x8e\xa6\xfd\xee\x8ai>d\xa72\x9f\xc2l{\x0f\x920:\x0f\x1c\xbbC|o\xcd3\r\xf8\xb2ڣ\xad\xecJ;\xef\xeei\x8b\xd2\xf3\xa2\x00\xb9\xbd\xbd}Y\f?\x11\xaf\xa4\xe9K\xc3E\xb1\\\x92\x12\xc4]\xf8\xdf\x12\x97\xabr \xe0\x1fd\x8c:\x99\x1f\xf4\x90\xf5\x1e\x81)\x06k\x95\xb6S?\x85e\x9c\xee|\x92\x11\x88\x82A\x9aG\x1ec\x18\xf0ͨ\x93\xe5\x91O,\xad\x15\x8a\xf2\xa4\xb0ȼRH\xce\xf2\xb0\xf0\x821\xa0[\xb5U\xdd\x17\x82J=L\x89\xfb\x8c\b\xe8)0^\xab-y;B\xca7u\x1d\x84q\x18M\xdb4l\xcbZQ\xde߉\x8a\x9d9\"\xd3i6\xca\xca@c\xe0\xda\xdd\xd3\x7fȏH\xce\a\xf6z\x15\x92\x04:hX\xa8\xebs\xe1s\xd6y[L\xbbc2\xdd\x01~\xa5\x19\x86b\x9b\xecڞ\xa2#\x87\x04\x88&\x9c\xa5D?\x91^?S\x18\xe8\xfb\xfc}\x03\x8e\xc1˴B\x87\xe1\f\x92\xc1$d\xc5l\xae\x85\x12G^\xb5U8\xdc\xe7\xea\b\xeb˸u\x1b\xf0\xd5T=\x1fo\x96\xff\x00\t\xba>ޟ\xd5c\xe89QJ\xecRI\xa7\x16\x88\x9d$_\xdeBGE5z2յ\x17\x8cJ#c\xe4\xbfC\xe1\xfc\xbbӯG!y\xef\xd9\xdc\xe7e\x16\xe7\x8c\x1ei\x04kԎ\a\x05\xc6\xcbI\xf3\xfb?o\x1b-'\xcf\xec\xfd\xbcl\xb4\x9f?\xb3\xf6\xf1NJ\xdb2u\x9a\xe2s\xb3\xca@vPN\xa0\xb3\xe0\xb1ʻ\x82>\x7f^\x7f\xfb\xce\xfb\xeb\xfd\xe7\xd5{\x1d9F\xb2M\"F\x83\xb5\x9c\xee\x8c&\x90ֆ8b^ōw\x14~\xc0:\x11\x03n<\x8d! \xf5\x06\xeeb\xcc\x00=\xb2\x92\xab\x89\xda[V\xa6y\xa7\x95\xb8\xbc\x92\x1d\xe6'\xc6ONo\xc0]\x8cܦ\t\xe1<CG\x03\xe3'@\x94d\x17\x15\x1cw\x9e'\x8eA\x84\x01-W\x99\v\xf8&D\x9e>\x84\xbf\xfc\xfa_Bd\xe8Ť\x19\xc4\x1aM\xff\x003X\x04?*b-\xfa5Ow^\xec0\xc0A\xdd>\am\x13\x1e\xab)\xd8\xe8N]K\xb1\xef\xcb\xf8\xe7\xf1\xa7+\x95\xadR\xbc\xb3\xcc\xdak\xbb\x1cJ]\x8f\xec\x03\x03\x99\xda-\xb0\xb5=\x8b\xa9\xdfF\xb9\xf8[\x1ej=!\xe9{\xfc}\x03\x8e\xc1\xd0\xf8F\x7fE\xd1\xf6\xf9o\xf0\x9b\xa3\xed\xe9\xfdV>\x8b\x12\xd62\xbas1\xf0\xc9\n\xb7@\x02\x17&\xb6\xfem\v\xf4>\x1f˽:\xfaϼ\x87\xa4\\v\xf2\xf0\x93=\xcb\xd0\xf8\x9au\xe8\xf1\xb9v\x9c\a\xces\xbf\xc9\xeb\x04\xea\xf9\xb5z\xff\x00\xbbh\xf3\r\xf4\xfa\x11kc(\x9e;\xc3N%:\xe3\x93\xf6\x00\xda\xe3\xadѲ\xc8\xfeJr'CR)\xd9\x06d\x1c^\x16\xf5\x12\xa7\xedBq2\xcb\fѬ\x91H\xa7Udq\xa8#\xa1\x16\xfd\xca#\xbb\xea|x\x01%|n\x9a\x8cS\xe7\xb3\xcc\xfaA_)\x88\xf8\xcc0x\x81\x90\x97\x7f\xd0\x18\x93\x9e\x99\x01\x96݃\xec\xacY\x93\xaeI[\xc6yWX,\xd9I-\x0e\xda\xf5\x88\x91\x97\xc4\xec\x15\x0f\x80\xe2]\xea\x1984+\xf6\x17C\xac\xcf\xfb_\xa5\xef\xf1\xf4\x0e;\aC\xe1\x19\xfd\x17G\xdb\xe5\xbf\xc2n\x8f\xb7\xa7\xf5X\xfa \x86\x83)\xa5\x11\x1f\x12\x15\x1d\x02:\xf2;\xcb\xe5\xc6W\xa1\xf0\xfe]\xe9\xd7\xd63\xaag6\x83p\xc9Hʢe\f\x03\x03\xb8z\xc8\xd0\xe3\xbdHzT\xc7o/\x05\xcf\xf2\xd2|\xfaa\xbf\xb5ւ\v\x12!\xf7;\x05\xd5\x1b匎\x80\uaad8S\x94\xf8\x8b\x18\xfd`jFyA\xff\x00bL\x1c\xf4\"\x12׳\v\xc3,g\x83\xa4\x83u\x87\x8bC\x89\x11秚U\xac\xc58\x1ej\t\xba3ks#qT\xf6\x9a\xed\xa9\x87\xectj\xa4u\xa9D\x91VN<ڢ\xee\x00?gA\x80\xba \xeeZ=\xa6y\xba\x93\xc9\xf6g\fI'RO|\x9e\x97\xbf\xc7\xd081\xd1\tܦ\x8d\xf2O2\xe6hU\xccs{C\xa9\xea=\x0f\x84g\xf4]\x1fo\x96\xff\x00\t\xba>ޟ\xd5c\xe8\x03\xbdz\xec0\x12?%]\x80f\xf1(\xd4\xe0\x00\aP\x03\xb0rێ\xb5:љ&\x9aC\xa2\"\x8cE\xccE\x99\xc8`\xa3\x1b{3\\\xdc\x10\x82\xff\x00\xa4腺\x1f\x0f\xe5ޝ}b\xeeW]\x13.\x8e\xb4\xc2\xd3ʎ\xee\x929\xe0\x91\xbe/e\xa73\xbb,Γ#\xc8`\x02FB5%\x03c:\xd9\xef?c\xee1,2Y\xa3a\xa0\x95\xe1$\xc6Y}\xa9`\xa7\x93\xd9U\xb3\x14\xeb\xe3\x89\xc3b@r\xdc҅\x8c\xaf0qށd\x12+\xf8\xd0ɮ\x182\xb0\xd4\x10u\x04\x1eX\xf7\xe6\xb5I\xfb\x9d{g\x8f\xf0\xb1|\xae\xb8\x1a\x1eΜz\xd7ɪ\xcb;\xb7x\xcb00ƽ\x1f\xf3\xedٳ\xe6\x10 \xf4\x9d\x19J\xd1Ϳ\xb0O\xd8\x1aC\xacO\xfb\x1f\xd6e\xd6\f\xaa>~\xd0\xed\xb5?\xd8N\x9f\xbf\xc7\xd08\xaa\x05x\"\xa1\xddwe:A\x005\x93\x17e\xb6(T\x8a\xb8\x9eod\xe2%\xd3^_\x84g\xf4]\x1fo\x96\xff\x00\t\xba>ޟ\xd5c\xe8@Q\x15\x1e\f\xa8\x11ķ\xa9\x96\x7f\xe4\x1d\av\x8a\x05\x8ak0\xa7\x19\xadO\xf8\xa4?\x10aԴJ\x8f!^\xcaQs:\x9f\x8e\xef\xd0\xf8\x7f.\xf4\xeb\xd1\xda,ʵd\xee-\xc8a\xb5,h\xbaՈ\xf0S\x8d\xad\xce>}?\xda\xc6\xd6\xe7\x1f>\x9f\xedcks\x8f\x9fO\xf6\xb1\xb6\x13\r\xa4\x96i\xcdey\xe66t/\x1e\x9b\xaf\x8d\xad\xce>}?\xdařg\xb1+\x16\x92Y\\\xbb\xbb\x1e\xfb3jI\xe5\xf5v\xf2q\\\xcf\xdfr ֜\xdfo\x16\xb4̲ؿ\xb0\xbb\x9e\xb9\xea\x8e\t㋡X\xff\x00R\xe6\xf3\x195\x1c \xb4\xfdo\x19\xf8\xdcW\xa5\x03\xcfby\x16(\xa2\x8dK;\xbb\x9d\x15T\x0e$\x9c*6km\x85\x8c\xc2E\xf7N\xf4`\xf6F:/\xea2\x9a\x11B\xeb\xd94\xda\xccz,C\x03\xa8#\x88#\x0e\r\xa7\x80Cs\xc1b\x1fP\xfe-x\x81\xd3:V\xa3ZI\xe4\x1a\xe8[pj\x10~\x93\x1e\xa1\x897\xed]\xb3%\x89\x8f\xe9\xca\xdb\xc7N\x9f\xbf\xc7\xd08@\v\x90\xcc@\xf6G@5?\xb0t>\x11\x9f\xd1t}\xbe[\xfc&\xe8\xfbj\x7fT\x8b\x93*\xb5zǹV\x89\xa5`\x0f|\x85\xe00\xcb\x14(C.S\v\xea\xf2~\xbeD\xc4)\x14\x11\"\xc7\x1ch\xa1\x11\x11F\x8a\xa0\r\x00\x00p\x1d\b\xf7\xe8\xe4\x19\x93\x97s\xc0\xcf[\xf0\x10'ʛ\xf8\x97~\x85M(\xd2=\xb1A\xc5\xfcN\xe4\xb7C\xe1\xfc\xbbӯG\xff\x00\x83\xf58\xb0\x0e\x01\xc08\xa6֯Y\xdf\x10¥T\xbe\xe2\x97n\xb7 p\x18\xa6կV*&\x85\x8a\xb1B\xca\x18u\xa9#\x81\xe8\x10\xf5s\b$\x9e\b\xfbD\x88!\xb0\x98\xb2\xf5\xf3<\x9a\xf6\xb0\xd8N\xad@\xf5I \x1e\xd6E\xc4)\f\xb6ca4JI\t4Lc~ZQڥj>nhd\x1e\xa5\x94\xff\x00\x02\x0fX#\xacb9s\x8c\xaf\xdc\x13\xae\xe4?{\xe3LB\xf1ʌU\xd1ԫ)\x1d\xe2\x0e\x84`rd\xb3\xdb\xf5Z<\xc0n\xc3\x17Ǒ\xb4Uđ\xdf\xcf\xcaiπy\x9a\xa0\xf1X\x01\xfa}\x19y\xba\xb4\xeb\xc9bf\xecHƧ\xc6q\xf8\xfb\xf6\xa5\xb0\xe3]B\x99\x1b]\xd1\xe0^\x03\xa56\x91_N\xeb\xa6;'\x84h\xe0xӧ.\x92\xdear\xef\xea\":F<N\xfe\xb1\xef\xf1\xf4\x0e;\a+\x05DVvc\xde\n5'\t\xa2\xdeqk\xcf\xd7\x0f\xd1\xf6\xf9o\U0001b8f2\xc9rX\xa1\x13ڕ\f\x86G\x11(A\xa2\x87Q\x8f\xe8R\xe6i$|f\x96\x85n\xe7\x8c\xf6I5\x89H\\e\x95\xa8\xe8?\xbb\xd6 Ą\xf7\x86\x8a\x9fã]\xe7\x928\xd9\xd2\x14:4\x8c\x06\xa1\a\x8c\xf5b\xdaK\xb5\x9bBg\x9e\xed\x88\xf8\xef\xd8\xfc}\x8f䋣\xf0\xfe]\xe9ף\xb30Z\xbdcs\x9d\x9d\xdeP[qB/\x06\x03\x80\xc6\xc1\xa5\x93\x12\x17\xe6`y\f\x8f\xa7y\x03H\x018\xd8<¤\xe3\x8cSӱ\x13\x8f\x18i1\xb2V<\xc4\xdf}\x8d\x9c\x9a\xb6tL\xdd\xcb3E*\x81\xa4._\x8c\x8d\x8c\x96o\xfde\xa4\x00[1\xbe\x9b\xfaFA\xd7\x7fOaЗr\xdd)\xd6X\xfb\x1bN*ߢé\xb1\x19{u\xaa\xff\x00m\xaf\xc5\xcc\v\xd6\xe8\x7fN\xb9\xc4\xe1#\xbd'ueŽ\xdc\r\x1e/\xdf\x1d\x1c\x82\x85\xfd\xd5\xd1M\x9a\xe9)_\x8a\\\x121\xb1\x95\x81\xfd\tg\x8c|\x88\xe3\x1b\x15\x96\x13\xdb4]\xd3\xe9\x8b\xe2$\x8e4\x1a\" \n\xaa\ax\x01\xa0\x1d+\x1a\xcd)K\x19\xa9_\xc8N1@~\x99\xe9\x9f\xed\x14mG:\rt\r\xb8u*|\f:\x8e/I\x0eS\x99\b\x8c6D\x0f(\x1c\xf2o\xa0q\x10b1ry\x1e\x8cq\xc8\xcf$F!*9\xd3X\xc3h\xc7C\xc7P:\x12,qƥ\x9d\xd8誣\xac\x92N\vs3\xceV\xaa\x9e)^?Q\x18\xf5\x8f\x7f\x8f\xa0q\xd89IM2\x8bQ\xa3\xf6<\xc8bO\xfb\xb6;\xd5k}M:*\x1d⎔\x81;J$\xe7\x04\x18oT\x8a\xc4}z\xee\x89T6\xe9\xfd!\xc0\xf4)\xc5f\xac\xe8RXe]\xe4u=\xe6\x18\xa7\rjШX\xa0\x85\x04q\xa0\x1d\xe5U\xd0\x0e\x93\x89,I\xbc\xb4\xa9+i%\x99\axv \xfc\xb7Ŏvݗ\xd4\xe9ԑ\xa8\xe0\x88;ȼ\aG\xe1\xfc\xbbӯN\x9c\x16#W\x0e\x16h\xd6A\xbc8\x10\x1c\x1cR\x83wM7y\xa4\xd3O\x93\x19=:m+\x17\x94ׁ\"\xdfc\xdfm\xc058\xf7j\xdfV\x8f\xa2^\\\x86\xf3\x81r.&\x17\xe0'L?;\x92ͥ\xc9\x16\x99\xfe\xe8}\x98\x9e\x02\xbf\xe5}\fZ\x8a\x96n\xa0\"]}\x12\v^\x17\xefG&\x0f\xad\x98\xe7έ\xa1\x14*\x9fM'dk\x8b/bՙ^Y\xa6s\xab;\xb9ԓ\xeb\x12\x0e뤌\xf4K\x1e\t)\xe7\"\xf3s`\xf71\xbfZ\\\xb7\xf0\xba K\x02Ep\x8f\xe3(S\xa16\x97s\xc64\xa3\xed\x10q\x9d\xbdg\xdf\xe3\xe8\x1cv\x0eR9\xdc\xd6\xfc\x10i\xfa\x10\x9e}\x9b\xe5L{ֿԓ\xa3\xefz\x9e\x8a|[\x10њ]\xfc\xb6̇D\x82G:\xbc/؏\xebN\x99\xa6z5^\xe5\x8d\xff\x00\x05\x03v\xce\xe3\xe8\f^{6\xe5\xd0\x03\xc1cA\xc28ׂ\xa0\xe9|?\x97zu\xc2\b\xf2\xab4\xabM\x05\xe4\x1du吰\"n\xd8\xce\x18\x15 \x10G^\xbd>Ïv\xad\xf5h\xfaI%\xec\x82W&J\xba\xfa\xb8\vqx>\xc63\x1a\x84LI\x97-\a\x9b\x84\xbfb{\x83\xfe\x83b\xcd\xc8\"\xac\xeb\xcee7д{\x9d\x8a$\xeb@{S\x13q\xd1'\x80\x9f\xc2W\x94q\x8d\xbde\xa2\xbf\xb4\x05}E5mR\x02x=\x92>\x86/Ij\xed\x86ޒG\xff\x00\xb0Q\xc1Tw\x80\xe5ڥ\xbcE\x98`\xe6\x053\x0f\xe3|%\xcf#\xees\xd3${\xdak\xa6\xfb\x05\xd7\x1b|\x9f\xf4\xe3\xf7\xd8\xdb\xf8\xff\x00\xe9\xa7\xefq{\xba\xcd\x19\x119\xfeo\x9a\xdf\xdf@\xfe\xc7Vӎ \x82L\xa1,˖f!\xac\b\x9c\xc6\xe8\xac\xdeF\xa1\xb1,\x1d\xc0$\x8e\xf4T\xb9\xbd\xfd\xfb\x8e\xac\x1d\x8ff\xeb\xe9/B\xa5O\xea\xbaUҽ \xf6\xd1\t\xfc\xb7r;Y\x8e6\xf6 \xda\r@\xcb\xcf\xde\xe3o\xe3\xff\x00\xa6\x9f\xbd\xc6f/\x18\xa1\x82N|E\xcc\xeb\xcf }4\xd5\xf93\xe1\x97\x7fW\xc7\x03\xeak\x99\xf7\xf9\xe2Gy\xd3\x1b\x7f\x1f\xfd4\xfd\xee6\xc9mw\x04\"^`Q1\x97Ղ\xf1\xe7\x0e#Y-ܝ \x81K\x05\x05\xdc\xe8\x06\xa7\x19T\tN\xa5\xb1$ζ\xe1r\x06\x87\xa1\x94\xc2\xf9F[X\xac\x0e\xf6\xe1C,\xd3hd}\t\xc6f\x99s\xe5T\xe2\x16$\xe6ŏ¤\t\x11@\x03&6\xfe?\xfai\xfb\xdcm\xb2L(P\xb3o\x99\xee\x02\x85\xf9\x84/\xbb\xaf;\x8a\xb1\xcfj*\xcde\xd5\xe5X\x876\x8c\xa8N\xaf\xf1\xb1\x93\xd4\xf9\xf4\x18\xcd\x11\xed\xe7Uk\ta\x11\x7ft\x91\x11\xc1]\xe0\xe4I\xec\xf1\xb7\xc9\xff\x00N?{\x8c\xeaL\xf7!za\xeb]p\xb0\xb5\x19c:\b7\x1d\xdd\xda6^\a\x82\xe0\x8c\x11\x820y3\xea\x14?\xe6l\xa4D\xf8\x83\x1dN;\xab:\xb3\xde\x10!\x82\x1f\xdb$\xb8\xb82\x8c\xb9\xf5\x06\xb5\x12Q\xdd{%\x9bٷLj\xce\xc1@\u05feq\x94\xd7J\xb4szs\xce\xc2\xe4$\x84\x86P\xc7\x19t\x13S\x19t\x11o\xb5\xa8\xa3;\xe8[Q\xa3\x1cT\xcbnQ\x854\x82\v\xd6a\x94D;\x11\x83\xa3\xe3)\xc8)\x9e\xf3\xc0\xd0\x12<\xf3\xbe)$\x19\xcc\x04\xc7<\x89$N\x96\x00\xe1(溆\xbd\xf1\x821\x92\xb6ip\x10#\xaa\xb6#\xae\x18\x9e\xfbI)\x01G\x88\x13\x882\xea\xf0\x02\x02\xe5\xc2z\xcf[s\xb0\xef\x9dN6G\xfa\xb2\u0081\xac\xf5\xaeCf\xbb\xf6\x90\x03\aL\x1cepKJԐ\x18]\xedD\x84\x84\x85\x13\x818@\xb6*X\x92\t\x94\x10\xc0I\x13\x15`\b\xe25\x1d\r\xa9Z\x1fڦ\x83\x994\xccߊӾ\x1dqw\xba\xbb\x82~k\x9f\xdc\xe6\xb7\xfa\x81\xd7wV\xd3\x19\xac\xb5&\xd0\a\v\xd7\x1c\xab\xedeC\xa8q\x8d\x90\xa4\xf3\xe5\xaf\n9\x04\x90\xc6}N\xf4'\xa9\xe1\xc6\xd7اY\xfa\xad\xe5\xd7!\xee\xa8gN\xcd\xf41\x91\xa7\xe4\x1e\x9e\x7f^;\n?\xb9\xc4y\xfb>m:\xd7^\xd6\xd0c>\xff\x00\xd2\xd4 \xb5ܦc_\x9f\xbb0)\xbf\xa8p\xea!\xc7\xf4\x80\x1d\xdc\xea\xc4\xe5\xe4\x9fM\x8d\xaa[ۖa\x83\x98\x14\xcc:\xf3\xbd\xfd\xe3#r6\xef;*&\xbak\xa6\xf1\xd3\x1f\nS\xfe~O~A\xe9\x06;N;\x0e=\xf3\x0f\xa0Lm%\xfa5^S+C^v\x8dK\xb0\n[A\xdf b\xf8\x9f7\xa0\x82\xb6`\vj\xfa\x82Dr7\xeb\x14r_\x9a\x9d\xc8EA\x1c\xf0\xb9I\x17~\xccht#\x0fc5\xb3r);\x96\xf5\x8bNe\xab)M\xc4 6\xa0\xaa\xe3n\xb3\x9f\x9d\xbe\t$Ƅ\x93\xdaW^OyQ\xf4\\\x9e\xe1C\xe9\xc9\xc9\xef\x11\xe9S\x16^\vU\xa5Ya\x99\x0e\x8c\x8e\xa7PF6\xdb09:X\xd6\xe0\x91\x9aU1\x85<U\x01'\x12\xef\xc3<I,o\xa1\x1b\xca\xe3x\x1d\b\x1cA\xe4\xda[\xb4\xab\x1c\xaa\xb4\x86(d\xd1w\xcb>6\xdf4\xf3ص,\x8fk!Y\xe7f:\x99d1\xc2K\x1f(\xf2\x7f\xbb\xb9\x9f\xd5\xdb\x19\xa4\xf4l\xc9\t\x85具X\xc6\xc41_\x11#\x1bq\x9a\xf9\xecY{\x16le5%\x9aW:\xb3\xbb\xa0%\x98\xf2g\xf6\xe8E.Z\xcf*@\xfa\x06nt\xe3n3_=\x8d\xb8\xcd|\xf66\xe35\xf3\xd8ی\xdf\xf7l\xb2\xe3j\xf3{H\x7f&k\xb3H>FoZb\xac\xa4\x10Gx\x8cm\x86c5k9\xd5\x18\xa6\x89\xa5\xd5]$\x98\x06\x18\xdaK\xb4j\x9c\xb6\xb4\x9c\xcc2h\xbbľ6\xe35\xf3\xd8ی\xd7\xcfcn3_=\x8d\xb8\xcd|\xf66\xe35\xf3\xd8ی\xd7\xcfcn3_=\x8d\xa3\xcd\xef\xe5\x10\x99M\x9a\xa8\xe1̛\xd12\xafS\x90\x0e\x8cq\xb4\xb9\xbd\f\xa6gCZ\xab\xb8C\x18\x11\x85=HHĥ\xab\x9c\xd9*\xb4\xb2u\xbd\x8b6\x03J\xe7\xf6qc\xd0\xf8V\xe7\xf2c\xdf\xff\x00ȼ\x9e\xf8\xa1\xf4%\xe4\x10\x9bu\x1a\xaf6&M\xf4<\xe4\xe9\x1b\x021\xb39T\xff\x00\xa8y\xa0\xfbx\xd8u\x84\xe69\x85Z|\xf8\xbeN\xe7? \x8f]9\xae]\xa9\xbc\x99}L\xd2\xe5hkA'0\x82\x18\xe4*\x11\x84Zo\xf2|0=\x00\xe4\xf8J\x97'\xbea\xfac\x1f\nS\xfe~O~A\xe9\x06;N;\x0e=\xf3\x0f\xa0NNܳ\xff\x007'e\x1f\xaeE\xcb\xeeQ\xfd\x11\xc9\xef*>\x8b\x93\xdc(}99=\xe2=*c\xb7\x93\xe0\xda~\x85y>\x05\xab\xf4ߒ\x85\xc8ld\xbb8Vy'\b#r\x9c\xc4'wp\x9eO\xf7w3\xfa\xbbr\xfc\aK\xd1\xf2|\x12\xfe\x9d\xbf\xc0G#×\xe6umȑ\xe8]\x96\t\x03\x90\xba銖k\xc0(\xc1\x01K\x01C\xefG\xaf\xb4-\xebEE̳i\x90\xcf\x1f|\xa4\xe9#\xa4\xbd\x0f\x85n\x7f&=\xff\x00\xfc\x8b\xc9\xef\x8a\x1fB^OmC\xebQ\xf2\x7f\xbc9g\xd6\x13\x97\xe1\xec\xc3ӷ'\xc3\x03\xd0\x0eO\x84\xa9r{\xe6\x1f\xa61\x9dP\xb3/\xf5\x95C\xcd\xc3b9\x1f\x91ªۀ\x92N\x80\x00\xe3\x1bO\x95|\xf6\x1f\xb5\x8d\xa7ʾ{\x0f\xdaŘ\xa7\x85焤\xb18\x91\x1bHS\x81^LΥC)˷9\xf9\x92-t\xe7\xb8o\xe3i\xf2\xaf\x9eC\xf6\xb1\x9fe\xf6'qOr(\xadF\xeet\xb7\x19:\x00yv\x9f*\x04D\x9a\xebv\x1e\xc1\xfaX\xda|\xab\xe7\x90\xfd\xac\\\x82\xcc&\xa5=%\x85\xd6E:Eڜ\x99\x9dJ\x81\u18f8g\x9d\"\xd7F~\x1b\xf8\xda|\xab\xe7\x90\xfd\xacg\xf9l\xf3= \x128\xedD\xeeO8\xbc\x00n]\xa6\xcb\x15\xd3.\xaa\n\xb5ȁ\x1aD:\xba\xdb\x1bO\x95|\xf2\x1f\xb5\x8b\xd5\xedB2\x8a\xc0\xc9\x04\xab*j\x1d\xfb\xeb\xc9~\xb5H\x8eE2\a\x9eT\x8c\x12g\x8b\xbe\xf8\xda|\xab\xe7\x90\xfd\xacm\x1eY$\xb2\xe49\x8aG\x1a[\x89\x99\xd9\xe0p\x00\x00\xf2\xed\x16[\x14\xa9\x93SI#{p\xab)\x11\x8dA\x05\xb1\xb4\xf9W\xcf!\xfbX\xcck[E\xca\xd8\x16\x82e\x98\x03\xcf\x1fi\xfe?;\xa3Zc\x99\xdb<\xdc\xf6R&\xe0\xbd\xe78\xb1\x14\xf0\xbd\xcdRX\x98:0\xdc\x1c\n\xf2f\x94\xea\x19&\xa2S\x9f\x9d\"\xd7A'\xb7\xc6\xd3\xe5_<\x87\xedc>\xcb\xecNƖ\x91Ej7s\xa5\xa8\xc9\xd1A\xe4\x95#\x8a<\xfb.y$s\xa2*\xac\xeaIlm>U\xf3\xd8~\xd66\x9f*\xf9\xe4?k\x13$\xb1I\x9d\xdet\x91\x182\xb2\x99\x89\x04\x11ɜS\xa8\xed\x9b\a\v<\xe9\x11#\x99\x1c\x03\xe3i\xf2\xaf\x9eC\xf6\xb1\x9dѳ7w\xd4<\xdc6c\x91\xbeD<\x84\x00,\xc4I<\x00\x0e?\xe1\x10p\x0e\x01\xc08\a\x00\xe0\x1c\x03\x80p\x0e\x01\xc08\a\x00\xe0\x1c\x03\x80p\x0e\x01\xc08\a\x00\xe0\x1c\x03\x80p\x0e\x01\xc08\a\x00\xe0\x1c\x03\x80p\x0e\x01\xe4\x18\a\x00\xe0\x1c\x0f\xf0C\x00\xe0\x1c\x03\x80p\x0e\x01\xc08\a\x00\xe0\x1c\x03\x80p\x0e\x01\xc08\a\x00\xe0\x1c\x03\x80p\x0e6+-\xf3xج\xb7\xcd\xe3b\xb2\xdf7\x8d\x8a\xcb|\xde6+-\xf3xج\xb7\xcd\xe3b\xb2\xdf7\x8d\x8a\xcb|\xde6+-\xf3xج\xb7\xcd\xe3b\xb2\xdf7\x8d\x8a\xcb|\xde6+-\xf3xج\xb7\xcd\xe3b\xb2\xdf7\x8d\x8a\xcb|\xde6+-\xf3xج\xb7\xcd\xe3b\xb2\xdf7\x8d\x8a\xcb|\xde6+-\xf3xج\xb7\xcd\xe3b\xb2\xdf7\x8d\x8a\xcb|\xde6+-\xf3xج\xb7\xcd\xe3b\xb2\xdf7\x8d\x8a\xcb|\xde6+-\xf3xج\xb7\xcd\xe3b\xb2\xdf7\x8d\x8a\xcb|\xde6+-\xf3xج\xb7\xcd\xe3b\xb2\xdf6qB\nt\xaa\n\xb1$P\x8d\x17S\x023\xe3e\xe8۽j\x90\xb3,\xf2\xa1.\xc2v.\x98ج\xb7\xcd\xe3b\xb2\xdf7\x8d\x8a\xcb|\xde6Z\x8dK\xb0\n\x9c\xd4\xf1!\x0e\xbb\xf6\xa3C\xfe\vdhMj\xceOJi\xe5d:\xbb\xbc@\x93\x8d\x8a\xcb|\xde6+-\xf3xج\xb7\xcd\xe3b\xb2\xdf7\x8d\x8a\xcb|\xde6+-\xf3xج\xb7\xcd\xe3b\xb2\xdf7\x8d\x8a\xcb|\xde6+-\xf3xج\xb7\xcd\xe3b\xb2\xdf7\x8d\x8a\xcb|\xde6+-\xf3xج\xb7\xcd\xe3b\xb2\xdf7\x8d\x8a\xcb|\xde6+-\xf3xج\xb7\xcd\xe3b\xb2\xdf7\x8d\x8a\xcb|\xdf\xe6\x1cέ(\xbd\xd2\xcc\xc9\n\xfc\xaeF6\xba\xb4\xf2\x0e\tQ$\xb5\xaf\xed\x88\x15\xc6\xce\xe6wd흣\xaa\x87\xd2⹊L\xc6䓈Co\xee\t\x0fRk\xa0\xd7L\x11\xb9N\x9dz\xcb\xe2\x81\x02t;(\xfdr/\xf0_\x00e\xfe\x84~k\x91Q\x14j\xcc\xc7@\a\x84\xe3l\xf2\xc0\xc0\xe8\xe9\f\xc2Ï\x1aC\xbep\x99\xa6c\xd8\xd0\xd7\x11F|\xf1S\x8d\x8f\xab\x01\xefIr\xcb\xcf\xf2\xa4b<gpЍ\xb8\xa5Jѯ\xc8\xce\x1d\U00074655\xd4\xf6\x96-I\"\x8f\x12\xb1 b\x7f묱4\x02\xb5\xc7&T^Ȧ\xeb#\x13\xe6\x11\xda>Έ\xaaZe\xfd\xfdDx\xd9\vv;\x1e݄\xaf\xff\x00hĘ\xaf\x95\xe5\xc9\xdeh\xa03H<\xf1a\x8d\xb2̴<R\t\xbb\x99\x0f\x8d`\xdc\x18\xc93,\xc9ٽ\\\x90\xc1$\xfd\x7f\xa4\xc0\x1ceU\xb2\xd8\xd8j\xafr\xca\xfd\x18\xb7\xdb\x1bc\xf1\xe0\xa5[\xf8I.2\xcbW\xe7\x86Ex\xe5\xb9i\x8e\x85O\xb5\x8bq:=\x94~\xb9\x17\xf8/\x802\xff\x00B?5\xf1\x8360y\xf8I\xfeN\x9eRl\xc7\\\xa8\x9eft\x8a(\xf7\xbbZB1\xb4\xf9u%쬒Z\x7f\xfcC\x16\xf3<\xc9\xfb\xe8\xf2\x88\"?\xb2!\xae6?-\x8d\xd7B\x92\xc9\b\x9eU\xf1I6\xfbc\xa8\x00\x00\x03\xd6\f¥\xb1\x16\xf9\x85\xc2?\xe0\xe4\x12\x8d\t\a\xbe\xb8{\xefz\xd6c\x1dd\x13\xce\x1c\x04\b\xce\xe7@\xb8]I\xe1\xa6&\xcdM\x91\ns\xc5- S&\x9e\xabOQ\x89s\x7f\x9d/\xd8\xc0\xeb\x8aW\x8f\xc9b0\x1fv\xf5ء\x90\xa7\x15\x88\xb6\xb20\xf8\xab\x89s\x7f\x9d/\xd8ę\xc7\xce\xd3\xee\xf1&q\xf3\xb4\xfb\xbcI\x9c|\xed>\xef\x06\xf9\xb36i\r]'\x9c8ѣ\x91\xfb\xca=\xa7#\xdc[U\xa0\x86\xc5^\xe7\x94G\xacz\x94\x93]U\xb1&q\xf3\xb4\xfb\xbcO\x9a\x89\x8cN#/e\b\x0f\xe4a\be:0<A\x18{\xe9~\xae`\xf08\x82p\x80\xc6Q]\x0e\x85N\x1aCZ\x85X\xabBdm[r\x15\b5?\x9a\xfa\xcd+t\xa7\xf9e\x10\xff\x00?KX2\xd8\b7\xaf\x11\xaaƾ\xd1{d8\xa4\xb0R\xae4U\xef\xbbw\xdd\xcf}۾}t\xe8`\xabb\xe3\x8f\u05f8\x8d=\x1e#\xdfIsJ\xe6T\xed\x8a6\xe7$\x1eJ\xf2\xe8V<\xda\xea)\x1c4Y\x9b\x03\x88\xbd\xf5Y:?\x97\x9d\x87\xf2 ~I\n\xc1}\xdf/\x9b\xc2,\x8d\x10y\xcd9SqS3\x9eXײ9\xcf<\x83\xc9|\x1e\xbb4ඞ\x0e\xe7r\x87\xd2\xfel\xe0\xb9L\xf3\xfc\xdcs\xe3\xe8c&\xbby\xfd\xa5Z\xef9\xf9\x10\x1cl\xb4\x95a<^ܱ\xd7\xd3Ǝw\xf1\xb5\x19u?\xf9h\xe4\xb5\xf4\x84X\xcd\xf3[\xd2\x0e \x18\xebD~@͍\x8c\xa3!\xed\xb6\x1aߧ/\x8a\x90օxE\fk\x1a\x0f\x12\xa8\x03ןz*)\x058\xfc\x1c\xd4`\xb8\xf2\xc9\xc0\xea\xcb2\xdb6\x15\xbfNM \x1e\x93\x93B(Q\x9e\xc0\a\xf2\x9a4%W\xf7\x8e\x1c\xb3\xbb\x16v<I=d\xe3\xbfq\xa3\xf3\xb1:txIn\xdb\xf9\xb4^I\nM\x04\x89,n8\xab\xa1\xde\a\xf6\x11\x81\xa4W\xe9\xc1e\x17\xb0L\x81\xf4<\x89\xea3,\xae\t\x1d\xfbe\x84\x98N$܊\xef?JO\x0f=\x19\xdc\x1eX\x1f\x9b\x11]\x1dJ\xb2\xb0\xd4\x10{\xc4\x1c UQ\xa2\xaa\x8d\x00\x03\xfc\x11\n\aY'\xbc\x06\x01\x06\xfd\xfb\x16\x88\xfdt\x85\xf0\x80\x89\xedW\xa7\x19\xfdB\x19_\xd2\x0eG\x1c\xeeoz(\b\xef\xf3P\xfe\x19\x8f'ô\x17˘/G\xff\x00\xc9\x1fC\xcaA\x97*\xb756\xed)\xf8\xe4\xfa|\x89\xfd\xd6\xf4\xf5>r\x9b\xe3\xd1`j\xf4.״\xa3\xc3\v\x87\xc3\x06V\x1b\xcaG\x02\x0f\xe7\xe7\xddx\xf2\xc9\xe3\x8d\xfb%\x9csI\xa7\xednE)-\xe7\x9e\xe4\xa3\xf5\x92\x15C\xe4(\xe4}c\xca\xe8o\xb8\xec\x9a\xd9\xdf\xfa\x01y?\xc9\xcdi\xc9\xe4L\xa7\xa3\xf9\x14,I\xe7%\xe4r\xea\xb0ՙ\x1b\xb5,\xc0\x93\x8f\xa7\x87\x01/\xd2K1\xfe\xb6\xa1\xff\x00G\xe4M\xf9\xa9\x88.G\xe0\xe6$\x05ϐO#j\xe7,\x86\x19\x1b\xb6JØs\xf2\xa7\xe7\xe7\xf5Y\x96g\x04,\xbd\xb1\xc4\f\xdf\xc5\x06\x14\x92z\x80\x1d\xf2pF\x94(W\xab\xe6c\bO$\x9b\xf1ڿ)\x80\xff\x00\xec\xa1܋\xe4@98\xc7\"\xb8\xfdӯG\x82d\x117\x95b^D\xd1,\xe5b\x8c\x9f\x1e\x97\xfa\xab\xe1\xc2E\x15\xf8㝏z\x19\xff\x00\x05!\xfd\x8aܚ\b\xefR\x9e\xb3\x93\xde\x13!L!WF*\xcax\x82:\x88Ğ\xaf-\xcc\xe7\x8d\x13\xb2)\x80\x97\xf3\xf7\x1a\xf4\xe7\xb8\xe3\xfea\xc2\x0fE\x84\f\x92fp<\xa0\xf7\xe2\x84\xf3\xaf\xff\x00\xd5y\x1bvX\xe8\xbcP0\xe2&\xb1\xf8\x18\xcf\xec-\xc9\tI\xf3\\\xaaK\xb2\xeb\xdf\u07b3\"\xc6|\xd8^C\xa8\x9a\xac\x12yh\x0fC\xfc\x9c\x9e\xaa|\xae\xef\x8e.\xea\xbeQ\xd3\t\xac\xb9U\xa8n\xa0\x1cJk̿\xfd\x9f\x92]\xf9mP\x88\xce\xdf\xfb\xf1\x8enQ\xe5\xa9\xe4P\xa0fSL\x8bؖO>\x83\xe4|\x7f\xb5Q\x86\xdf͟p\xfa_\xcf\xd2o\xc5K\x99\xa7\x1f\x83\x99\x8co\x8f,\x9c/V[\x96X\x99\x1b\xb2It\x80}3\xc8\xda=\xeb\xaffO\xd5\xd5\x1f\xea\xf8]\xeb\x17,E^\x15\xedyX\"\x8f\x94\xe14\x82\f\xb2zQ|J\xa609\x0e\xa6\\\x8b/s\xe3h\x17\xa1\xfeMj)\xf2\xc0\x1f\x1f\xe7f5c\xf2\xa5\x03\x1f\x88\xbdVz\xd2|Y\x94\xa1\xfe8\x8frz\xf2\xbc2\xa7\xb5x\xce\xe9\x18\x93Y2\xbb\xdb\xe8;!\xb6>\xda7\"z\x8c\xc3+\x85\xdd\xfbe\x80\x98\x8e$݆\xdb\xcbNO\x0f?\x19\b<\xbfϬ\x15To1<\x00\x18\x04=\xfb\xd6-0\xf0\xcc\xe5\xf0\xa0\x8b7 \xa6\x87\xfe]\f\x87\xd2\xf2>\xb0\xe55a\xa4\xbe\x99\xff\x00\xee\xf8@\xf0e\x91K~Q\xfa\xa1\xb9\x1f\xff\x00w\x18\xe1\x1c\xb7\xe3\xf2\xc4\\\x9d좴~hnt;\xcfQ<\x8a\xb1\xae86}\x97\x03\xe7ג=\xc8s\x12\x99\x8c^\x1e\xe9\x1a\xc8|\xe0l1\xe6\xb3jS@\x17\xbd\xce\xc49\xe4\xe4\x1f\xdd/\xcdS\xe7)\xbe=\x16\x06\xb2ѹ\x05\xa4\xf8иq\x87\f\x8e\x03+\x0e\x05Xj4\xfc\xf8J\x95\xcb&\x86&\xec\x92\xc8\xe6P\xfc\xafț\xb2\xdc\xe7\xeeI\xff\x00\xfbHw\x0f\x90\x06$\t\x04\x11<\xb2\xb9\xef$cx\x9f\xd8\x06\x06\x93_\xb95\xa9\acL\xe5\xf0\x9dvl\xc5J#\xdf\v\x00\xe7_\xe5.1\xc53\x83\x1f\x9c\x81\xb9:\xdcEe<ݙ\x17\x97\xb0\xe0\xeb\xa6d\xf1\xf9\xa0\x13\x03]3X\x1f͝\xfeD\xf6\x12KBw\xf8㞋\x1a\x96\xa1z\v:\x0f\xca\x118b\xbf\xb4a\xc3\xc6\xea\x19\x19x\x15=`\xe2=e\xab\x1cW#\xf0\n\xf2\x06s\xe4r\x1dO\xf5lP;w\xcb\xd6\xfc\x03\x1f\x95?>?\xab̳8ct\xed\x8a\x10e\u0096f:\x00;\xe4\xe0\xea\x94(ת\xbe\x11\n\x04ĚO|&]\x17\x84\xd9:H<\xd8nE\xd2g\xa6-\xcdۿl\xf3\xc4\x1f\x10m1\xfeNyZC\xe6\xa5NO\xf2-]\x8f唿C\xe1\xfc\xc4\x0f\x10\x9d\x868\t\xac?\x9b\xaf#rF\f\xd5k\xf7l'\xb0\xd5<\xe9\xd3ƀ\x8eG\x06j\xb5\xfb\x86a\xd8j\x9eh\x13\xe3@\x0e4\xe6\xafT\x9e\xb3\xea5\xf53!C\xfcp\x85d\x8d\x8a\xba\x9e \xa9Ѓ\x87\xd6L\xbf4\x95\x11;\"\x9d\x04\x9f\x9f?\xd9h\xcdi\xbcv_pz, ul\xce\x19d^\xd8\xe0<\xf3\x8f\x919$\xe0\x92ߝ>9\xe6\xa2±\x17\xafA\f\x9b\xbcDe\xbd[~\xc5\xc0\x00\x0e\xa5\x00p\x18\xff\x00\"\xcd'\xf9f\t\xc9\xfeNye>X\xa2n\x87\xf9\xb9\xad\xc7\xf2\xa6c\x8f\xf2a\xbc\xff\x00-gNDW\x8dԣ\xabp*z\x888$\xb5\v\xd3\xd6\xd4\xfeP\x89ʆ\xfd\xa3\x0e=D\x91_\x81>8\xe6e\xe4\x00\x0f\xeb)g@8\x04\xb5\xf8t\x1f#\xe3\x85̾+_5\x93p\xfa_Ϗ\xaaW\x8a\x9cQx\x17\x98V\xc2\xea\xd5\xf2˒\xc5\xe0r\x04|\x8f\xaaV\x15`\x84v \x81[\v\xab\xd4ˮM\x0f\x81\xf4\x11\xf2w\x96\xa7\xd6b\xe4\xe03\xaf\xa5\x02t:ɳ1>2\xe7\x1d\xec\xb2\xe7*\xe8l֩4\x9f\x1f\x9b\xdcąR\xddK\x90M\xe1A\x11\x97\xe9'\"hgʪ\xbc\x9e\x16\x05\xe3\xc3賭\xb8\xa5\xf0\xa1\x81\xcf\xf8_\xff\xc4\x00\x14\x11\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa0\xff\xda\x00\b\x01\x02\x01\x01?\x00\x11\x1f\xff\xc4\x00\x14\x11\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa0\xff\xda\x00\b\x01\x03\x01\x01?\x00\x11\x1f\xff\xd9"),
 }
+var resourceWordPng = &fyne.StaticResource{
+	StaticName: "word.png",
+	StaticContent: []byte(
+		"\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x01\x00\x00\x00\x01@\b\x06\x00\x00\x00S*\xa2\n\x00\x00\n+IDATx\x9c\xec\xdd͉\x1bA\x10\x80ѱQBV8\x02\x83\"PL\x02\x83\u0091CZ\x83\xa1/\xbb\xabߙ\uea6ayߩ\x0e:?\x15}\xa8\xd9\x1d\x0e\x87\x8fI\xd2\xcd.\x97ˏ6W\xebg\x1b$}\xedt:M\x95\xff$\x01\x00\x00\x00\xdc\x01\xa0:\x02\x00\x00\x00\x00\x1e\x00P\x19\x81]\x1bZ\xe7\xf3\xb9\x8d\xd2\xe6\xbb^\xafm\xfc\x8f\xc04M\x1f\x95\xde\x04l\x006\x00\x1b\xc0\x13\x1b@\xd5M\x00\x00\x00\x00\xc0\v\x00TC\x00\x00\x00\x00\xc0\x8b\x00TB\x00\x00\x00\x00\xc0\x1b\x00TA\x00\x00\x00\x00\xc0\x9b\x00T@\x00\x00\x00\x00\xc0\f\x00\xb2#\x00\x00\x00\x00`&\x00\x99\x11\x00\x00\x00\x00\xb0\x00\x00Y\x11\x00\x00\x00\x00\xb0\x10\x00\x19\x11\x00\x00\x00\x00\xb0 \x00\xd9\x10\x00\x00\x00\x00\xb00\x00\x99\x10\x00\x00\x00\x00\xd0\x01\x80,\b\x00\x00\x00\x00\xe8\x04@\x06\x04\x00\x00\x00\x00t\x04 :\x02\x00\x00\x00\x00:\x03\x10\x19\x01\x00\x00\x00\x00\x03\x00\x88\x8a\x00\x00\x00\x00\x80A\x00DD\x00\x00\x00\x00\xc0@\x00\xa2!\x00\x00\x00\x00`0\x00\x91\x10\xf8r\x13\xf0\xd7o7\x01\xdd\x04\xdc\xceM\xc0\xbf\x7f\x8em\x1c^\x84\x1b\x836\x00\x1b\x80\r`\x85\r \xca&\x00\x00\x00\x00`E\x00\xd6F\x00\x00\x00\x00\xc0\xca\x00\xac\x89\x00\x00\x00\x00\x80\x00\x00\xac\x85\x00\x00\x00\x00\x80 \x00\xac\x81\x00\x00\x00\x00\x80@\x00\x8cF\x00\x00\x00\x00@0\x00F\"\x00\x00\x00\x00  \x00\xa3\x10\x00\x00\x00\x00\x10\x14\x80\x11\b\x00\x00\x00\x00\b\f@o\x04\x00\x00\x00\x00\x04\a\xa0'\x02\x00\x00\x00\x00\x12\x00\xd0\v\x01\x00\x00\x00\x00I\x00\xe8\x81\x00\x00\x00\x00\x80D\x00,\x8d\x00\x00\x00\x00\x80d\x00,\x89\x00\x00\x00\x00\x80\x84\x00,\x85\x00\x00\x00\x00\x80\xa4\x00,\x81\x00\x00\x00\x00\x80\xc4\x00\xccE\x00\x00\x00\x00@r\x00\xe6 \x00\x00\x00\x00\xa0\x00\x00\xef\"\x00\x00\x00\x00\xa0\b\x00\xef \x00\x00\x00\x00\xa0\x10\x00\xaf\"\x00\x00\x00\x00\xa0\x18\x00\xaf \x00\x00\x00\x00\xa0 \x00\xcf\"\x00\x00\x00\x00\xa0(\x00\xcf \x00\x00\x00\x00\xa00\x00\x8f\x10\x00\x00\x00\x00P\x1c\x80{\b\x00\x00\x00\x00\xd8\x00\x00\xb7\x10\x00\x00\x00\x00\xb0\x11\x00\xbeC\x00\x00\x00\x00\xc0\x86\x00\xf8\x8c\xc0\xee\xc6o$\x15\xae}\x9a\x1c\x00\x00\x00\xc0\x1d\x00\xf6\xfb}\x1bKt<\x1e'o\x00\xde\x00\xbc\x01l\xf4\r\xe0s\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00 (\x00\xffػcS\v\x81 \f\xa3\x89uO0\xadX\x90-\x19\x8d\xa0\"\xa8\xcc&\xcb\xd9H^\x01\x87?\x98w?\v\xc0\x02\xb0\x00,\x00\v\xc0\x02\xb0\x00,\x00\v\xc0\x02\xb0\x00,\x00\v\xc0\x02\xb0\x00,\x00\v\xc0\x02\xb0\x00,\x00\v\xc0\x02\xb0\x00,\x00\v\xc0\x02\xb0\x00,\x00\v\xc0\x02\xb0\x00,\x80c\x01\xdc~\x0f`[\xcf\xff/l\x01X\x00\x16\x80\x05`\x01X\x00\x16\xc0\x84\v\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00t\x02\xb0<\xfc\xbd\xedED}z\x9e\xf7\xf1ef}Z\x00\x16\x80\x05`\x01\xf4.\x00\x97\x80.\x01]\x02\xba\x04t\t\xe8\x12\xd0%\xa0K@\x97\x80.\x01]\x02\xba\x04t\t\xe8\x12\xd0%\xa0K@\x97\x80.\x01]\x02\xba\x04t\t\xe8\x12\xd0%\xa0K@\x97\x80.\x01]\x02\xba\x04t\t\xe8\x12\xd0%\xa0K\xc0ח\x80\xa3/\x99,\x00\v\xc0\x02\xb0\x00,\x00\v\xc0\x02\xf8\xb1\x00t\x01t\x01t\x01t\x01t\x01t\x01t\x01t\x01t\x01t\x01t\x01t\x01t\x01t\x01t\x01t\x01t\x01t\x01t\x01t\x01t\x01t\x01t\x01t\x01t\x01t\x01t\x01t\x01t\x01t\x01t\x01t\x01t\x01t\x01t\x01t\x01t\x01t\x01t\x01t\x01t\x01t\x01t\x01t\x01t\x01t\x01t\x01t\x01t\x01t\x01t\x01t\x01t\x01t\x01t\x01t\x01t\x01t\x01t\x01t\x01t\x01t\x01t\x01\xae]\x80\x9d\xbd;6\x01\x00\x88A\x00\xb8\xff\xd6\xdf\xfc\x02)\x04\xc1[B\f\x18N\x03\xd0\x004\x00\r@\x03\xd0\x004\x00\r@\x03\xd0\x004\x00\r@\x03\xd0\x004\x00\r@\x03\xd0\x004\x00\r@\x03\xd0\x004\x00\r \xd5\x00\xb8\x00\\\x00.\x00\x17\x80\v\xc0\x05\xe0\x02p\x01\xb8\x00\\\x00.\x00\x17\x80\v\xc0\x05\xe0\x02p\x01\xb8\x00\\\x00.\x00\x17\x80\v\xc0\x05\xe0\x02p\x01\xb8\x00\\\x00.\x00\x17\x80\v\xc0\x05\xe0\x02p\x01\xb8\x00\\\x00.\x00\x17\x80\v\xc0\x05\xe0\x02p\x01\xb8\x00\\\x00.\x00\x17\x80\v\xc0\x05\xe0\x02p\x01\xb8\x00\\\x00.\x00\x17\x80\v\xc0\x05\xe0\x02p\x01\xb8\x00\\\x00.\x00\x17\x80\v\xc0\x05\xe0\x02p\x01\xb8\x00\\\x00.\x00\x17\x80\v\xc0\x05\xe0\x02p\x01\xb8\x00\\\x00.\x00\x17\x80\v\xc0\x05\xe0\x02p\x01\xb8\x00\\\x00.\x00\x17\x80\v\xc0\x05\xe0\x02p\x01\xb8\x00\\\x00.\x00\x17\x80\v\xc0\x05\xe0\x02p\x01\xb8\x00\\\x00.\x00\x17\x80\v\xc0\x05\xe0\x02p\x01\xb8\x00\\\x00.\x00\x17\x80\v\xc0\x05\xe0\x02p\x01\xb8\x00\\\x00.\x00\x17\x80\v\xc0\x05\xe0\x02p\x01\xb8\x00\\\x00.\x00\x17\x80\v\xc0\x05\xe0\x02p\x01\xb8\x00\\\x00.\x00\x17\x80\v\xc0\x05\xe0\x02p\x01\xb8\x00\\\x00.\x00\x17\x80\v\xc0\x05\xe0\x02p\x01\xb8\x00\\\x00.\x00\x17\x80\v\xc0\x05\xe0\x02p\x01\xb8\x00Q\x17\xc0Wh\xe7W\xa8\x13\xc0\t\xe0\x04p\x028\x01\x9c\x00N\x80\xc5\x13\xc0\x12\xd0\x12\xd0\x12\xd0\x12\xd0\x12\xd0\x12\xd0\x12\xd0\x12\xd0\x12\xd0\x12\xd0\x12\xd0\x12\xd0\x12\xd0\x12\xd0\x12\xd0\x12\xd0\x12\xd0\x12\xd0\x12\xd0\x12\xd0\x12\xd0\x12\xd0\x12\xd0\x12\xd0\x12\xd0\x12\xd0\x12\xd0\x12\xf0\xba\x04\xb4\x00\xeb\\\x80i\x00\x1a\x80\x06\xa0\x01h\x00\xe3\r@\x00\b\x00\x01 \x00\x04\x80\x00\x10\x00\x02@\x00\b\x00\x01 \x00\x04\x80\x00\x10\x00\x02@\x00\b\x00\x01 \x00\x04\x80\x00\x10\x00\x02@\x00\b\x00\x01 \x00\x04\x80\x00\x10\x00?\x00\x1e{w@\x02\x00\x00\xc2@\xb0\x7fk3\b\"ʮ\xc5\x06\x0fg\x01X\x00\x16\x80\x05`\x01X\x00\x16\x80\x05`\x01X\x00\x16\x80\x05`\x01X\x00\x16\x80\x05\xf0d\x01p\x01\xb8\x00+.\x00Oᦧ\xe0\x02\xb8\x00.\x80\v\xe0\x02\xb8\x00.@\xe2\x05P\x02*\x01\x95\x80J@%\xa0\x12P\t\xa8\x04T\x02*\x01\x95\x80J@%\xa0\x12P\t\xa8\x04T\x02*\x01\x95\x80J@%\xa0\x12P\t\xa8\x04T\x02*\x01\x95\x80J\xc0n\t\xc8\x05\xe0\x02p\x01\xb8\x00\\\x00.\x00\x17\x80\v\xc0\x05\xe0\x02p\x01\xb8\x00\\\x00.\x00\x17\x80\v\xc0\x05\xe0\x02p\x01\xb8\x00\\\x00.\x00\x17\x80\v\xc0\x05\xe0\x02p\x01\xb8\x00\\\x00.\x00\x17\x80\v\xc0\x05\xe0\x02p\x01\xb8\x00\\\x00.\x00\x17\x80\v\xc0\x05\xe0\x02p\x01\xb8\x00\\\x00.\x00\x17\x80\v\xc0\x05\xe0\x02p\x01\xb8\x00\\\x00.\x00\x17\x80\v\xc0\x05\xe0\x02p\x01\xb8\x00\\\x00.\x00\x17\x80\v\xc0\x05\xe0\x02p\x01\xb8\x00\\\x00.\x00\x17\x80\v\xc0\x05\xe0\x02p\x01\xb8\x00\\\x00.\x00\x17\x80\v\xc0\x05\xe0\x02p\x01\xb8\x00\\\x00.\x00\x17\x80\v\xc0\x05\xe0\x02p\x01\xb8\x00\\\x00.\x00\x17\x80\v\xc0\x05\xe0\x02p\x01\xb8\x00\\\x00.\x00\x17\x80\v\xc0\x05\xe0\x02p\x01\xb8\x00\\\x00.\x00\x17\x80\v\xc0\x05\xe0\x02p\x01\xb8\x00\\\x00.\x00\x17\x80\v\xc0\x05\xe0\x02p\x01\xb8\x00\\\x00.\x00\x17\x80\v\xc0\x05\xe0\x02p\x01\xb8\x00\\\x00.\x00\x17\x80\v\xc0\x05\xe0\x02p\x01\xb8\x00\\\x00.\x00\x17\x80\v\xc0\x05\xe0\x02p\x01\xb8\x00\\\x00.\x00\x17\x80\v\xc0\x05\xe0\x02p\x01\xb8\x00\\\x00.\x00\x17\x80\v\xc0\x05\xe0\x02p\x01\xb8\x00\\\x00.\x00\x17\x80\v\xc0\x05\xe0\x02p\x01\xb8\x00\\\x00.\x00\x17\x80\v\xc0\x05\xe0\x02p\x01\xb8\x00\\\x00.\x00\x17\x80\v\xc0\x05\xe0\x02p\x01\xb8\x00\\\x00.\x00\x17\x80\v\xc0\x05\xe0\x02p\x01\xb8\x00\\\x80\x01\x17\xa0ػc\x13\bb\x18\x8a\x82,l\x95\xea\xbf\x0f;0*\xc0\x81AB\x93\xdd50|\xc1[\f\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xd0\r\x80?\x7fx\x17\xc0\xbb\x00/\xdf\x05\xf0UhͯB\x9d\x00N\x00'\x80\x13\xc0\t\xe0\x04p\x02L<\x01\x94\x80J@%\xa0\x12P\t\xa8\x04T\x02*\x01\x95\x80J@%\xa0\x12P\t\xa8\x04T\x02*\x01\x95\x80J@%\xa0\x12P\t\xa8\x04T\x02*\x01\x95\x80J@%\xa0\x12\xf0\xb6\x04T\x80\xd5,\xc0,\x00\v\xc0\x02\xb0\x00,\x80\xe1\v\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1c\x00\xbe\x88X\xf9\xc7\x02\xb0\x00,\x00\v\xc0\x02\xb0\x00,\x80!\v\x00\x00\x00\x00\x00\x00\x00\x00\x80v\x00l\x8d2\xa8\xc0\x00\f\x00HD\xf0(\x87\x913c\x00\x00\x00\x00IEND\xaeB`\x82"),
+}
 var resourceZipPng = &fyne.StaticResource{
 	StaticName: "zip.png",
 	StaticContent: []byte(
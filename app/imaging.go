@@ -0,0 +1,109 @@
+package app
+
+import (
+	"image"
+	"image/color"
+	_ "image/gif"
+	"image/jpeg"
+	"image/png"
+	"os"
+)
+
+/*
+
+  File:    imaging.go
+  Author:  Bob Shofner
+
+  MIT License - https://opensource.org/license/mit/
+
+  This permission notice shall be included in all copies
+    or substantial portions of the Software.
+
+*/
+/*
+  Description: Decode, scale and save thumbnail images.
+*/
+
+func loadImage(path string) (image.Image, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	img, _, err := image.Decode(f)
+	return img, err
+}
+
+// scaleImage shrinks an image to fit in max x max, keeping its shape.
+func scaleImage(src image.Image, max int) image.Image {
+	b := src.Bounds()
+	w, h := b.Dx(), b.Dy()
+	if w <= max && h <= max || w == 0 || h == 0 {
+		return src
+	}
+	if h > w {
+		return resizeImage(src, w*max/h, max)
+	}
+	return resizeImage(src, max, h*max/w)
+}
+
+// resizeImage makes an image exactly nw x nh.
+// Each new pixel is the average of the pixels it covers (or the nearest
+// pixel, when enlarging).
+func resizeImage(src image.Image, nw, nh int) *image.NRGBA {
+	b := src.Bounds()
+	w, h := b.Dx(), b.Dy()
+	if nw < 1 {
+		nw = 1
+	}
+	if nh < 1 {
+		nh = 1
+	}
+	dst := image.NewNRGBA(image.Rect(0, 0, nw, nh))
+	for y := 0; y < nh; y++ {
+		y0, y1 := b.Min.Y+y*h/nh, b.Min.Y+(y+1)*h/nh
+		if y1 == y0 {
+			y1++
+		}
+		for x := 0; x < nw; x++ {
+			x0, x1 := b.Min.X+x*w/nw, b.Min.X+(x+1)*w/nw
+			if x1 == x0 {
+				x1++
+			}
+			var r, g, bl, a, n uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					cr, cg, cb, ca := src.At(sx, sy).RGBA()
+					r, g, bl, a = r+uint64(cr), g+uint64(cg), bl+uint64(cb), a+uint64(ca)
+					n++
+				}
+			}
+			if n == 0 {
+				continue
+			}
+			// averaged premultiplied values, back to non-premultiplied
+			c := color.RGBA64{R: uint16(r / n), G: uint16(g / n), B: uint16(bl / n), A: uint16(a / n)}
+			dst.Set(x, y, c)
+		}
+	}
+	return dst
+}
+
+// saveImage writes a JPEG, or a PNG (to keep transparency) if asked.
+func saveImage(img image.Image, path string, asPNG bool) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if asPNG {
+		err = png.Encode(f, img)
+	} else {
+		err = jpeg.Encode(f, img, &jpeg.Options{Quality: 85})
+	}
+	if e := f.Close(); err == nil {
+		err = e
+	}
+	return err
+}
@@ -31,7 +31,7 @@ func Input(c *element.Console, cmd func(t string)) {
 }
 
 func ShowCount(c *element.Console, count int) {
-	c.Speak(fmt.Sprintf("Path count is %d", count))
+	c.Info(fmt.Sprintf("Path count is %d", count))
 }
 func ShowText(c *element.Console, h string, txt []string) {
 	c.Speak("\n" + h)
@@ -39,8 +39,41 @@ func ShowText(c *element.Console, h string, txt []string) {
 		c.Speak(t)
 	}
 }
-func ErrorText(c *element.Console, txt string) {
-	c.Speak(fmt.Sprintf("!! Error: %s", txt))
+
+// ErrorText, with details shown when it is expanded.
+func ErrorText(c *element.Console, txt string, details ...string) {
+	c.Error(txt, details...)
+}
+
+// ShowProgress of reading the directories.
+func ShowProgress(c *element.Console) Progress {
+	return func(done, total int, dir string) {
+		if done < total {
+			c.Progress(fmt.Sprintf("Reading %d of %d: %s", done+1, total, dir))
+		} else {
+			c.Progress(fmt.Sprintf("Read %d directories", total))
+		}
+	}
+}
+
+// AskProtection asks for the PDF passwords and permissions.
+// nil if there are no passwords.
+func AskProtection(c *element.Console) *Protection {
+	p := &Protection{}
+	p.UserPassword = c.AskPassword("User password, to open the PDF (<enter> for none):")
+	p.OwnerPassword = c.AskPassword("Owner password, for all permissions (<enter> for none):")
+	if p.UserPassword == "" && p.OwnerPassword == "" {
+		return nil
+	}
+	allow := func(what string) bool {
+		c.Speak(fmt.Sprintf("Allow %s? (y/n)", what))
+		return c.AskYesNo("y or n")
+	}
+	p.Print = allow("printing")
+	p.Copy = allow("copying")
+	p.Modify = allow("changes")
+	p.Annotate = allow("comments and forms")
+	return p
 }
 
 func GetNextInputPath(window fyne.Window,
@@ -0,0 +1,233 @@
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+/*
+
+  File:    job.go
+  Author:  Bob Shofner
+
+  MIT License - https://opensource.org/license/mit/
+
+  This permission notice shall be included in all copies
+    or substantial portions of the Software.
+
+*/
+/*
+  Description: Job files, to make the same sheets again without the console.
+
+  A job file is JSON with one or more jobs:
+
+    {
+      "jobs": [
+        {
+          "name": "weekly",
+          "sources": ["$HOME/Pictures/scans", "~/Documents/week"],
+          "recursive": true,            the directories under them too
+          "output": "${OUT}/weekly.pdf",
+          "formats": ["pdf", "html"],   else by the output's extension
+          "options": { "sortBy": "mtime", "cols": 6, "captions": false,
+                       "filter": { "globs": ["*.jpg"] } }
+        }
+      ]
+    }
+
+  "options" are named as in Preferences; any not given are the defaults
+  (not the Preferences). $VAR, ${VAR} and ~ are expanded in the paths, and
+  relative paths are in the folder of the job file.
+
+  All of the jobs are checked before any is run.
+*/
+
+type Job struct {
+	Name      string   `json:"name"`
+	Sources   []string `json:"sources"`
+	Recursive bool     `json:"recursive"`
+	Output    string   `json:"output"`
+	Formats   []string `json:"formats"`
+	Options   Options  `json:"options"`
+}
+
+// formatExt is the extension of the output file for a format.
+var formatExt = map[string]string{
+	FormatPDF:  ".pdf",
+	FormatHTML: ".html",
+	FormatPNG:  ".png",
+	FormatJPEG: ".jpg",
+	"jpg":      ".jpg",
+}
+
+// LoadJobs reads and checks a job file. The error lists every problem found.
+func LoadJobs(file string) ([]Job, error) {
+	file = ExpandPath(file, "")
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	// each job starts with the default options
+	var raw struct {
+		Jobs []json.RawMessage `json:"jobs"`
+	}
+	if err = json.Unmarshal(content, &raw); err != nil {
+		return nil, errors.New(file + ": " + err.Error())
+	}
+	if len(raw.Jobs) == 0 {
+		return nil, errors.New(file + ": no jobs")
+	}
+	dir := filepath.Dir(file)
+	jobs := make([]Job, 0, len(raw.Jobs))
+	problems := make([]string, 0)
+	for i, r := range raw.Jobs {
+		job := Job{Options: DefaultOptions()}
+		decoder := json.NewDecoder(bytes.NewReader(r))
+		decoder.DisallowUnknownFields()
+		if err = decoder.Decode(&job); err != nil {
+			problems = append(problems, fmt.Sprintf("job %d: %v", i+1, err))
+			continue
+		}
+		job.expand(dir)
+		if job.Name == "" {
+			job.Name = fmt.Sprintf("job %d", i+1)
+		}
+		for _, p := range job.check() {
+			problems = append(problems, fmt.Sprintf("%s: %s", job.Name, p))
+		}
+		jobs = append(jobs, job)
+	}
+	if len(problems) > 0 {
+		return nil, errors.New(file + ":\n  " + strings.Join(problems, "\n  "))
+	}
+	return jobs, nil
+}
+
+func (j *Job) expand(dir string) {
+	for i, s := range j.Sources {
+		j.Sources[i] = ExpandPath(s, dir)
+	}
+	j.Output = ExpandPath(j.Output, dir)
+	j.Options.Template = ExpandPath(j.Options.Template, dir)
+}
+
+// check finds what would stop the job, or make it not as meant.
+func (j *Job) check() []string {
+	problems := make([]string, 0)
+	add := func(format string, a ...any) {
+		problems = append(problems, fmt.Sprintf(format, a...))
+	}
+	if len(j.Sources) == 0 {
+		add("no sources")
+	}
+	for _, s := range j.Sources {
+		if info, err := os.Stat(s); err != nil {
+			add("source %s: %v", s, errors.Unwrap(err))
+		} else if !info.IsDir() {
+			add("source %s: not a directory", s)
+		}
+	}
+	if j.Output == "" {
+		add("no output")
+	} else if info, err := os.Stat(filepath.Dir(j.Output)); err != nil || !info.IsDir() {
+		add("output %s: no directory %s", j.Output, filepath.Dir(j.Output))
+	}
+	for _, f := range j.Formats {
+		if _, ok := formatExt[strings.ToLower(f)]; !ok {
+			add("format %q is not pdf, html, png or jpeg", f)
+		}
+	}
+	o := j.Options
+	if !contains(SortKeys, o.Sort) {
+		add("sortBy %q is not one of %s", o.Sort, strings.Join(SortKeys, ", "))
+	}
+	if o.Group != "" && !contains(GroupKeys, o.Group) {
+		add("groupBy %q is not one of %s", o.Group, strings.Join(GroupKeys, ", "))
+	}
+	if err := o.Filter.Check(); err != nil {
+		add("filter: %v", err)
+	}
+	if o.Cols < 0 || o.Rows < 0 || o.Cols > 20 || o.Rows > 20 {
+		add("cols and rows are 1 to 20 (0 for the default)")
+	}
+	for _, m := range strings.Split(o.Manifest, ",") {
+		if m = strings.TrimSpace(m); m != "" && m != ManifestJSON && m != ManifestCSV {
+			add("manifest %q is not json or csv", m)
+		}
+	}
+	if _, err := parseColor(o.RasterBackground); err != nil {
+		add("rasterBackground: %v", err)
+	}
+	if o.Template != "" {
+		if _, err := LoadTemplate(o.Template); err != nil {
+			add("template: %v", err)
+		}
+	}
+	if o.Attach != "" {
+		for _, s := range []string{o.Attach, o.AttachTotal} {
+			if _, err := parseSize(s); err != nil {
+				add("attach: %v", err)
+			}
+		}
+	}
+	return problems
+}
+
+func contains(list []string, s string) bool {
+	for _, l := range list {
+		if l == s {
+			return true
+		}
+	}
+	return false
+}
+
+// Dirs are the sources, and the directories under them if Recursive.
+func (j Job) Dirs() []string {
+	return AllDirs(j.Sources, j.Recursive, j.Options.Filter)
+}
+
+// Outputs are the output file in each of the formats.
+func (j Job) Outputs() []string {
+	if len(j.Formats) == 0 {
+		return []string{j.Output}
+	}
+	base := strings.TrimSuffix(j.Output, filepath.Ext(j.Output))
+	outputs := make([]string, 0, len(j.Formats))
+	for _, f := range j.Formats {
+		outputs = append(outputs, base+formatExt[strings.ToLower(f)])
+	}
+	return outputs
+}
+
+// RunJobs makes the outputs of the jobs, telling report of each.
+// The jobs after one that fails are still run.
+func RunJobs(jobs []Job, report func(lines []string)) error {
+	failed := 0
+	for _, job := range jobs {
+		dirs := job.Dirs()
+		for _, output := range job.Outputs() {
+			if err := RemoveOutput(output); err != nil {
+				report([]string{fmt.Sprintf("!! %s: unable to remove old %s. %v", job.Name, output, err)})
+				failed++
+				continue
+			}
+			summary, err := CreateOutput(dirs, output, job.Options)
+			if err != nil {
+				report(append([]string{fmt.Sprintf("!! %s: unable to write %s. %v", job.Name, output, err)}, summary.Lines()...))
+				failed++
+				continue
+			}
+			report(append([]string{fmt.Sprintf("** %s: written %s", job.Name, summary.Output())}, summary.Lines()...))
+		}
+	}
+	if failed > 0 {
+		return errors.New(fmt.Sprintf("%d of the outputs failed", failed))
+	}
+	return nil
+}
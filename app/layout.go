@@ -0,0 +1,259 @@
+package app
+
+import (
+	"fmt"
+	"image/color"
+)
+
+/*
+
+  File:    layout.go
+  Author:  Bob Shofner
+
+  MIT License - https://opensource.org/license/mit/
+
+  This permission notice shall be included in all copies
+    or substantial portions of the Software.
+
+*/
+/*
+  Description: Place the thumbnails of a directory on pages.
+
+  All positions are in points on a Letter page, whatever the output.
+*/
+
+const pageWidth = 612.0
+const pageHeight = 792.0
+const pageMargin = 15.0
+
+// the grid of thumbnails
+const gridLeft = 50.0
+const gridTop = 50.0
+const gridWidth = 500.0
+const gridHeight = 700.0
+
+const defaultCols = 5
+const defaultRows = 7
+const captionHeight = 7
+
+const headerSize = 10.0
+const nameSize = 8.0
+const captionSize = 6.0
+const groupSize = 9.0
+const groupHeight = 14.0 // above the first row of a group
+const badgeSize = 7.0
+const statsSize = 7.0
+
+// Layout is the layout engine. It makes the Pages of a Section.
+type Layout struct {
+	Cols  int
+	Rows  int
+	Stats bool // a line of DirStats under the headers
+}
+
+// NewLayout has the default grid for cols or rows less than 1.
+func NewLayout(cols, rows int) Layout {
+	if cols < 1 {
+		cols = defaultCols
+	}
+	if rows < 1 {
+		rows = defaultRows
+	}
+	return Layout{Cols: cols, Rows: rows}
+}
+
+// Section puts the entries of a directory on as many pages as needed.
+// first is the number of its first page in the Document.
+// Each group of entries starts a new row, under its sub-header.
+func (l Layout) Section(title string, entries []Entry, first int) Section {
+	section := Section{Title: title, Pages: make([]Page, 0)}
+	cellW, cellH := gridWidth/float64(l.Cols), gridHeight/float64(l.Rows)
+	size := cellW
+	if cellH < size {
+		size = cellH
+	}
+	size *= 0.8
+	// limit length to avoid collision
+	maxName := int(cellW * 24 / 100)
+	maxCaption := int(cellW * 30 / 100)
+	// caption lines take at most half of the image's height, from its bottom
+	captionLines := int(size / 2 / captionHeight)
+	if l.Stats {
+		stats := dirStats(title, entries)
+		section.Stats = &stats
+	}
+	var page *Page
+	newPage := func() {
+		section.Pages = append(section.Pages, Page{
+			Number: first + len(section.Pages),
+			Header: l.header(title),
+			Lines:  make([]TextLine, 0),
+			Cells:  make([]Cell, 0, l.Cols*l.Rows),
+		})
+		page = &section.Pages[len(section.Pages)-1]
+		if section.Stats != nil {
+			page.Lines = append(page.Lines, TextLine{Text: section.Stats.String(),
+				Y: page.Header.Y + statsSize + 4, Size: statsSize, Center: true})
+		}
+	}
+	bottom := gridTop + gridHeight
+	y := bottom // top of the current row, no page yet
+	row, col := 0, l.Cols
+	group := ""
+	for n, entry := range entries {
+		heading := entry.Group != "" && (n == 0 || entry.Group != group)
+		group = entry.Group
+		if heading || col == l.Cols { // a new row
+			y += cellH
+			row, col = row+1, 0
+			need := cellH
+			if heading {
+				need += groupHeight
+			}
+			if y+need > bottom+0.01 { // (rounding)
+				newPage()
+				y, row = gridTop, 1
+			}
+			if heading {
+				page.Lines = append(page.Lines,
+					TextLine{Text: group, X: gridLeft - 5, Y: y + groupHeight - 4, Size: groupSize, Bold: true})
+				y += groupHeight
+			}
+		}
+		x := gridLeft + float64(col)*cellW
+		col++
+		name := entry.Name
+		if len(name) > maxName {
+			name = name[len(name)-maxName:]
+		}
+		caption := entry.Thumb.Caption
+		if len(caption) > captionLines {
+			caption = caption[:captionLines]
+		}
+		// the image is kept square, smaller for the caption
+		side := size - captionHeight*float64(len(caption))
+		cell := Cell{
+			Entry: entry,
+			Image: Rect{X: x + (size-side)/2, Y: y, W: side, H: side},
+			Name:  TextLine{Text: name, X: x - 5, Y: y + cellH*0.9, Size: nameSize},
+			Link:  fileURL(entry.Path),
+			Row:   row,
+			Col:   col,
+		}
+		if entry.Dupe > 0 {
+			cell.Mark = dupeMark(cell.Image, entry.Dupe)
+		}
+		for j, line := range caption {
+			if len(line) > maxCaption {
+				line = line[:maxCaption]
+			}
+			cell.Caption = append(cell.Caption, TextLine{
+				Text: line,
+				X:    cell.Name.X,
+				Y:    cell.Image.Y + cell.Image.H + captionHeight*float64(j+1),
+				Size: captionSize,
+			})
+		}
+		if entry.Meta != nil { // where it is, for the manifest
+			entry.Meta.Page, entry.Meta.Row, entry.Meta.Col = page.Number, cell.Row, cell.Col
+		}
+		page.Cells = append(page.Cells, cell)
+	}
+	return section
+}
+
+func (l Layout) header(title string) TextLine {
+	return TextLine{Text: title, Y: pageMargin + 6 + headerSize*0.35, Size: headerSize, Bold: true, Center: true}
+}
+
+// textPages are pages of lines of text, under a header.
+type textPages struct {
+	header TextLine
+	first  int // the number of the first page
+	pages  []Page
+	y      float64
+}
+
+func (l Layout) textPages(title string, first int) *textPages {
+	return &textPages{header: l.header(title), first: first, pages: make([]Page, 0), y: pageHeight}
+}
+
+// line adds a line of text, space below the last, on a new page if needed.
+func (t *textPages) line(text string, size float64, bold bool, space float64) {
+	if t.y+space > pageHeight-gridTop {
+		t.pages = append(t.pages, Page{Number: t.first + len(t.pages), Header: t.header, Lines: make([]TextLine, 0)})
+		t.y = gridTop
+	}
+	t.y += space
+	page := &t.pages[len(t.pages)-1]
+	page.Lines = append(page.Lines, TextLine{Text: text, X: gridLeft - 5, Y: t.y, Size: size, Bold: bold})
+}
+
+// SummaryPages list the DirStats of the sections, and their totals.
+// first is the number of the first page.
+func (l Layout) SummaryPages(doc *Document, first int) []Page {
+	t := l.textPages("Summary", first)
+	for _, section := range doc.Sections {
+		if section.Stats == nil {
+			continue
+		}
+		t.line(section.Title, nameSize, true, 16)
+		t.line(section.Stats.String(), statsSize, false, 10)
+	}
+	if len(t.pages) == 0 {
+		return t.pages
+	}
+	t.line(fmt.Sprintf("Totals for %d directories", len(doc.Sections)), headerSize, true, 24)
+	t.line(doc.Totals().String(), statsSize+1, false, 12)
+	return t.pages
+}
+
+// IssuesPages list the files that couldn't be shown. Long paths and
+// messages are wrapped.
+func (l Layout) IssuesPages(issues []Issue, first int) []Page {
+	t := l.textPages("Issues", first)
+	for _, issue := range issues {
+		for i, text := range wrapText(issue.Path, lineChars(nameSize)) {
+			space := nameSize + 2.0
+			if i == 0 {
+				space = 16
+			}
+			t.line(text, nameSize, true, space)
+		}
+		message := fmt.Sprintf("    %s: %s", issue.Stage, issue.Message)
+		for _, text := range wrapText(message, lineChars(statsSize)) {
+			t.line(text, statsSize, false, statsSize+3)
+		}
+	}
+	return t.pages
+}
+
+// lineChars is about how many characters of size fit across the page.
+func lineChars(size float64) int {
+	return int((gridWidth + 10) / (size * 0.55))
+}
+
+// wrapText splits text in lines of no more than n characters.
+func wrapText(text string, n int) []string {
+	runes := []rune(text)
+	lines := make([]string, 0, len(runes)/n+1)
+	for len(runes) > n {
+		lines = append(lines, string(runes[:n]))
+		runes = runes[n:]
+	}
+	return append(lines, string(runes))
+}
+
+// dupeMark is the border and badge for DupeGroup n.
+func dupeMark(image Rect, n int) *Mark {
+	text := fmt.Sprintf("D%d", n)
+	w := badgeSize * (0.6*float64(len(text)) + 0.5)
+	badge := Rect{X: image.X + image.W - w + 2, Y: image.Y - 2, W: w, H: badgeSize + 3}
+	return &Mark{
+		Color:  dupeColor(n),
+		Border: Rect{X: image.X - 2, Y: image.Y - 2, W: image.W + 4, H: image.H + 4},
+		Badge:  badge,
+		Text: TextLine{Text: text, X: badge.X + badgeSize*0.25, Y: badge.Y + badgeSize + 0.5, Size: badgeSize, Bold: true,
+			Color: color.RGBA{R: 0xFF, G: 0xFF, B: 0xFF, A: 0xFF}},
+	}
+}
@@ -0,0 +1,251 @@
+package app
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+	"time"
+)
+
+// layoutEntries are n entries, in the groups given (one for each entry,
+// or none).
+func layoutEntries(n int, groups ...string) []Entry {
+	entries := make([]Entry, 0, n)
+	for i := 0; i < n; i++ {
+		e := Entry{Name: fmt.Sprintf("f%02d.jpg", i), Path: fmt.Sprintf("/d/f%02d.jpg", i),
+			Meta: &FileMeta{Category: CameraExt, Size: 10}}
+		if len(groups) > 0 {
+			e.Group = groups[i]
+		}
+		entries = append(entries, e)
+	}
+	return entries
+}
+
+// place is where a cell is: its page (from 0 in the Section), row and col.
+type place struct{ page, row, col int }
+
+func TestLayoutSection(t *testing.T) {
+	tests := []struct {
+		name    string
+		layout  Layout
+		entries []Entry
+		first   int
+		cells   []int      // on each page
+		places  []place    // of each entry, if checked
+		heads   [][]string // group headings on each page, if checked
+	}{
+		{name: "empty", layout: NewLayout(0, 0), entries: nil, first: 1, cells: []int{}},
+		{name: "one", layout: NewLayout(0, 0), entries: layoutEntries(1), first: 1,
+			cells: []int{1}, places: []place{{0, 1, 1}}},
+		{name: "full page", layout: NewLayout(0, 0), entries: layoutEntries(35), first: 1, cells: []int{35}},
+		{name: "one over", layout: NewLayout(0, 0), entries: layoutEntries(36), first: 3, cells: []int{35, 1}},
+		{name: "rows and cols", layout: NewLayout(2, 2), entries: layoutEntries(5), first: 1,
+			cells:  []int{4, 1},
+			places: []place{{0, 1, 1}, {0, 1, 2}, {0, 2, 1}, {0, 2, 2}, {1, 1, 1}}},
+		{name: "a group starts a row", layout: NewLayout(3, 3), entries: layoutEntries(4, "A", "A", "B", "B"), first: 1,
+			cells:  []int{4},
+			places: []place{{0, 1, 1}, {0, 1, 2}, {0, 2, 1}, {0, 2, 2}},
+			heads:  [][]string{{"A", "B"}}},
+		// a group is continued on the next page without a heading, and
+		// a heading needs room for a row under it
+		{name: "groups over pages", layout: NewLayout(2, 2), entries: layoutEntries(4, "A", "A", "A", "B"), first: 1,
+			cells:  []int{2, 1, 1},
+			places: []place{{0, 1, 1}, {0, 1, 2}, {1, 1, 1}, {2, 1, 1}},
+			heads:  [][]string{{"A"}, {}, {"B"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			section := tt.layout.Section("/d", tt.entries, tt.first)
+			if len(section.Pages) != len(tt.cells) {
+				t.Fatalf("%d pages, want %d", len(section.Pages), len(tt.cells))
+			}
+			found := make(map[string]place)
+			for i, page := range section.Pages {
+				if page.Number != tt.first+i {
+					t.Errorf("page %d is numbered %d, want %d", i, page.Number, tt.first+i)
+				}
+				if page.Header.Text != "/d" {
+					t.Errorf("page %d header %q, want the directory", i, page.Header.Text)
+				}
+				if len(page.Cells) != tt.cells[i] {
+					t.Errorf("page %d has %d cells, want %d", i, len(page.Cells), tt.cells[i])
+				}
+				for _, c := range page.Cells {
+					found[c.Entry.Path] = place{i, c.Row, c.Col}
+				}
+				if tt.heads != nil {
+					heads := make([]string, 0)
+					for _, line := range page.Lines {
+						heads = append(heads, line.Text)
+					}
+					if fmt.Sprint(heads) != fmt.Sprint(tt.heads[i]) {
+						t.Errorf("page %d headings %v, want %v", i, heads, tt.heads[i])
+					}
+				}
+			}
+			if len(found) != len(tt.entries) {
+				t.Errorf("%d entries placed, want %d", len(found), len(tt.entries))
+			}
+			for i, want := range tt.places {
+				if got := found[tt.entries[i].Path]; got != want {
+					t.Errorf("entry %d at %+v, want %+v", i, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestLayoutCells(t *testing.T) {
+	l := NewLayout(0, 0)
+	entries := layoutEntries(7)
+	entries[0].Thumb.Caption = []string{"one", "two"}
+	entries[1].Name = "a-very-long-name-that-does-not-fit-under-the-image.jpg"
+	entries[2].Dupe = 3
+	section := l.Section("/d", entries, 1)
+	cells := section.Cells()
+	cellW, cellH := gridWidth/float64(l.Cols), gridHeight/float64(l.Rows)
+	size := math.Min(cellW, cellH) * 0.8
+	for i, c := range cells {
+		row, col := i/l.Cols, i%l.Cols
+		if c.Image.X != gridLeft+float64(col)*cellW+(size-c.Image.W)/2 || c.Image.Y != gridTop+float64(row)*cellH {
+			t.Errorf("cell %d image at %v,%v", i, c.Image.X, c.Image.Y)
+		}
+		if c.Image.X+c.Image.W > gridLeft+gridWidth+0.01 || c.Image.Y+cellH > gridTop+gridHeight+0.01 {
+			t.Errorf("cell %d is off the grid: %+v", i, c.Image)
+		}
+		if c.Link != fileURL(c.Entry.Path) {
+			t.Errorf("cell %d link %s", i, c.Link)
+		}
+		if c.Entry.Meta.Page != 1 || c.Entry.Meta.Row != c.Row || c.Entry.Meta.Col != c.Col {
+			t.Errorf("cell %d metadata at %d %d,%d, want 1 %d,%d", i, c.Entry.Meta.Page,
+				c.Entry.Meta.Row, c.Entry.Meta.Col, c.Row, c.Col)
+		}
+	}
+	// captions take their room from the image, which stays square
+	if got, want := cells[0].Image.H, size-2*captionHeight; got != want || cells[0].Image.W != got {
+		t.Errorf("image with 2 captions is %vx%v, want %v", cells[0].Image.W, got, want)
+	}
+	if len(cells[0].Caption) != 2 || cells[0].Caption[1].Y <= cells[0].Caption[0].Y {
+		t.Errorf("captions %+v", cells[0].Caption)
+	}
+	if cells[3].Image.H != size || cells[3].Image.W != size {
+		t.Errorf("image is %vx%v, want %v", cells[3].Image.W, cells[3].Image.H, size)
+	}
+	// the end of a long name is kept
+	if name := cells[1].Name.Text; len(name) != int(cellW*24/100) || name != entries[1].Name[len(entries[1].Name)-len(name):] {
+		t.Errorf("long name is %q", name)
+	}
+	if cells[2].Mark == nil || cells[2].Mark.Text.Text != "D3" || cells[0].Mark != nil {
+		t.Errorf("dupe marks %+v %+v", cells[2].Mark, cells[0].Mark)
+	}
+}
+
+func TestLayoutCaptionFits(t *testing.T) {
+	for _, grid := range []int{5, 12, 20} {
+		l := NewLayout(grid, grid)
+		entries := layoutEntries(2)
+		entries[0].Thumb.Caption = []string{"3:05  192 kbps", "Artist", "Album"}
+		cells := l.Section("/d", entries, 1).Cells()
+		size := math.Min(gridWidth/float64(l.Cols), gridHeight/float64(l.Rows)) * 0.8
+		image := cells[0].Image
+		if image.H < size/2 || image.W != image.H {
+			t.Errorf("%dx%d: image with a caption is %vx%v, of %v", grid, grid, image.W, image.H, size)
+		}
+		if n := len(cells[0].Caption); n == 0 && grid < 20 || n > 3 {
+			t.Errorf("%dx%d: %d caption lines", grid, grid, n)
+		}
+		if last := cells[0].Caption; len(last) > 0 && last[len(last)-1].Y > image.Y+size+0.01 {
+			t.Errorf("%dx%d: caption below the cell's image, at %v", grid, grid, last[len(last)-1].Y)
+		}
+	}
+}
+
+func TestLayoutStats(t *testing.T) {
+	l := NewLayout(2, 2)
+	l.Stats = true
+	section := l.Section("/d", layoutEntries(5), 1)
+	if section.Stats == nil || section.Stats.Files != 5 {
+		t.Fatalf("stats %+v, want 5 files", section.Stats)
+	}
+	for i, page := range section.Pages {
+		if len(page.Lines) == 0 || page.Lines[0].Text != section.Stats.String() {
+			t.Errorf("page %d has no line of stats", i)
+		}
+	}
+}
+
+func TestSummaryPages(t *testing.T) {
+	l := NewLayout(0, 0)
+	l.Stats = true
+	doc := &Document{}
+	for i := 0; i < 3; i++ {
+		doc.Sections = append(doc.Sections, l.Section(fmt.Sprintf("/d%d", i), layoutEntries(2), i+1))
+	}
+	pages := l.SummaryPages(doc, 4)
+	if len(pages) != 1 || pages[0].Number != 4 || pages[0].Header.Text != "Summary" {
+		t.Fatalf("summary pages %+v", pages)
+	}
+	// a title and stats for each directory, then the totals
+	if got := len(pages[0].Lines); got != 3*2+2 {
+		t.Errorf("%d lines, want 8", got)
+	}
+	if l.Stats = false; len(l.SummaryPages(&Document{Sections: []Section{NewLayout(0, 0).Section("/d", layoutEntries(1), 1)}}, 1)) != 0 {
+		t.Errorf("summary pages without stats")
+	}
+}
+
+func TestIssuesPages(t *testing.T) {
+	l := NewLayout(0, 0)
+	long := "/d/" + strings.Repeat("x", 300)
+	issues := []Issue{{Path: "/d/a.jpg", Stage: IssueThumbnail, Message: "bad"}, {Path: long, Stage: IssueRead, Message: "gone"}}
+	pages := l.IssuesPages(issues, 5)
+	if len(pages) != 1 || pages[0].Number != 5 || pages[0].Header.Text != "Issues" {
+		t.Fatalf("issues pages %+v", pages)
+	}
+	// the long path is wrapped
+	var text string
+	for _, line := range pages[0].Lines {
+		if len(line.Text) > lineChars(line.Size) {
+			t.Errorf("line of %d characters", len(line.Text))
+		}
+		if line.Bold {
+			text += line.Text
+		}
+	}
+	if text != "/d/a.jpg"+long {
+		t.Errorf("paths %q", text)
+	}
+	many := make([]Issue, 200)
+	for i := range many {
+		many[i] = Issue{Path: fmt.Sprintf("/d/%d", i), Stage: IssueRead, Message: "gone"}
+	}
+	pages = l.IssuesPages(many, 1)
+	if len(pages) < 2 || pages[1].Number != 2 {
+		t.Fatalf("%d pages for 200 issues", len(pages))
+	}
+	for _, page := range pages {
+		if last := page.Lines[len(page.Lines)-1]; last.Y > pageHeight-gridTop {
+			t.Errorf("page %d line at %v", page.Number, last.Y)
+		}
+	}
+}
+
+func TestDecorateIssues(t *testing.T) {
+	l := NewLayout(0, 0)
+	doc := &Document{Title: "t", Sections: []Section{l.Section("/d", layoutEntries(36), 1)}}
+	doc.Issues = l.IssuesPages([]Issue{{Path: "/d/a", Stage: IssueRead, Message: "gone"}}, doc.PageCount()+1)
+	doc.decorate(Options{Header: DefaultHeader, Footer: "page {page} of {pages}"}, time.Now())
+	pages := doc.Pages()
+	if len(pages) != 3 {
+		t.Fatalf("%d pages, want 3", len(pages))
+	}
+	last := pages[2]
+	if last.Header.Text != "Issues" || last.Footer.Text != "page 3 of 3" {
+		t.Errorf("issues page header %q, footer %q", last.Header.Text, last.Footer.Text)
+	}
+	if pages[0].Footer.Text != "page 1 of 3" {
+		t.Errorf("first footer %q", pages[0].Footer.Text)
+	}
+}
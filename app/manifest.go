@@ -0,0 +1,110 @@
+package app
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+/*
+
+  File:    manifest.go
+  Author:  Bob Shofner
+
+  MIT License - https://opensource.org/license/mit/
+
+  This permission notice shall be included in all copies
+    or substantial portions of the Software.
+
+*/
+/*
+  Description: Write the inventory of the files shown (JSON and/or CSV)
+    next to the output: sheet.pdf -> sheet.json, sheet.csv
+*/
+
+const ManifestJSON = "json"
+const ManifestCSV = "csv"
+
+// WriteManifest writes the formats ("json", "csv" or "json,csv") asked for.
+func WriteManifest(metas []*FileMeta, output string, formats string) error {
+	base := strings.TrimSuffix(output, filepath.Ext(output))
+	for _, format := range strings.Split(formats, ",") {
+		var err error
+		switch strings.ToLower(strings.TrimSpace(format)) {
+		case "":
+			continue
+		case ManifestJSON:
+			err = writeManifestJSON(metas, base+".json")
+		case ManifestCSV:
+			err = writeManifestCSV(metas, base+".csv")
+		default:
+			err = errors.New(fmt.Sprintf("unknown manifest format %q", format))
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeManifestJSON(metas []*FileMeta, file string) error {
+	content, err := json.MarshalIndent(metas, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(file, content, 0644)
+}
+
+var manifestColumns = []string{"path", "size", "modified", "type", "content",
+	"width", "height", "sha256", "page", "row", "col",
+	"artist", "title", "album", "year", "genre", "track", "exif"}
+
+func writeManifestCSV(metas []*FileMeta, file string) error {
+	f, err := os.Create(file)
+	if err != nil {
+		return err
+	}
+	w := csv.NewWriter(f)
+	_ = w.Write(manifestColumns)
+	for _, m := range metas {
+		// exif as "name=value; ..."
+		exif := make([]string, 0, len(m.Exif))
+		for k, v := range m.Exif {
+			exif = append(exif, k+"="+v)
+		}
+		sort.Strings(exif)
+		_ = w.Write([]string{
+			m.Path,
+			strconv.FormatInt(m.Size, 10),
+			m.Modified.Format(time.RFC3339),
+			m.Category,
+			m.Content,
+			strconv.Itoa(m.Width),
+			strconv.Itoa(m.Height),
+			m.SHA256,
+			strconv.Itoa(m.Page),
+			strconv.Itoa(m.Row),
+			strconv.Itoa(m.Col),
+			m.Audio["artist"],
+			m.Audio["title"],
+			m.Audio["album"],
+			m.Audio["year"],
+			m.Audio["genre"],
+			m.Audio["track"],
+			strings.Join(exif, "; "),
+		})
+	}
+	w.Flush()
+	err = w.Error()
+	if e := f.Close(); err == nil {
+		err = e
+	}
+	return err
+}
@@ -0,0 +1,98 @@
+package app
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"image"
+	"io"
+	"os"
+	"time"
+)
+
+/*
+
+  File:    meta.go
+  Author:  Bob Shofner
+
+  MIT License - https://opensource.org/license/mit/
+
+  This permission notice shall be included in all copies
+    or substantial portions of the Software.
+
+*/
+/*
+  Description: What is known about each file shown, for the manifest.
+*/
+
+type FileMeta struct {
+	Path     string            `json:"path"`
+	Size     int64             `json:"size"`
+	Modified time.Time         `json:"modified"`
+	Category string            `json:"type"`
+	Content  string            `json:"content,omitempty"`
+	Width    int               `json:"width,omitempty"`
+	Height   int               `json:"height,omitempty"`
+	Audio    map[string]string `json:"audio,omitempty"`
+	Exif     map[string]string `json:"exif,omitempty"`
+	SHA256   string            `json:"sha256,omitempty"`
+	DHash    string            `json:"dhash,omitempty"` // of images, for near duplicates
+	PHash    string            `json:"phash,omitempty"` // perceptual, to check the dHash
+	Page     int               `json:"page"`            // where it is in the output
+	Row      int               `json:"row"`
+	Col      int               `json:"col"`
+}
+
+// collectMeta gets the details of a file. Hashing (which reads all of the
+// file) is only done if asked.
+func collectMeta(path string, content Content, hash bool) *FileMeta {
+	meta := &FileMeta{Path: path, Content: content.Name, Category: UnknownExt}
+	info, err := os.Stat(path)
+	if err != nil {
+		return meta
+	}
+	meta.Category = categoryOf(path, sniffed{FileInfo: info, content: content})
+	meta.Size = info.Size()
+	meta.Modified = info.ModTime()
+	if info.IsDir() {
+		return meta
+	}
+	switch content.Name {
+	case "jpeg", "png", "gif":
+		if f, err := os.Open(path); err == nil {
+			if config, _, err := image.DecodeConfig(f); err == nil {
+				meta.Width, meta.Height = config.Width, config.Height
+			}
+			_ = f.Close()
+		}
+	}
+	switch content.Name {
+	case "jpeg", "tiff":
+		if exif, err := ExifDetails(path); err == nil && len(exif) > 0 {
+			meta.Exif = exif
+		}
+	}
+	if meta.Category == AudioExt {
+		if details, err := ID3Details(path); err == nil {
+			meta.Audio = details.Meta()
+		}
+	}
+	if hash {
+		meta.SHA256, _ = fileHash(path)
+	}
+	return meta
+}
+
+func fileHash(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	h := sha256.New()
+	if _, err = io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,206 @@
+package app
+
+import (
+	"context"
+	"image/color"
+	"net/url"
+	"path/filepath"
+	"time"
+)
+
+/*
+
+  File:    model.go
+  Author:  Bob Shofner
+
+  MIT License - https://opensource.org/license/mit/
+
+  This permission notice shall be included in all copies
+    or substantial portions of the Software.
+
+*/
+/*
+  Description: The page model shared by all the kinds of output.
+
+  A Document has a Section for each directory. A Section has the Pages
+  it needs, and a Page has the Cells (one for each file) placed on it.
+  All positions are in points on a Letter page.
+*/
+
+type Document struct {
+	Title    string
+	Font     string // of the PDF, from the Template
+	Cover    *Page  // if wanted
+	Sections []Section
+	Summary  []Page // the totals of the sections, if wanted
+	Issues   []Page // the files with problems, if wanted
+}
+
+type Section struct {
+	Title string // the directory
+	Pages []Page
+	Stats *DirStats // if wanted
+}
+
+type Page struct {
+	Number   int // in the Document, from 1
+	Header   TextLine
+	Footer   TextLine
+	Lines    []TextLine // other text: statistics, group headings
+	Pictures []Picture  // logos
+	Cells    []Cell
+}
+
+type Cell struct {
+	Entry    Entry
+	Image    Rect
+	Name     TextLine
+	Caption  []TextLine
+	Link     string   // the original file, as a URL
+	Row, Col int      // from 1
+	Mark     *Mark    // of a duplicate
+	Frame    *Frame   // the Template's border
+	Attach   *Attach  // the original, embedded in the PDF
+	Note     TextLine // under the name
+}
+
+// Picture is an image file, in Rect.
+type Picture struct {
+	Path string
+	Type string
+	Rect Rect
+}
+
+// Frame is a line around a Rect.
+type Frame struct {
+	Rect  Rect
+	Color color.RGBA
+	Width float64
+}
+
+// Mark is a colored border around the image, and a badge at its corner.
+type Mark struct {
+	Color  color.RGBA
+	Border Rect
+	Badge  Rect
+	Text   TextLine // in the badge
+}
+
+type Rect struct {
+	X, Y, W, H float64
+}
+
+// TextLine is a line of text with its baseline at X, Y.
+type TextLine struct {
+	Text   string
+	X, Y   float64
+	Size   float64
+	Bold   bool
+	Center bool       // in the width of the page, X is ignored
+	Color  color.RGBA // black if not set
+}
+
+// Cells is all of the Cells of the Section, in order.
+func (s Section) Cells() []Cell {
+	cells := make([]Cell, 0)
+	for _, page := range s.Pages {
+		cells = append(cells, page.Cells...)
+	}
+	return cells
+}
+
+// Pages is all of the Pages of the Document, in order.
+func (d *Document) Pages() []Page {
+	pages := make([]Page, 0, d.PageCount())
+	if d.Cover != nil {
+		pages = append(pages, *d.Cover)
+	}
+	for _, section := range d.Sections {
+		pages = append(pages, section.Pages...)
+	}
+	pages = append(pages, d.Summary...)
+	return append(pages, d.Issues...)
+}
+
+// PageCount is the number of pages in the Document.
+func (d *Document) PageCount() int {
+	n := len(d.Summary) + len(d.Issues)
+	if d.Cover != nil {
+		n++
+	}
+	for _, section := range d.Sections {
+		n += len(section.Pages)
+	}
+	return n
+}
+
+// buildDocument is the one pass over the directories: the files are
+// enumerated, their thumbnails and metadata collected, and laid out.
+// Duplicates are found (in all of the directories) before the layout.
+func buildDocument(ctx context.Context, dirs []string, file string, options Options, summary *Summary, build *Build) *Document {
+	now := time.Now()
+	template, err := LoadTemplate(options.Template)
+	if err != nil {
+		summary.AddIssue(TemplateName+" "+options.Template, IssueRead, err)
+	}
+	if template.Header != "" {
+		options.Header = template.Header
+	}
+	if template.Footer != "" {
+		options.Footer = template.Footer
+	}
+	layout := NewLayout(options.Cols, options.Rows)
+	layout.Stats = options.Stats
+	doc := &Document{Title: documentTitle(file, options), Sections: make([]Section, 0, len(dirs))}
+	all := make([][]Entry, 0, len(dirs))
+	for i, dir := range dirs {
+		if options.Progress != nil {
+			options.Progress(i, len(dirs), dir)
+		}
+		all = append(all, build.prepare(ctx, dir, options, summary))
+	}
+	if options.Progress != nil {
+		options.Progress(len(dirs), len(dirs), "")
+	}
+	if options.Dupes {
+		markDupes(all, summary)
+	}
+	next := 1
+	if options.Cover {
+		next++
+	}
+	for i, dir := range dirs {
+		section := layout.Section(dir, all[i], next)
+		next += len(section.Pages)
+		build.pages(dir, len(section.Pages))
+		doc.Sections = append(doc.Sections, section)
+	}
+	if options.Stats {
+		doc.Summary = layout.SummaryPages(doc, next)
+	}
+	if options.Cover {
+		cover := layout.CoverPage(doc, options.Subtitle, template, now)
+		doc.Cover = &cover
+	}
+	if OutputFormat(file) == FormatPDF && options.Attach != "" {
+		max, total := attachSizes(options, summary)
+		doc.attach(max, total, summary)
+	}
+	// the issues found so far, with the rest of the pages
+	if OutputFormat(file) == FormatPDF && options.IssuesPage && len(summary.Issues) > 0 {
+		doc.Issues = layout.IssuesPages(summary.Issues, doc.PageCount()+1)
+	}
+	doc.decorate(options, now)
+	doc.applyTemplate(template)
+	return doc
+}
+
+// fileURL is the link to a file.
+func fileURL(path string) string {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		abs = path
+	}
+	u := url.URL{Scheme: "file", Path: filepath.ToSlash(abs)}
+	return u.String()
+}
@@ -0,0 +1,176 @@
+package app
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"fmt"
+	"fyne.io/fyne/v2"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+/*
+
+  File:    office.go
+  Author:  Bob Shofner
+
+  MIT License - https://opensource.org/license/mit/
+
+  This permission notice shall be included in all copies
+    or substantial portions of the Software.
+
+*/
+/*
+  Description: retrieve office (OOXML and ODF) document info.
+*/
+
+type OfficeInfo struct {
+	title  string
+	author string
+	pages  int // pages or slides
+	words  int
+	family string // SheetExt, SlideExt, WordExt
+	mime   string
+	cover  *fyne.StaticResource
+}
+
+// package members holding the preview image and properties.
+var officeThumbnails = map[string]string{
+	"docprops/thumbnail.jpeg":  "jpeg",
+	"docprops/thumbnail.jpg":   "jpeg",
+	"docprops/thumbnail.png":   "png",
+	"thumbnails/thumbnail.png": "png",
+}
+
+func OfficeDetails(path string) (officeInfo OfficeInfo, err error) {
+	officeInfo.family = ExtensionType(path)
+	r, err := zip.OpenReader(path)
+	if err != nil {
+		return
+	}
+	defer func() {
+		_ = r.Close()
+	}()
+	for _, f := range r.File {
+		name := strings.ToLower(f.Name)
+		if mime, ok := officeThumbnails[name]; ok && officeInfo.cover == nil {
+			content, e := readZipFile(f)
+			if e == nil && len(content) > 0 {
+				officeInfo.mime = mime
+				officeInfo.cover = &fyne.StaticResource{
+					StaticName:    filepath.Base(path),
+					StaticContent: content,
+				}
+			}
+			continue
+		}
+		switch name {
+		case "docprops/core.xml", "docprops/app.xml", "meta.xml":
+			content, e := readZipFile(f)
+			if e == nil {
+				officeInfo.parseProperties(content)
+			}
+		}
+	}
+	return
+}
+
+func readZipFile(f *zip.File) ([]byte, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = rc.Close()
+	}()
+	return io.ReadAll(rc)
+}
+
+// parseProperties picks the interesting elements out of the OOXML core/app
+// properties or the ODF meta.xml. Only local names are compared, so the
+// various namespace prefixes don't matter.
+func (o *OfficeInfo) parseProperties(content []byte) {
+	decoder := xml.NewDecoder(strings.NewReader(string(content)))
+	var element string
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			element = t.Name.Local
+			if element == "document-statistic" { // ODF
+				for _, a := range t.Attr {
+					switch a.Name.Local {
+					case "page-count":
+						o.pages, _ = strconv.Atoi(a.Value)
+					case "word-count":
+						o.words, _ = strconv.Atoi(a.Value)
+					}
+				}
+			}
+		case xml.EndElement:
+			element = ""
+		case xml.CharData:
+			value := strings.TrimSpace(string(t))
+			if value == "" {
+				continue
+			}
+			switch element {
+			case "title":
+				o.title = value
+			case "creator", "initial-creator":
+				if o.author == "" {
+					o.author = value
+				}
+			case "Pages", "Slides":
+				o.pages, _ = strconv.Atoi(value)
+			case "Words":
+				o.words, _ = strconv.Atoi(value)
+			}
+		}
+	}
+}
+
+// Caption is the short text shown under the thumbnail.
+func (o OfficeInfo) Caption() []string {
+	caption := make([]string, 0)
+	if o.title != "" {
+		caption = append(caption, o.title)
+	}
+	if o.author != "" {
+		caption = append(caption, o.author)
+	}
+	unit := "pp"
+	if o.family == SlideExt {
+		unit = "slides"
+	}
+	switch {
+	case o.pages > 0 && o.words > 0:
+		caption = append(caption, fmt.Sprintf("%d %s, %d words", o.pages, unit, o.words))
+	case o.pages > 0:
+		caption = append(caption, fmt.Sprintf("%d %s", o.pages, unit))
+	case o.words > 0:
+		caption = append(caption, fmt.Sprintf("%d words", o.words))
+	}
+	return caption
+}
+
+// Meta is the document properties, by name.
+func (o OfficeInfo) Meta() map[string]string {
+	return map[string]string{
+		"title":  o.title,
+		"author": o.author,
+		"pages":  strconv.Itoa(o.pages),
+		"words":  strconv.Itoa(o.words),
+	}
+}
+
+const officeFmt = "Title: %s\nAuthor: %s\nPages: %d, Words: %d"
+
+func (o OfficeInfo) String() string {
+	return fmt.Sprintf(officeFmt, o.title, o.author, o.pages, o.words)
+}
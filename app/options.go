@@ -0,0 +1,297 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"fyne.io/fyne/v2"
+	"strconv"
+	"strings"
+)
+
+/*
+
+  File:    options.go
+  Author:  Bob Shofner
+
+  MIT License - https://opensource.org/license/mit/
+
+  This permission notice shall be included in all copies
+    or substantial portions of the Software.
+
+*/
+/*
+  Description: Options for generating the output, kept in Preferences
+    (and in projects, with the same names).
+
+  The "set" command changes one, by its name in Preferences:
+    set sortBy mtime       set groupBy off
+    set stats on           set footer {title} - page {page}
+    set rasterWidth 1600   set attach 1m
+*/
+
+type Options struct {
+	IssuesPage       bool        `json:"issuesPage"`       // append a page listing the files with problems
+	RasterWidth      int         `json:"rasterWidth"`      // pixels, for PNG/JPEG pages
+	RasterHeight     int         `json:"rasterHeight"`     // pixels, 0 keeps the shape of the page
+	RasterBackground string      `json:"rasterBackground"` // #rrggbb
+	Manifest         string      `json:"manifest"`         // "json", "csv" or "json,csv" written with the output
+	Sort             string      `json:"sortBy"`           // one of SortKeys
+	Descending       bool        `json:"sortDescending"`
+	Group            string      `json:"groupBy"` // "", or one of GroupKeys
+	Filter           Filter      `json:"filter"`  // which files are shown
+	Cols             int         `json:"cols"`    // of thumbnails on a page, 0 for the default
+	Rows             int         `json:"rows"`
+	Captions         bool        `json:"captions"` // the lines of detail under the thumbnails
+	Dupes            bool        `json:"dupes"`    // mark duplicate files
+	Stats            bool        `json:"stats"`    // statistics under the headers, and a summary page
+	Title            string      `json:"title"`    // of the document, else the output file's name
+	Header           string      `json:"header"`   // templates, see header.go
+	Footer           string      `json:"footer"`
+	Author           string      `json:"author"` // PDF properties
+	Subject          string      `json:"subject"`
+	Keywords         string      `json:"keywords"`
+	Cover            bool        `json:"cover"`       // a cover page
+	Subtitle         string      `json:"subtitle"`    // on the cover
+	Template         string      `json:"template"`    // file, "" for template.json in storage
+	Attach           string      `json:"attach"`      // largest file embedded in the PDF ("1m"), "" for none
+	AttachTotal      string      `json:"attachTotal"` // of all embedded files
+	Protection       *Protection `json:"-"`           // of the PDF, never saved
+	Force            bool        `json:"-"`           // read every directory again, never saved
+	Progress         Progress    `json:"-"`           // of reading the directories, nil for none
+}
+
+// Progress is told before each directory is read, and when all are.
+type Progress func(done, total int, dir string)
+
+// DefaultOptions are used for anything not in Preferences (or a project).
+func DefaultOptions() Options {
+	return Options{RasterWidth: 1275, RasterBackground: "#ffffff", Sort: SortName, Filter: DefaultFilter(),
+		Captions: true, Header: DefaultHeader, Footer: DefaultFooter, AttachTotal: DefaultAttachTotal}
+}
+
+// LoadOptions gets the Options from Preferences, and writes them back
+// so they can be found (and changed) there.
+func LoadOptions(prefs fyne.Preferences) Options {
+	o := DefaultOptions()
+	o.IssuesPage = prefs.BoolWithFallback("issuesPage", o.IssuesPage)
+	o.RasterWidth = prefs.IntWithFallback("rasterWidth", o.RasterWidth)
+	o.RasterHeight = prefs.IntWithFallback("rasterHeight", o.RasterHeight)
+	o.RasterBackground = prefs.StringWithFallback("rasterBackground", o.RasterBackground)
+	o.Manifest = prefs.StringWithFallback("manifest", o.Manifest)
+	o.Sort = prefs.StringWithFallback("sortBy", o.Sort)
+	o.Descending = prefs.BoolWithFallback("sortDescending", o.Descending)
+	o.Group = prefs.StringWithFallback("groupBy", o.Group)
+	o.Filter = parseFilter(prefs.StringWithFallback("filter", ""))
+	o.Cols = prefs.IntWithFallback("cols", o.Cols)
+	o.Rows = prefs.IntWithFallback("rows", o.Rows)
+	o.Captions = prefs.BoolWithFallback("captions", o.Captions)
+	o.Dupes = prefs.BoolWithFallback("dupes", o.Dupes)
+	o.Stats = prefs.BoolWithFallback("stats", o.Stats)
+	o.Title = prefs.StringWithFallback("title", o.Title)
+	o.Header = prefs.StringWithFallback("header", o.Header)
+	o.Footer = prefs.StringWithFallback("footer", o.Footer)
+	o.Author = prefs.StringWithFallback("author", o.Author)
+	o.Subject = prefs.StringWithFallback("subject", o.Subject)
+	o.Keywords = prefs.StringWithFallback("keywords", o.Keywords)
+	o.Cover = prefs.BoolWithFallback("cover", o.Cover)
+	o.Subtitle = prefs.StringWithFallback("subtitle", o.Subtitle)
+	o.Template = prefs.StringWithFallback("template", o.Template)
+	o.Attach = prefs.StringWithFallback("attach", o.Attach)
+	o.AttachTotal = prefs.StringWithFallback("attachTotal", o.AttachTotal)
+	o.Save(prefs)
+	return o
+}
+
+func (o Options) Save(prefs fyne.Preferences) {
+	prefs.SetBool("issuesPage", o.IssuesPage)
+	prefs.SetInt("rasterWidth", o.RasterWidth)
+	prefs.SetInt("rasterHeight", o.RasterHeight)
+	prefs.SetString("rasterBackground", o.RasterBackground)
+	prefs.SetString("manifest", o.Manifest)
+	prefs.SetString("sortBy", o.Sort)
+	prefs.SetBool("sortDescending", o.Descending)
+	prefs.SetString("groupBy", o.Group)
+	prefs.SetString("filter", o.Filter.String())
+	prefs.SetInt("cols", o.Cols)
+	prefs.SetInt("rows", o.Rows)
+	prefs.SetBool("captions", o.Captions)
+	prefs.SetBool("dupes", o.Dupes)
+	prefs.SetBool("stats", o.Stats)
+	prefs.SetString("title", o.Title)
+	prefs.SetString("header", o.Header)
+	prefs.SetString("footer", o.Footer)
+	prefs.SetString("author", o.Author)
+	prefs.SetString("subject", o.Subject)
+	prefs.SetString("keywords", o.Keywords)
+	prefs.SetBool("cover", o.Cover)
+	prefs.SetString("subtitle", o.Subtitle)
+	prefs.SetString("template", o.Template)
+	prefs.SetString("attach", o.Attach)
+	prefs.SetString("attachTotal", o.AttachTotal)
+}
+
+// setting is an Option the set command changes: field is a pointer to it
+// (*bool, *int or *string), check is of the value typed.
+type setting struct {
+	name  string // in Preferences
+	field func(o *Options) any
+	check func(value string) error
+}
+
+var settings = []setting{
+	{"sortBy", func(o *Options) any { return &o.Sort }, oneOf(SortKeys)},
+	{"sortDescending", func(o *Options) any { return &o.Descending }, nil},
+	{"groupBy", func(o *Options) any { return &o.Group }, func(v string) error {
+		if v == "" {
+			return nil
+		}
+		return oneOf(GroupKeys)(v)
+	}},
+	{"cols", func(o *Options) any { return &o.Cols }, between(0, 20)},
+	{"rows", func(o *Options) any { return &o.Rows }, between(0, 20)},
+	{"captions", func(o *Options) any { return &o.Captions }, nil},
+	{"stats", func(o *Options) any { return &o.Stats }, nil},
+	{"dupes", func(o *Options) any { return &o.Dupes }, nil},
+	{"cover", func(o *Options) any { return &o.Cover }, nil},
+	{"issuesPage", func(o *Options) any { return &o.IssuesPage }, nil},
+	{"title", func(o *Options) any { return &o.Title }, nil},
+	{"subtitle", func(o *Options) any { return &o.Subtitle }, nil},
+	{"header", func(o *Options) any { return &o.Header }, nil},
+	{"footer", func(o *Options) any { return &o.Footer }, nil},
+	{"author", func(o *Options) any { return &o.Author }, nil},
+	{"subject", func(o *Options) any { return &o.Subject }, nil},
+	{"keywords", func(o *Options) any { return &o.Keywords }, nil},
+	{"template", func(o *Options) any { return &o.Template }, func(v string) error {
+		if v == "" {
+			return nil
+		}
+		_, err := LoadTemplate(v)
+		return err
+	}},
+	{"rasterWidth", func(o *Options) any { return &o.RasterWidth }, between(1, 20000)},
+	{"rasterHeight", func(o *Options) any { return &o.RasterHeight }, between(0, 20000)},
+	{"rasterBackground", func(o *Options) any { return &o.RasterBackground }, func(v string) error {
+		_, err := parseColor(v)
+		return err
+	}},
+	{"manifest", func(o *Options) any { return &o.Manifest }, func(v string) error {
+		for _, m := range strings.Split(v, ",") {
+			if m = strings.TrimSpace(m); m != "" && m != ManifestJSON && m != ManifestCSV {
+				return errors.New(fmt.Sprintf("manifest %q is not json or csv", m))
+			}
+		}
+		return nil
+	}},
+	{"attach", func(o *Options) any { return &o.Attach }, func(v string) error {
+		if v == "" {
+			return nil
+		}
+		_, err := parseSize(v)
+		return err
+	}},
+	{"attachTotal", func(o *Options) any { return &o.AttachTotal }, func(v string) error {
+		_, err := parseSize(v)
+		return err
+	}},
+}
+
+func oneOf(list []string) func(v string) error {
+	return func(v string) error {
+		if !contains(list, v) {
+			return errors.New(fmt.Sprintf("%q is not one of %s", v, strings.Join(list, ", ")))
+		}
+		return nil
+	}
+}
+
+func between(min, max int) func(v string) error {
+	return func(v string) error {
+		if n, _ := strconv.Atoi(v); n < min || n > max {
+			return errors.New(fmt.Sprintf("%s is not %d to %d", v, min, max))
+		}
+		return nil
+	}
+}
+
+// Set changes one of the Options, as typed after "set": its name (in any
+// case) and the value. Without a value, a text is cleared; "off" is
+// also "" for groupBy, manifest and attach. on|off is for the others.
+func (o *Options) Set(args []string) error {
+	if len(args) == 0 {
+		return nil
+	}
+	var s *setting
+	for i := range settings {
+		if strings.EqualFold(settings[i].name, args[0]) {
+			s = &settings[i]
+		}
+	}
+	if s == nil {
+		names := make([]string, 0, len(settings))
+		for _, s := range settings {
+			names = append(names, s.name)
+		}
+		return errors.New(fmt.Sprintf("unknown option %q, set one of %s", args[0], strings.Join(names, ", ")))
+	}
+	value := strings.Join(args[1:], " ")
+	switch field := s.field(o).(type) {
+	case *bool:
+		switch strings.ToLower(value) {
+		case "on", "true", "yes":
+			*field = true
+		case "off", "false", "no":
+			*field = false
+		default:
+			return errors.New(fmt.Sprintf("%s is on or off", s.name))
+		}
+		return nil
+	case *int:
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return errors.New(fmt.Sprintf("%s %q is not a number", s.name, value))
+		}
+		if s.check != nil {
+			if err = s.check(value); err != nil {
+				return errors.New(fmt.Sprintf("%s: %v", s.name, err))
+			}
+		}
+		*field = n
+	case *string:
+		switch s.name {
+		case "groupBy", "manifest", "attach":
+			if strings.EqualFold(value, "off") {
+				value = ""
+			}
+		case "template":
+			value = ExpandPath(value, "")
+		}
+		if s.check != nil {
+			if err := s.check(value); err != nil {
+				return errors.New(fmt.Sprintf("%s: %v", s.name, err))
+			}
+		}
+		*field = value
+	}
+	return nil
+}
+
+// Lines describe the Options the set command changes.
+func (o Options) Lines() []string {
+	lines := make([]string, 0, len(settings))
+	for _, s := range settings {
+		var value string
+		switch field := s.field(&o).(type) {
+		case *bool:
+			value = "off"
+			if *field {
+				value = "on"
+			}
+		case *int:
+			value = strconv.Itoa(*field)
+		case *string:
+			value = *field
+		}
+		lines = append(lines, fmt.Sprintf("  %s: %s", s.name, value))
+	}
+	return lines
+}
@@ -0,0 +1,90 @@
+package app
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestOptionsSet(t *testing.T) {
+	dir := t.TempDir()
+	template := filepath.Join(dir, "client.json")
+	if err := os.WriteFile(template, []byte(`{"font": "Times"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		args  string
+		check func(o Options) bool
+		err   bool
+	}{
+		{"sortBy mtime", func(o Options) bool { return o.Sort == SortMtime }, false},
+		{"SORTBY size", func(o Options) bool { return o.Sort == SortSize }, false},
+		{"sortBy colour", nil, true},
+		{"sortDescending on", func(o Options) bool { return o.Descending }, false},
+		{"sortDescending maybe", nil, true},
+		{"groupBy month", func(o Options) bool { return o.Group == GroupMonth }, false},
+		{"groupBy off", func(o Options) bool { return o.Group == "" }, false},
+		{"groupBy week", nil, true},
+		{"stats on", func(o Options) bool { return o.Stats }, false},
+		{"dupes yes", func(o Options) bool { return o.Dupes }, false},
+		{"captions off", func(o Options) bool { return !o.Captions }, false},
+		{"cover true", func(o Options) bool { return o.Cover }, false},
+		{"issuesPage on", func(o Options) bool { return o.IssuesPage }, false},
+		{"header {section} of {title}", func(o Options) bool { return o.Header == "{section} of {title}" }, false},
+		{"footer", func(o Options) bool { return o.Footer == "" }, false},
+		{"cols 6", func(o Options) bool { return o.Cols == 6 }, false},
+		{"cols 21", nil, true},
+		{"rows many", nil, true},
+		{"rasterWidth 1600", func(o Options) bool { return o.RasterWidth == 1600 }, false},
+		{"rasterWidth 0", nil, true},
+		{"rasterHeight 0", func(o Options) bool { return o.RasterHeight == 0 }, false},
+		{"rasterBackground #102030", func(o Options) bool { return o.RasterBackground == "#102030" }, false},
+		{"rasterBackground blue", nil, true},
+		{"manifest json,csv", func(o Options) bool { return o.Manifest == "json,csv" }, false},
+		{"manifest off", func(o Options) bool { return o.Manifest == "" }, false},
+		{"manifest xml", nil, true},
+		{"attach 1m", func(o Options) bool { return o.Attach == "1m" }, false},
+		{"attach off", func(o Options) bool { return o.Attach == "" }, false},
+		{"attach lots", nil, true},
+		{"attachTotal 0", func(o Options) bool { return o.AttachTotal == "0" }, false},
+		{"attachTotal", nil, true},
+		{"template " + template, func(o Options) bool { return o.Template == template }, false},
+		{"template " + filepath.Join(dir, "missing.json"), nil, true},
+		{"colour red", nil, true},
+		{"", func(o Options) bool { return true }, false},
+	}
+	for _, tt := range tests {
+		o := DefaultOptions()
+		o.Header, o.Footer, o.Group, o.Manifest, o.Attach = "h", "f", GroupDay, "csv", "2m"
+		before := o.Lines()
+		err := o.Set(strings.Fields(tt.args))
+		if (err != nil) != tt.err {
+			t.Errorf("Set(%s) error = %v, want an error %v", tt.args, err, tt.err)
+			continue
+		}
+		if err != nil {
+			if strings.Join(o.Lines(), "\n") != strings.Join(before, "\n") {
+				t.Errorf("Set(%s) failed, but changed the Options", tt.args)
+			}
+			continue
+		}
+		if !tt.check(o) {
+			t.Errorf("Set(%s) = %v", tt.args, o.Lines())
+		}
+	}
+}
+
+func TestOptionsLines(t *testing.T) {
+	o := DefaultOptions()
+	o.Stats, o.Cols, o.Manifest = true, 4, "json"
+	lines := strings.Join(o.Lines(), "\n") + "\n"
+	for _, want := range []string{"  sortBy: name", "  stats: on", "  dupes: off", "  cols: 4", "  manifest: json", "  attachTotal: 20m"} {
+		if !strings.Contains(lines, want+"\n") {
+			t.Errorf("Lines has no %q:\n%s", want, lines)
+		}
+	}
+	if len(o.Lines()) != len(settings) {
+		t.Errorf("%d lines for %d settings", len(o.Lines()), len(settings))
+	}
+}
@@ -1,9 +1,14 @@
 package app
 
 import (
+	"context"
+	"errors"
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/data/binding"
+	"os"
+	"path/filepath"
 	"snap/fileutil"
+	"strings"
 )
 
 /*
@@ -18,15 +23,109 @@ import (
 
 */
 /*
-  Description: Handle file output selection of PDFs.
+  Description: Handle file output selection of PDFs (or HTML, or images).
 */
 
+const FormatPDF = "pdf"
+const FormatHTML = "html"
+const FormatPNG = "png"
+const FormatJPEG = "jpeg"
+
+// OutputFormat is chosen by the extension of the output file.
+func OutputFormat(file string) string {
+	switch strings.ToLower(filepath.Ext(file)) {
+	case ".html", ".htm":
+		return FormatHTML
+	case ".png":
+		return FormatPNG
+	case ".jpg", ".jpeg":
+		return FormatJPEG
+	}
+	return FormatPDF
+}
+
+// RemoveOutput removes the output of the last time: the file, or the
+// pages of a PNG/JPEG output (so none are left from a longer one).
+func RemoveOutput(file string) error {
+	files := []string{file}
+	if f := OutputFormat(file); f == FormatPNG || f == FormatJPEG {
+		files = append(files, rasterPages(file)...)
+	}
+	for _, f := range files {
+		if err := os.Remove(f); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// outputFile is true if path is one that writing output makes: the file,
+// its build record and manifests, the pages of a PNG/JPEG, or the folder
+// of an HTML gallery and what is in it.
+func outputFile(output, path string) bool {
+	if output == "" {
+		return false
+	}
+	output, path = absPath(output), absPath(path)
+	base := strings.TrimSuffix(output, filepath.Ext(output))
+	switch path {
+	case output, buildFile(output), base + ".json", base + ".csv", base + "_files":
+		return true
+	}
+	if strings.HasPrefix(path, base+"_files"+string(filepath.Separator)) {
+		return true
+	}
+	if f := OutputFormat(output); f == FormatPNG || f == FormatJPEG {
+		return filepath.Dir(path) == filepath.Dir(output) && rasterPattern(output).MatchString(filepath.Base(path))
+	}
+	return false
+}
+
+func absPath(path string) string {
+	if abs, err := filepath.Abs(path); err == nil {
+		return abs
+	}
+	return filepath.Clean(path)
+}
+
+// CreateOutput lays out the directories, writes the output file in the
+// format for its extension, and the manifest if wanted.
+func CreateOutput(dirs []string, file string, options Options) (*Summary, error) {
+	summary := NewSummary()
+	if options.Protection == nil {
+		p, err := ProtectionFromEnv()
+		if err != nil {
+			return summary, err
+		}
+		options.Protection = p
+	}
+	if options.Protection != nil && OutputFormat(file) != FormatPDF {
+		summary.AddIssue(file, IssueWrite, errors.New("not password protected, only a PDF can be"))
+	}
+	build := loadBuild(file, options)
+	doc := buildDocument(context.Background(), dirs, file, options, summary, build)
+	summary.Reused = build.Reused
+	err := renderers[OutputFormat(file)].Render(doc, file, options, summary)
+	if err == nil {
+		if err = build.save(file); err != nil {
+			summary.AddIssue(buildFile(file), IssueWrite, err)
+			err = nil
+		}
+	}
+	if err == nil && options.Manifest != "" {
+		if err = WriteManifest(summary.Metadata, file, options.Manifest); err != nil {
+			summary.AddIssue(file, IssueWrite, err)
+		}
+	}
+	return summary, err
+}
+
 func GetNextOutputPath(window fyne.Window,
 	last binding.ExternalString,
 	cb func(string)) {
 
 	fs := fileutil.FileSelectFilter{
-		Title:      "Output PDF File Path",
+		Title:      "Output File Path (.pdf, .html, .png or .jpg)",
 		FileType:   fileutil.File,
 		FileSelect: fileutil.Save,
 		Multiple:   false,
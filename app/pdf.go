@@ -1,14 +1,11 @@
 package app
 
 import (
-	"fmt"
 	"github.com/jung-kurt/gofpdf"
+	"image/color"
 	"log"
 	"os"
 	"path/filepath"
-	"regexp"
-	"snap/fileutil"
-	"sort"
 )
 
 /*
@@ -23,93 +20,144 @@ import (
 
 */
 /*
-  Description: Create a PDF file from list of paths.
+  Description: Create a PDF file from the Document.
 */
 
-const maxPhotoCols = 5
-const maxPhotoRows = 7
+type pdfRenderer struct{}
 
-func getAllFiles(path string) []string {
-	files := make([]string, 0)
-	entries, _ := os.ReadDir(path)
-	for _, entry := range entries {
-		// skip hidden
-		match, e := regexp.MatchString(fileutil.DefaultHiddenFiles, filepath.Base(entry.Name()))
-		if match || e != nil {
-			continue
-		}
-		if entry.IsDir() || entry.Type() == 0 {
-			files = append(files, entry.Name())
-		}
-	}
-	return files
+// pdfWriter draws the pages in the Document's font.
+type pdfWriter struct {
+	*gofpdf.Fpdf
+	font    string
+	summary *Summary
 }
 
-func CreatePDF(errFunc func(e error), dirs []string, file string) {
+// Render writes the PDF file. The thumbnails link to the original files.
+func (pdfRenderer) Render(doc *Document, file string, options Options, summary *Summary) error {
 	pdf := gofpdf.New("P", "pt", "Letter", "")
-	pdf.SetMargins(15, 15, 15)
-	for _, dir := range dirs {
-		showPdf(errFunc, dir, pdf)
+	pdf.SetMargins(pageMargin, pageMargin, pageMargin)
+	pdf.SetTitle(doc.Title, true)
+	pdf.SetAuthor(options.Author, true)
+	pdf.SetSubject(options.Subject, true)
+	pdf.SetKeywords(options.Keywords, true)
+	pdf.SetCreator("snap", true)
+	if p := options.Protection; p != nil {
+		pdf.SetProtection(p.flags(), p.UserPassword, p.OwnerPassword)
+	}
+	w := pdfWriter{Fpdf: pdf, font: doc.Font, summary: summary}
+	if w.font == "" {
+		w.font = "Arial"
+	}
+	for _, page := range doc.Pages() {
+		w.page(page)
 	}
 	err := pdf.OutputFileAndClose(file)
-	if err != nil || pdf.Err() {
-		log.Printf("pdf.OutputFileAndClose error: %s %s\n", pdf.Error(), err)
+	if err == nil && pdf.Err() {
+		err = pdf.Error()
 	}
+	if err != nil {
+		log.Printf("pdf.OutputFileAndClose error: %s\n", err)
+		summary.AddIssue(file, IssueWrite, err)
+		return err
+	}
+	summary.Written = append(summary.Written, file)
+	return nil
 }
 
-func showPdf(errFunc func(e error), dir string, pdf *gofpdf.Fpdf) {
-	files := getAllFiles(dir)
-	buildPDF(errFunc, dir, pdf, files)
+// text writes a TextLine, in the size and color it asks for.
+func (w pdfWriter) text(line TextLine) {
+	if line.Text == "" {
+		return
+	}
+	style := ""
+	if line.Bold {
+		style = "B"
+	}
+	w.SetFont(w.font, style, line.Size)
+	w.SetTextColor(int(line.Color.R), int(line.Color.G), int(line.Color.B))
+	x := line.X
+	if line.Center {
+		x = (pageWidth - w.GetStringWidth(line.Text)) / 2
+	}
+	w.Text(x, line.Y, line.Text)
 }
 
-func buildPDF(errFunc func(e error), dir string, pdf *gofpdf.Fpdf, sorted []string) {
-	var n int
-	header := func() {
-		n = 0
-		pdf.AddPage()
-		pdf.SetFont("Arial", "B", 10)
-		pdf.CellFormat(570, 12, fmt.Sprintf("%s", dir), "", 0, "CM", false, 0, "")
-		pdf.SetFont("Arial", "", 8)
+// image places an image file, false (and an issue for path) if it can't be.
+func (w pdfWriter) image(file, imageType string, r Rect, link, path string) bool {
+	// ImageOptions(src, x, y, width, height, flow, options, link, linkStr)
+	w.ImageOptions(file, r.X, r.Y, r.W, r.H, false,
+		gofpdf.ImageOptions{ImageType: imageType, ReadDpi: true}, 0, link)
+	if w.Err() {
+		log.Printf("pdfWriter error: %s\n  %s\n", w.Error(), file)
+		w.summary.AddIssue(path, IssueImage, w.Error())
+		// the error is "sticky", clear it for the rest of the document
+		w.ClearError()
+		return false
 	}
-	sort.SliceStable(sorted, func(i, j int) bool {
-		return sorted[i] < sorted[j]
-	})
-	xScale := 100
-	yScale := 100
-	for _, s := range sorted {
-		// get generic path for image
-		path, err := ImageResourcePath(dir, s)
-		if err != nil {
-			log.Println("Got ImageResourcePath error ", s, err)
-			errFunc(err)
+	return true
+}
+
+func (w pdfWriter) page(page Page) {
+	w.AddPage()
+	for _, picture := range page.Pictures {
+		w.image(picture.Path, picture.Type, picture.Rect, "", picture.Path)
+	}
+	w.text(page.Header)
+	w.text(page.Footer)
+	for _, line := range page.Lines {
+		w.text(line)
+	}
+	for _, cell := range page.Cells {
+		if !w.image(cell.Entry.Thumb.Path, cell.Entry.Thumb.Type, cell.Image, cell.Link, cell.Entry.Path) {
 			continue
 		}
-		if n%(maxPhotoRows*maxPhotoCols) == 0 {
-			header()
+		w.text(cell.Name)
+		for _, line := range cell.Caption {
+			w.text(line)
 		}
-		row := n / maxPhotoCols
-		col := n - (row * maxPhotoCols)
-		n++
-		// ImageOptions(src, x, y, width, height, flow, options, link, linkStr)
-		pdf.ImageOptions(
-			path,
-			float64(col*xScale+50), float64(row*yScale+50),
-			80, 80,
-			false,
-			gofpdf.ImageOptions{ReadDpi: true},
-			0,
-			"",
-		)
-		if pdf.Err() {
-			log.Printf("buildPDF error: %s\n  %s\n", pdf.Error(), path)
-			errFunc(err)
-			continue
+		if cell.Frame != nil {
+			w.frame(cell.Frame.Rect, cell.Frame.Color, cell.Frame.Width)
 		}
-		// limit length to avoid collision
-		name := s //strings.TrimSuffix(s, filepath.Ext(s))
-		if len(name) > 24 {
-			name = name[len(s)-24:]
+		if cell.Mark != nil {
+			w.mark(cell.Mark)
 		}
-		pdf.Text(float64(col*xScale+45), float64(row*yScale+140), name)
+		w.text(cell.Note)
+		if cell.Attach != nil {
+			w.attach(cell.Attach)
+		}
+	}
+}
+
+func (w pdfWriter) frame(r Rect, c color.RGBA, width float64) {
+	w.SetDrawColor(int(c.R), int(c.G), int(c.B))
+	w.SetLineWidth(width)
+	w.Rect(r.X, r.Y, r.W, r.H, "D")
+}
+
+// mark draws the border and badge of a duplicate.
+func (w pdfWriter) mark(mark *Mark) {
+	w.frame(mark.Border, mark.Color, 1.5)
+	c := mark.Color
+	w.SetFillColor(int(c.R), int(c.G), int(c.B))
+	b := mark.Badge
+	w.Rect(b.X, b.Y, b.W, b.H, "F")
+	w.text(mark.Text)
+}
+
+// attach embeds the original file, opened by its badge.
+func (w pdfWriter) attach(a *Attach) {
+	content, err := os.ReadFile(a.Path)
+	if err != nil {
+		w.summary.AddIssue(a.Path, IssueAttach, err)
+		return
 	}
+	w.SetFillColor(0x60, 0x60, 0x60)
+	b := a.Badge
+	w.Rect(b.X, b.Y, b.W, b.H, "F")
+	w.text(a.Text)
+	w.AddAttachmentAnnotation(&gofpdf.Attachment{
+		Content:     content,
+		Filename:    filepath.Base(a.Path),
+		Description: a.Path,
+	}, b.X, b.Y, b.W, b.H)
 }
@@ -0,0 +1,118 @@
+package app
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"fyne.io/fyne/v2"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+/*
+
+  File:    project.go
+  Author:  Bob Shofner
+
+  MIT License - https://opensource.org/license/mit/
+
+  This permission notice shall be included in all copies
+    or substantial portions of the Software.
+
+*/
+/*
+  Description: Named projects, kept as JSON in the fyne storage folder.
+
+  A project is the PATHs, the Options (but the PDF passwords) and the
+  output file, so the same sheets can be made again:
+    save weekly     projects/weekly.json
+    load weekly
+    projects        list them
+*/
+
+const ProjectDir = "projects"
+
+type Project struct {
+	Name    string   `json:"name"`
+	Paths   []string `json:"paths"`
+	Output  string   `json:"output"`
+	Options Options  `json:"options"`
+}
+
+// LoadPaths are the PATHs of the last session.
+func LoadPaths(prefs fyne.Preferences) []string {
+	return prefs.StringListWithFallback("paths", make([]string, 0))
+}
+
+// SavePaths keeps the PATHs for the next session.
+func SavePaths(prefs fyne.Preferences, paths []string) {
+	prefs.SetStringList("paths", paths)
+}
+
+// projectFile checks the name, it is a file name without the .json.
+func projectFile(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || name == "." || name == ".." || strings.ContainsAny(name, `/\:`) {
+		return "", errors.New(fmt.Sprintf("%q is not a project name", name))
+	}
+	return filepath.Join(GetSystem().Storage, ProjectDir, name+".json"), nil
+}
+
+// SaveProject writes (or replaces) the project.
+func SaveProject(p Project) error {
+	file, err := projectFile(p.Name)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+		return err
+	}
+	content, err := json.MarshalIndent(p, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(file, content, 0644)
+}
+
+// LoadProject reads a project. Options missing from the file are the defaults.
+func LoadProject(name string) (Project, error) {
+	p := Project{Name: name, Options: DefaultOptions()}
+	file, err := projectFile(name)
+	if err != nil {
+		return p, err
+	}
+	content, err := os.ReadFile(file)
+	if os.IsNotExist(err) {
+		return p, errors.New(fmt.Sprintf("no project %q", name))
+	}
+	if err != nil {
+		return p, err
+	}
+	if err = json.Unmarshal(content, &p); err != nil {
+		return p, errors.New(file + ": " + err.Error())
+	}
+	p.Name = name
+	return p, nil
+}
+
+// Projects are the names of the saved projects.
+func Projects() []string {
+	names := make([]string, 0)
+	files, _ := filepath.Glob(filepath.Join(GetSystem().Storage, ProjectDir, "*.json"))
+	for _, file := range files {
+		names = append(names, strings.TrimSuffix(filepath.Base(file), ".json"))
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Lines describes the project.
+func (p Project) Lines() []string {
+	lines := []string{fmt.Sprintf("  %s: %d paths, output %s", p.Name, len(p.Paths), p.Output)}
+	for _, path := range p.Paths {
+		lines = append(lines, "    "+path)
+	}
+	return lines
+}
@@ -0,0 +1,133 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"github.com/jung-kurt/gofpdf"
+	"os"
+	"strings"
+)
+
+/*
+
+  File:    protect.go
+  Author:  Bob Shofner
+
+  MIT License - https://opensource.org/license/mit/
+
+  This permission notice shall be included in all copies
+    or substantial portions of the Software.
+
+*/
+/*
+  Description: Password protection of the PDF.
+
+  The passwords are never saved in Preferences. They are typed in (the
+  "protect" command), or for running without the console read from:
+    SNAP_PDF_PASSWORD        the user password
+    SNAP_PDF_OWNER_PASSWORD  the owner password
+    SNAP_PDF_PASSWORD_FILE   a file with the user password on the first
+                             line, and the owner password on the second
+    SNAP_PDF_PERMISSIONS     what the user may do: print,copy,modify,annotate
+*/
+
+const EnvPassword = "SNAP_PDF_PASSWORD"
+const EnvOwnerPassword = "SNAP_PDF_OWNER_PASSWORD"
+const EnvPasswordFile = "SNAP_PDF_PASSWORD_FILE"
+const EnvPermissions = "SNAP_PDF_PERMISSIONS"
+
+type Protection struct {
+	UserPassword  string // to open the PDF
+	OwnerPassword string // for all permissions, random if empty
+	Print         bool
+	Copy          bool
+	Modify        bool
+	Annotate      bool
+}
+
+// flags are gofpdf's permission flags.
+func (p *Protection) flags() byte {
+	var flags byte
+	if p.Print {
+		flags |= gofpdf.CnProtectPrint
+	}
+	if p.Copy {
+		flags |= gofpdf.CnProtectCopy
+	}
+	if p.Modify {
+		flags |= gofpdf.CnProtectModify
+	}
+	if p.Annotate {
+		flags |= gofpdf.CnProtectAnnotForms
+	}
+	return flags
+}
+
+// SetPermissions reads "print,copy,modify,annotate" (or "all" or "none").
+func (p *Protection) SetPermissions(s string) error {
+	p.Print, p.Copy, p.Modify, p.Annotate = false, false, false, false
+	for _, perm := range strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return r == ',' || r == ' '
+	}) {
+		switch perm {
+		case "print":
+			p.Print = true
+		case "copy":
+			p.Copy = true
+		case "modify":
+			p.Modify = true
+		case "annotate":
+			p.Annotate = true
+		case "all":
+			p.Print, p.Copy, p.Modify, p.Annotate = true, true, true, true
+		case "none":
+		default:
+			return errors.New(fmt.Sprintf("unknown permission %q", perm))
+		}
+	}
+	return nil
+}
+
+// Lines describes the Protection, without the passwords.
+func (p *Protection) Lines() []string {
+	if p == nil {
+		return []string{"  none"}
+	}
+	yes := func(b bool) string {
+		if b {
+			return "yes"
+		}
+		return "no"
+	}
+	return []string{
+		fmt.Sprintf("  user password: %s, owner password: %s", yes(p.UserPassword != ""), yes(p.OwnerPassword != "")),
+		fmt.Sprintf("  print: %s, copy: %s, modify: %s, annotate: %s", yes(p.Print), yes(p.Copy), yes(p.Modify), yes(p.Annotate)),
+	}
+}
+
+// ProtectionFromEnv is the Protection from the environment, nil if none.
+func ProtectionFromEnv() (*Protection, error) {
+	p := &Protection{
+		UserPassword:  os.Getenv(EnvPassword),
+		OwnerPassword: os.Getenv(EnvOwnerPassword),
+	}
+	if file := os.Getenv(EnvPasswordFile); file != "" {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		lines := strings.Split(strings.ReplaceAll(string(content), "\r\n", "\n"), "\n")
+		p.UserPassword = lines[0]
+		if len(lines) > 1 {
+			p.OwnerPassword = lines[1]
+		}
+	}
+	permissions, set := os.LookupEnv(EnvPermissions)
+	if p.UserPassword == "" && p.OwnerPassword == "" && !set {
+		return nil, nil
+	}
+	if err := p.SetPermissions(permissions); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
@@ -0,0 +1,167 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"sort"
+	"sync"
+	"time"
+)
+
+/*
+
+  File:    provider.go
+  Author:  Bob Shofner
+
+  MIT License - https://opensource.org/license/mit/
+
+  This permission notice shall be included in all copies
+    or substantial portions of the Software.
+
+*/
+/*
+  Description: Registry of the providers of thumbnail images.
+*/
+
+// Thumbnail is the image to show for a file, plus what is known about it.
+type Thumbnail struct {
+	Path    string            // image file (jpg, png or gif)
+	Type    string            // gofpdf image type of Path, by content
+	Caption []string          // extra lines of detail under the image
+	Meta    map[string]string // properties of the file (title, artist, ...)
+}
+
+// ThumbnailProvider makes thumbnails for the files it recognizes.
+//
+//	size is the wanted width/height in points; a provider may return
+//	a larger image, it is scaled when placed.
+type ThumbnailProvider interface {
+	Match(path string, info fs.FileInfo) bool
+	Render(ctx context.Context, path string, size int) (Thumbnail, error)
+}
+
+type registeredProvider struct {
+	name     string
+	priority int
+	provider ThumbnailProvider
+}
+
+var providers = make([]registeredProvider, 0)
+var providerLock sync.RWMutex
+
+// RegisterThumbnailProvider adds (or replaces, by name) a provider.
+// Providers with a higher priority are asked first. The built-in
+// icons are always the last choice.
+//
+//goland:noinspection GoUnusedExportedFunction
+func RegisterThumbnailProvider(name string, priority int, provider ThumbnailProvider) {
+	providerLock.Lock()
+	defer providerLock.Unlock()
+	for i, p := range providers {
+		if p.name == name {
+			providers = append(providers[:i], providers[i+1:]...)
+			break
+		}
+	}
+	providers = append(providers, registeredProvider{name: name, priority: priority, provider: provider})
+	thumbCacheLock.Lock() // the new provider may make better ones
+	thumbCache = make(map[string]cachedThumb)
+	thumbCacheLock.Unlock()
+	sort.SliceStable(providers, func(i, j int) bool {
+		return providers[i].priority > providers[j].priority
+	})
+}
+
+// providerNames are the providers, in the order they are asked, as
+// "name:priority".
+func providerNames() []string {
+	providerLock.RLock()
+	defer providerLock.RUnlock()
+	names := make([]string, 0, len(providers))
+	for _, p := range providers {
+		names = append(names, fmt.Sprintf("%s:%d", p.name, p.priority))
+	}
+	return names
+}
+
+// cachedThumb is a thumbnail made for the file as it was.
+type cachedThumb struct {
+	size     int
+	modified time.Time
+	bytes    int64
+	thumb    Thumbnail
+}
+
+// thumbCache keeps the thumbnails of this session, so a file is only
+// asked for again once it has changed.
+var thumbCache = make(map[string]cachedThumb)
+var thumbCacheLock sync.Mutex
+
+func cachedThumbnail(path string, size int, info fs.FileInfo) (Thumbnail, bool) {
+	thumbCacheLock.Lock()
+	c, ok := thumbCache[path]
+	thumbCacheLock.Unlock()
+	if !ok || c.size != size || !c.modified.Equal(info.ModTime()) || c.bytes != info.Size() {
+		return Thumbnail{}, false
+	}
+	if _, err := os.Stat(c.thumb.Path); err != nil { // the image was removed
+		return Thumbnail{}, false
+	}
+	return c.thumb, true
+}
+
+func cacheThumbnail(path string, size int, info fs.FileInfo, thumb Thumbnail) {
+	thumbCacheLock.Lock()
+	thumbCache[path] = cachedThumb{size: size, modified: info.ModTime(), bytes: info.Size(), thumb: thumb}
+	thumbCacheLock.Unlock()
+}
+
+// ThumbnailFor asks each matching provider, in priority order, for a
+// thumbnail. A provider that fails passes the file on to the next one.
+// The thumbnail of an unchanged file is from the cache. content is
+// the file's, sniffed once by the caller.
+func ThumbnailFor(ctx context.Context, path string, content Content, size int) (Thumbnail, error) {
+	category := UnknownExt
+	info, err := os.Stat(path)
+	if err == nil {
+		if thumb, ok := cachedThumbnail(path, size, info); ok {
+			return thumb, nil
+		}
+		info = sniffed{FileInfo: info, content: content}
+		category = categoryOf(path, info)
+		providerLock.RLock()
+		list := append([]registeredProvider{}, providers...)
+		providerLock.RUnlock()
+		for _, p := range list {
+			if ctx.Err() != nil {
+				return Thumbnail{}, ctx.Err()
+			}
+			if !p.provider.Match(path, info) {
+				continue
+			}
+			thumb, e := p.provider.Render(ctx, path, size)
+			if e == nil {
+				thumb = typed(thumb, path, content)
+				cacheThumbnail(path, size, info, thumb)
+				return thumb, nil
+			}
+		}
+	}
+	thumb, err := iconThumbnail(path, category)
+	return typed(thumb, path, content), err
+}
+
+// typed insures the image type is from the content, not the name. The
+// file's own content is known, any other image is read.
+func typed(thumb Thumbnail, path string, content Content) Thumbnail {
+	if thumb.Type == "" && thumb.Path != "" {
+		if thumb.Path == path {
+			thumb.Type = content.Image
+		} else {
+			thumb.Type = SniffContent(thumb.Path).Image
+		}
+	}
+	return thumb
+}
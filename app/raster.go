@@ -0,0 +1,262 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/gobold"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/math/fixed"
+	"image"
+	"image/color"
+	"image/draw"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+/*
+
+  File:    raster.go
+  Author:  Bob Shofner
+
+  MIT License - https://opensource.org/license/mit/
+
+  This permission notice shall be included in all copies
+    or substantial portions of the Software.
+
+*/
+/*
+  Description: Create PNG (or JPEG) images of the pages.
+
+  "sheet.png" is written as sheet-001.png, sheet-002.png, ...
+  The pages have the same layout as the PDF.
+*/
+
+type rasterRenderer struct{}
+
+// Render writes an image file for each page.
+func (rasterRenderer) Render(doc *Document, file string, options Options, summary *Summary) error {
+	background, err := parseColor(options.RasterBackground)
+	if err != nil {
+		return err
+	}
+	r, err := newRasterizer(options.RasterWidth, options.RasterHeight, background)
+	if err != nil {
+		return err
+	}
+	written := make(map[string]bool)
+	for _, page := range doc.Pages() {
+		img := r.page(page, summary)
+		name := rasterPage(file, page.Number)
+		if err = saveImage(img, name, OutputFormat(file) == FormatPNG); err != nil {
+			summary.AddIssue(name, IssueWrite, err)
+			return err
+		}
+		summary.Written = append(summary.Written, name)
+		written[name] = true
+	}
+	// and those of a longer output
+	for _, name := range rasterPages(file) {
+		if !written[name] {
+			if err = os.Remove(name); err != nil {
+				summary.AddIssue(name, IssueWrite, err)
+			}
+		}
+	}
+	return nil
+}
+
+// rasterPage is the file of page n.
+func rasterPage(file string, n int) string {
+	ext := filepath.Ext(file)
+	return fmt.Sprintf("%s-%03d%s", strings.TrimSuffix(file, ext), n, ext)
+}
+
+// rasterPattern matches the names of the pages of file.
+func rasterPattern(file string) *regexp.Regexp {
+	ext := filepath.Ext(file)
+	base := strings.TrimSuffix(filepath.Base(file), ext)
+	return regexp.MustCompile("^" + regexp.QuoteMeta(base) + `-\d{3,}` + regexp.QuoteMeta(ext) + "$")
+}
+
+// rasterPages are the pages of file there are now (from the last time).
+func rasterPages(file string) []string {
+	entries, err := os.ReadDir(filepath.Dir(file))
+	if err != nil {
+		return nil
+	}
+	pattern := rasterPattern(file)
+	pages := make([]string, 0)
+	for _, e := range entries {
+		if !e.IsDir() && pattern.MatchString(e.Name()) {
+			pages = append(pages, filepath.Join(filepath.Dir(file), e.Name()))
+		}
+	}
+	return pages
+}
+
+// rasterizer draws pages, scaling points to pixels.
+type rasterizer struct {
+	width, height int
+	sx, sy        float64 // pixels per point
+	background    color.Color
+	faces         map[string]font.Face
+}
+
+func newRasterizer(width, height int, background color.Color) (*rasterizer, error) {
+	if width <= 0 && height <= 0 {
+		return nil, errors.New("raster width or height must be set")
+	}
+	// missing dimension keeps the shape of the page
+	if width <= 0 {
+		width = int(float64(height) * pageWidth / pageHeight)
+	}
+	if height <= 0 {
+		height = int(float64(width) * pageHeight / pageWidth)
+	}
+	r := &rasterizer{
+		width:      width,
+		height:     height,
+		sx:         float64(width) / pageWidth,
+		sy:         float64(height) / pageHeight,
+		background: background,
+		faces:      make(map[string]font.Face),
+	}
+	return r, nil
+}
+
+// face is the font at a size in points.
+func (r *rasterizer) face(bold bool, size float64) font.Face {
+	key := fmt.Sprintf("%t%f", bold, size)
+	if f, ok := r.faces[key]; ok {
+		return f
+	}
+	ttf := goregular.TTF
+	if bold {
+		ttf = gobold.TTF
+	}
+	scale := r.sx
+	if r.sy < scale {
+		scale = r.sy
+	}
+	// the built-in fonts always parse
+	parsed, _ := opentype.Parse(ttf)
+	face, _ := opentype.NewFace(parsed, &opentype.FaceOptions{Size: size * scale, DPI: 72, Hinting: font.HintingFull})
+	r.faces[key] = face
+	return face
+}
+
+func (r *rasterizer) rect(b Rect) image.Rectangle {
+	return image.Rect(int(b.X*r.sx), int(b.Y*r.sy), int((b.X+b.W)*r.sx), int((b.Y+b.H)*r.sy))
+}
+
+// text draws a TextLine, its baseline at X, Y (points).
+func (r *rasterizer) text(img draw.Image, line TextLine) {
+	if line.Text == "" {
+		return
+	}
+	var c color.Color = line.Color
+	if line.Color.A == 0 {
+		c = color.Black
+	}
+	face := r.face(line.Bold, line.Size)
+	x := line.X
+	if line.Center {
+		x = (pageWidth - float64(font.MeasureString(face, line.Text).Round())/r.sx) / 2
+	}
+	d := font.Drawer{
+		Dst:  img,
+		Src:  image.NewUniform(c),
+		Face: face,
+		Dot:  fixed.P(int(x*r.sx), int(line.Y*r.sy)),
+	}
+	d.DrawString(line.Text)
+}
+
+// page draws a Page.
+func (r *rasterizer) page(page Page, summary *Summary) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, r.width, r.height))
+	draw.Draw(img, img.Bounds(), image.NewUniform(r.background), image.Point{}, draw.Src)
+	for _, picture := range page.Pictures {
+		r.picture(img, picture.Path, picture.Rect, summary)
+	}
+	r.text(img, page.Header)
+	r.text(img, page.Footer)
+	for _, line := range page.Lines {
+		r.text(img, line)
+	}
+	for _, cell := range page.Cells {
+		if !r.picture(img, cell.Entry.Thumb.Path, cell.Image, summary) {
+			continue
+		}
+		r.text(img, cell.Name)
+		for _, line := range cell.Caption {
+			r.text(img, line)
+		}
+		if cell.Frame != nil {
+			r.frame(img, cell.Frame.Rect, cell.Frame.Color, cell.Frame.Width)
+		}
+		if cell.Mark != nil {
+			r.mark(img, cell.Mark)
+		}
+	}
+	return img
+}
+
+// picture draws an image file, false (and an issue) if it can't be.
+func (r *rasterizer) picture(img draw.Image, path string, rect Rect, summary *Summary) bool {
+	src, err := loadImage(path)
+	if err != nil {
+		summary.AddIssue(path, IssueImage, err)
+		return false
+	}
+	box := r.rect(rect)
+	draw.Draw(img, box, resizeImage(src, box.Dx(), box.Dy()), image.Point{}, draw.Over)
+	return true
+}
+
+// frame draws a line (width points) inside rect.
+func (r *rasterizer) frame(img draw.Image, rect Rect, col color.RGBA, width float64) {
+	c := image.NewUniform(col)
+	outer := r.rect(rect)
+	n := int(width*r.sx + 0.5)
+	if n < 1 {
+		n = 1
+	}
+	inner := outer.Inset(n)
+	for _, side := range []image.Rectangle{
+		{Min: outer.Min, Max: image.Pt(outer.Max.X, inner.Min.Y)},
+		{Min: image.Pt(outer.Min.X, inner.Max.Y), Max: outer.Max},
+		{Min: outer.Min, Max: image.Pt(inner.Min.X, outer.Max.Y)},
+		{Min: image.Pt(inner.Max.X, outer.Min.Y), Max: outer.Max},
+	} {
+		draw.Draw(img, side, c, image.Point{}, draw.Src)
+	}
+}
+
+// mark draws the border and badge of a duplicate.
+func (r *rasterizer) mark(img draw.Image, mark *Mark) {
+	r.frame(img, mark.Border, mark.Color, 1.5)
+	draw.Draw(img, r.rect(mark.Badge), image.NewUniform(mark.Color), image.Point{}, draw.Src)
+	r.text(img, mark.Text)
+}
+
+// parseColor reads "#rrggbb" (or "white", "black").
+func parseColor(s string) (color.Color, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "", "white":
+		return color.White, nil
+	case "black":
+		return color.Black, nil
+	}
+	hex := strings.TrimPrefix(strings.TrimSpace(s), "#")
+	v, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil || len(hex) != 6 {
+		return nil, errors.New(fmt.Sprintf("color %q is not #rrggbb", s))
+	}
+	return color.RGBA{R: uint8(v >> 16), G: uint8(v >> 8), B: uint8(v), A: 0xFF}, nil
+}
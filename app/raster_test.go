@@ -0,0 +1,79 @@
+package app
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRasterPages(t *testing.T) {
+	dir := t.TempDir()
+	thumb := filepath.Join(dir, "thumb.png")
+	testPNG(t, thumb)
+	file := filepath.Join(dir, "sheet.png")
+	render := func(n int) *Summary {
+		entries := make([]Entry, 0, n)
+		for i := 0; i < n; i++ {
+			entries = append(entries, Entry{Name: "f.png", Path: thumb, Thumb: Thumbnail{Path: thumb, Type: "png"}})
+		}
+		doc := &Document{Title: "t", Sections: []Section{NewLayout(1, 1).Section(dir, entries, 1)}}
+		summary := NewSummary()
+		if err := (rasterRenderer{}).Render(doc, file, Options{RasterWidth: 60, RasterBackground: "#ffffff"}, summary); err != nil {
+			t.Fatal(err)
+		}
+		return summary
+	}
+	summary := render(3)
+	if len(summary.Written) != 3 || summary.Written[0] != filepath.Join(dir, "sheet-001.png") {
+		t.Fatalf("Written = %v, want sheet-001.png ... sheet-003.png", summary.Written)
+	}
+	other := filepath.Join(dir, "sheets-001.png") // not one of them
+	if err := os.WriteFile(other, []byte("x"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	summary = render(1)
+	if got := rasterPages(file); len(got) != 1 || got[0] != filepath.Join(dir, "sheet-001.png") {
+		t.Errorf("pages after a shorter output = %v, want only sheet-001.png", got)
+	}
+	if err := RemoveOutput(file); err != nil {
+		t.Fatal(err)
+	}
+	if got := rasterPages(file); len(got) != 0 {
+		t.Errorf("pages after RemoveOutput = %v, want none", got)
+	}
+	if _, err := os.Stat(other); err != nil {
+		t.Errorf("RemoveOutput removed %s", other)
+	}
+}
+
+func TestRasterPattern(t *testing.T) {
+	pattern := rasterPattern("/out/sheet.png")
+	for name, want := range map[string]bool{
+		"sheet-001.png":  true,
+		"sheet-1234.png": true,
+		"sheet-01.png":   false,
+		"sheet-001.jpg":  false,
+		"sheets-001.png": false,
+		"sheet.png":      false,
+		"sheet-001.png~": false,
+	} {
+		if got := pattern.MatchString(name); got != want {
+			t.Errorf("rasterPattern(%s) = %v, want %v", name, got, want)
+		}
+	}
+}
+
+func TestSummaryOutput(t *testing.T) {
+	s := NewSummary()
+	if got := s.Output(); got != "" {
+		t.Errorf("Output of nothing = %q", got)
+	}
+	s.Written = []string{"/a/s.pdf"}
+	if got := s.Output(); got != "/a/s.pdf" {
+		t.Errorf("Output = %q, want /a/s.pdf", got)
+	}
+	s.Written = []string{"/a/s-001.png", "/a/s-002.png", "/a/s-003.png"}
+	if got, want := s.Output(), "/a/s-001.png ... s-003.png (3 files)"; got != want {
+		t.Errorf("Output = %q, want %q", got, want)
+	}
+}
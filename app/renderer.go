@@ -0,0 +1,30 @@
+package app
+
+/*
+
+  File:    renderer.go
+  Author:  Bob Shofner
+
+  MIT License - https://opensource.org/license/mit/
+
+  This permission notice shall be included in all copies
+    or substantial portions of the Software.
+
+*/
+/*
+  Description: Write a Document in one of the output formats.
+*/
+
+// Renderer writes the Document to file.
+// Problems with individual files are added to the Summary.
+type Renderer interface {
+	Render(doc *Document, file string, options Options, summary *Summary) error
+}
+
+// renderers by output format
+var renderers = map[string]Renderer{
+	FormatPDF:  pdfRenderer{},
+	FormatHTML: htmlRenderer{},
+	FormatPNG:  rasterRenderer{},
+	FormatJPEG: rasterRenderer{},
+}
@@ -0,0 +1,237 @@
+package app
+
+import (
+	"archive/zip"
+	"bytes"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+/*
+
+  File:    sniff.go
+  Author:  Bob Shofner
+
+  MIT License - https://opensource.org/license/mit/
+
+  This permission notice shall be included in all copies
+    or substantial portions of the Software.
+
+*/
+/*
+  Description: Identify files by their content (magic numbers).
+*/
+
+// Content is what the first bytes of a file say it is.
+type Content struct {
+	Name     string   // "jpeg", "png", "mp3", "zip", ...
+	Category string   // CameraExt, AudioExt, ...
+	Image    string   // gofpdf image type (jpg, png, gif), "" if it can't be placed
+	Exts     []string // extensions normally used for the content
+}
+
+type signature struct {
+	content Content
+	match   func(b []byte) bool
+}
+
+func prefix(magic string) func(b []byte) bool {
+	return at(0, magic)
+}
+func at(offset int, magic string) func(b []byte) bool {
+	return func(b []byte) bool {
+		return len(b) >= offset+len(magic) && string(b[offset:offset+len(magic)]) == magic
+	}
+}
+func both(f1, f2 func(b []byte) bool) func(b []byte) bool {
+	return func(b []byte) bool {
+		return f1(b) && f2(b)
+	}
+}
+
+// ISO base media (mp4, mov, heic) brand
+func brand(brands ...string) func(b []byte) bool {
+	return func(b []byte) bool {
+		if !at(4, "ftyp")(b) || len(b) < 12 {
+			return false
+		}
+		for _, brand := range brands {
+			if string(b[8:12]) == brand {
+				return true
+			}
+		}
+		return len(brands) == 0
+	}
+}
+
+// MPEG audio frame sync (mp3 without ID3 tags, or AAC ADTS)
+func frameSync(layer byte) func(b []byte) bool {
+	return func(b []byte) bool {
+		return len(b) > 1 && b[0] == 0xFF && b[1]&0xF6 == 0xF0|layer
+	}
+}
+
+var zipExts = []string{".zip", ".jar", ".war", ".ear", ".apk", ".epub",
+	".docx", ".xlsx", ".pptx", ".odt", ".ods", ".odp", ".odg"}
+
+// most specific first
+var signatures = []signature{
+	{Content{"jpeg", CameraExt, "jpg", []string{".jpg", ".jpeg", ".jpe", ".jfif"}}, prefix("\xFF\xD8\xFF")},
+	{Content{"png", CameraExt, "png", []string{".png"}}, prefix("\x89PNG\r\n\x1a\n")},
+	{Content{"gif", CameraExt, "gif", []string{".gif"}}, func(b []byte) bool {
+		return prefix("GIF87a")(b) || prefix("GIF89a")(b)
+	}},
+	{Content{"bmp", BitmapExt, "", []string{".bmp", ".dib"}}, prefix("BM")},
+	{Content{"tiff", BitmapExt, "", []string{".tif", ".tiff", ".raw", ".kdc"}}, func(b []byte) bool {
+		return prefix("II*\x00")(b) || prefix("MM\x00*")(b)
+	}},
+	{Content{"webp", CameraExt, "", []string{".webp"}}, both(prefix("RIFF"), at(8, "WEBP"))},
+	{Content{"heic", AppleExt, "", []string{".heic", ".heif"}}, brand("heic", "heix", "hevc", "hevx", "mif1", "msf1")},
+	{Content{"m4a", AudioExt, "", []string{".m4a", ".m4b", ".mp4"}}, brand("M4A ", "M4B ")},
+	{Content{"mov", VideoExt, "", []string{".mov", ".qt"}}, brand("qt  ")},
+	{Content{"mp4", VideoExt, "", []string{".mp4", ".m4v", ".mov", ".3gp", ".3g2", ".f4v"}}, brand()},
+	{Content{"mp3", AudioExt, "", []string{".mp3"}}, func(b []byte) bool {
+		return prefix("ID3")(b) || frameSync(0x02)(b) || frameSync(0x04)(b) || frameSync(0x06)(b)
+	}},
+	{Content{"aac", AudioExt, "", []string{".aac"}}, frameSync(0x00)},
+	{Content{"flac", AudioExt, "", []string{".flac"}}, prefix("fLaC")},
+	{Content{"ogg", AudioExt, "", []string{".ogg", ".oga", ".ogv", ".opus"}}, prefix("OggS")},
+	{Content{"wav", AudioExt, "", []string{".wav"}}, both(prefix("RIFF"), at(8, "WAVE"))},
+	{Content{"avi", VideoExt, "", []string{".avi"}}, both(prefix("RIFF"), at(8, "AVI "))},
+	{Content{"asf", VideoExt, "", []string{".wmv", ".wma", ".asf"}}, prefix("\x30\x26\xB2\x75\x8E\x66\xCF\x11")},
+	{Content{"mkv", VideoExt, "", []string{".mkv", ".webm"}}, prefix("\x1A\x45\xDF\xA3")},
+	{Content{"flv", VideoExt, "", []string{".flv"}}, prefix("FLV\x01")},
+	{Content{"mpeg", VideoExt, "", []string{".mpg", ".mpeg", ".vob"}}, func(b []byte) bool {
+		return prefix("\x00\x00\x01\xBA")(b) || prefix("\x00\x00\x01\xB3")(b)
+	}},
+	// ODF starts with its stored mimetype
+	{Content{"odt", WordExt, "", []string{".odt", ".ott", ".zip"}}, both(prefix("PK\x03\x04"), at(38, "application/vnd.oasis.opendocument.text"))},
+	{Content{"ods", SheetExt, "", []string{".ods", ".ots", ".zip"}}, both(prefix("PK\x03\x04"), at(38, "application/vnd.oasis.opendocument.spreadsheet"))},
+	{Content{"odp", SlideExt, "", []string{".odp", ".otp", ".zip"}}, both(prefix("PK\x03\x04"), at(38, "application/vnd.oasis.opendocument.presentation"))},
+	{Content{"zip", ZipExt, "", zipExts}, func(b []byte) bool {
+		return prefix("PK\x03\x04")(b) || prefix("PK\x05\x06")(b)
+	}},
+	{Content{"gzip", ZipExt, "", []string{".gz", ".gzip", ".tgz"}}, prefix("\x1F\x8B")},
+	{Content{"7z", ZipExt, "", []string{".7z"}}, prefix("7z\xBC\xAF\x27\x1C")},
+	{Content{"rar", ZipExt, "", []string{".rar"}}, prefix("Rar!\x1A\x07")},
+	{Content{"pdf", PdfExt, "", []string{".pdf"}}, prefix("%PDF-")},
+	{Content{"ole", DocExt, "", []string{".doc", ".xls", ".ppt", ".msg", ".msi"}}, prefix("\xD0\xCF\x11\xE0\xA1\xB1\x1A\xE1")},
+	{Content{"rtf", WordExt, "", []string{".rtf", ".doc"}}, prefix("{\\rtf")},
+	{Content{"exe", ExeExt, "", []string{".exe", ".dll", ".com", ".scr"}}, prefix("MZ")},
+	{Content{"elf", ExeExt, "", []string{".bin", ".so", ".o"}}, prefix("\x7FELF")},
+}
+
+// SniffContent reads the start of a file to see what it really is.
+// Unrecognized content has an empty Name; plain text and html are guessed,
+// but have no Exts.
+func SniffContent(path string) Content {
+	f, err := os.Open(path)
+	if err != nil {
+		return Content{}
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	buffer := make([]byte, 512)
+	n, _ := f.Read(buffer)
+	content := sniffBytes(buffer[:n])
+	if content.Name == "zip" {
+		content = zipContent(path, content)
+	}
+	return content
+}
+
+// ooxml are the Office documents, zips known by a part in them.
+var ooxml = map[string]Content{
+	"word/document.xml":    {"docx", WordExt, "", []string{".docx", ".docm", ".dotx", ".zip"}},
+	"xl/workbook.xml":      {"xlsx", SheetExt, "", []string{".xlsx", ".xlsm", ".xltx", ".zip"}},
+	"ppt/presentation.xml": {"pptx", SlideExt, "", []string{".pptx", ".pptm", ".potx", ".zip"}},
+}
+
+// zipContent is what the zip is, if it is an Office document.
+func zipContent(path string, content Content) Content {
+	r, err := zip.OpenReader(path)
+	if err != nil {
+		return content
+	}
+	defer func() {
+		_ = r.Close()
+	}()
+	for _, f := range r.File {
+		if c, ok := ooxml[f.Name]; ok {
+			return c
+		}
+	}
+	return content
+}
+
+// sniffed is the FileInfo of a file with its Content, so a provider's
+// Match doesn't read the file again.
+type sniffed struct {
+	fs.FileInfo
+	content Content
+}
+
+// ContentOf a file, from its FileInfo if it was sniffed already.
+func ContentOf(path string, info fs.FileInfo) Content {
+	if s, ok := info.(sniffed); ok {
+		return s.content
+	}
+	return SniffContent(path)
+}
+
+func sniffBytes(b []byte) Content {
+	if len(b) == 0 {
+		return Content{}
+	}
+	for _, s := range signatures {
+		if s.match(b) {
+			return s.content
+		}
+	}
+	mime := http.DetectContentType(b)
+	switch {
+	case strings.HasPrefix(mime, "text/html"):
+		return Content{Name: "html", Category: HtmlExt}
+	case strings.HasPrefix(mime, "text/xml"):
+		return Content{Name: "xml", Category: DocExt}
+	case strings.HasPrefix(mime, "text/"):
+		if bytes.HasPrefix(b, []byte("#!")) {
+			return Content{Name: "script", Category: ExeExt}
+		}
+		return Content{Name: "text", Category: DocExt}
+	}
+	return Content{}
+}
+
+// Mismatch is a file whose extension doesn't fit its content.
+type Mismatch struct {
+	Path    string
+	Content string
+}
+
+// contentMismatch checks a file with a known extension against its content.
+func contentMismatch(path string, content Content) bool {
+	if content.Name == "" || GetFileTypes().Category(path) == "" {
+		return false // not an extension we know about
+	}
+	return !contentFits(path, content)
+}
+
+// contentFits is true if the extension is one used for the content, or
+// the content is only guessed (text).
+func contentFits(path string, content Content) bool {
+	if len(content.Exts) == 0 {
+		return true
+	}
+	ext := strings.ToLower(filepath.Ext(path))
+	for _, e := range content.Exts {
+		if e == ext {
+			return true
+		}
+	}
+	return false
+}
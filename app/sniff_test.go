@@ -0,0 +1,244 @@
+package app
+
+import (
+	"archive/zip"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// defaultTypes makes GetFileTypes the built-in mapping, without the
+// storage folder (and the fyne App) of GetSystem.
+func defaultTypes() {
+	fileTypesOnce.Do(func() {
+		fileTypes = DefaultFileTypes()
+	})
+}
+
+func TestSniffBytes(t *testing.T) {
+	tests := []struct {
+		name  string
+		bytes string
+		want  string
+		image string
+	}{
+		{"jpeg", "\xFF\xD8\xFF\xE0\x00\x10JFIF", "jpeg", "jpg"},
+		{"png", "\x89PNG\r\n\x1a\n\x00\x00", "png", "png"},
+		{"gif", "GIF89a\x01\x00", "gif", "gif"},
+		{"bmp", "BM\x00\x00", "bmp", ""},
+		{"tiff", "II*\x00\x08\x00", "tiff", ""},
+		{"webp", "RIFF\x00\x00\x00\x00WEBPVP8 ", "webp", ""},
+		{"wav", "RIFF\x00\x00\x00\x00WAVEfmt ", "wav", ""},
+		{"avi", "RIFF\x00\x00\x00\x00AVI LIST", "avi", ""},
+		{"heic", "\x00\x00\x00\x18ftypheic\x00\x00", "heic", ""},
+		{"m4a", "\x00\x00\x00\x18ftypM4A \x00\x00", "m4a", ""},
+		{"mov", "\x00\x00\x00\x14ftypqt  \x00\x00", "mov", ""},
+		{"mp4", "\x00\x00\x00\x18ftypisom\x00\x00", "mp4", ""},
+		{"mp3 id3", "ID3\x04\x00", "mp3", ""},
+		{"mp3 frame", "\xFF\xFB\x90\x00", "mp3", ""},
+		{"aac", "\xFF\xF1\x50\x80", "aac", ""},
+		{"flac", "fLaC\x00", "flac", ""},
+		{"zip", "PK\x03\x04\x14\x00", "zip", ""},
+		{"empty zip", "PK\x05\x06\x00\x00", "zip", ""},
+		{"odt", odf("text"), "odt", ""},
+		{"ods", odf("spreadsheet"), "ods", ""},
+		{"odp", odf("presentation"), "odp", ""},
+		{"gzip", "\x1F\x8B\x08", "gzip", ""},
+		{"pdf", "%PDF-1.7\n", "pdf", ""},
+		{"ole", "\xD0\xCF\x11\xE0\xA1\xB1\x1A\xE1\x00", "ole", ""},
+		{"rtf", "{\\rtf1\\ansi", "rtf", ""},
+		{"exe", "MZ\x90\x00", "exe", ""},
+		{"elf", "\x7FELF\x02\x01", "elf", ""},
+		{"html", "<!DOCTYPE html><html><body>", "html", ""},
+		{"xml", "<?xml version=\"1.0\"?><a/>", "xml", ""},
+		{"script", "#!/bin/sh\necho hi\n", "script", ""},
+		{"text", "just some words\n", "text", ""},
+		{"empty", "", "", ""},
+		{"unknown", "\x00\x01\x02\x03\x04\x05", "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := sniffBytes([]byte(tt.bytes))
+			if got.Name != tt.want || got.Image != tt.image {
+				t.Errorf("sniffBytes = %q (image %q), want %q (image %q)", got.Name, got.Image, tt.want, tt.image)
+			}
+		})
+	}
+}
+
+// odf is the start of an ODF document, its mimetype stored first.
+func odf(kind string) string {
+	return "PK\x03\x04" + strings.Repeat("\x00", 26) + "mimetypeapplication/vnd.oasis.opendocument." + kind
+}
+
+// testZip writes a zip of the names, empty.
+func testZip(t *testing.T, path string, names ...string) {
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := zip.NewWriter(f)
+	for _, name := range names {
+		if _, err = w.Create(name); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err = w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err = f.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSniffContent(t *testing.T) {
+	dir := t.TempDir()
+	photo := filepath.Join(dir, "photo.txt") // the name is wrong
+	if err := os.WriteFile(photo, []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\x0dIHDR"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if got := SniffContent(photo); got.Name != "png" || got.Category != CameraExt {
+		t.Errorf("SniffContent(%s) = %+v, want png", photo, got)
+	}
+	if got := SniffContent(filepath.Join(dir, "missing")); got.Name != "" {
+		t.Errorf("SniffContent of a missing file = %+v, want nothing", got)
+	}
+	if got := SniffContent(dir); got.Name != "" {
+		t.Errorf("SniffContent of a directory = %+v, want nothing", got)
+	}
+}
+
+func TestContentOf(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "a.gif")
+	if err := os.WriteFile(path, []byte("GIF89a\x01\x00"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := ContentOf(path, info); got.Name != "gif" {
+		t.Errorf("ContentOf = %q, want gif", got.Name)
+	}
+	// sniffed is not read again
+	known := sniffed{FileInfo: info, content: Content{Name: "jpeg", Image: "jpg"}}
+	if got := ContentOf(path, known); got.Name != "jpeg" {
+		t.Errorf("ContentOf sniffed = %q, want jpeg", got.Name)
+	}
+}
+
+func TestContentMismatch(t *testing.T) {
+	defaultTypes()
+	jpeg := sniffBytes([]byte("\xFF\xD8\xFF\xE0"))
+	zip := sniffBytes([]byte("PK\x03\x04"))
+	tests := []struct {
+		path    string
+		content Content
+		want    bool
+	}{
+		{"a.jpg", jpeg, false},
+		{"a.JPEG", jpeg, false},
+		{"a.png", jpeg, true},
+		{"a.pdf", jpeg, true},
+		{"a.docx", zip, false},
+		{"a.xyz", jpeg, false},                          // an extension that isn't known
+		{"a.txt", Content{}, false},                     // content that isn't known
+		{"a.jpg", sniffBytes([]byte("hello\n")), false}, // text has no Exts
+	}
+	for _, tt := range tests {
+		if got := contentMismatch(tt.path, tt.content); got != tt.want {
+			t.Errorf("contentMismatch(%s, %s) = %v, want %v", tt.path, tt.content.Name, got, tt.want)
+		}
+	}
+}
+
+func TestThumbnailForUsesContent(t *testing.T) {
+	defaultTypes()
+	dir := t.TempDir()
+	path := filepath.Join(dir, "photo")
+	if err := os.WriteFile(path, []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\x0dIHDR"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	// what the caller sniffed is used, the file is not read again
+	thumb, err := ThumbnailFor(context.Background(), path, Content{Name: "gif", Category: CameraExt, Image: "gif"}, 80)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if thumb.Path != path || thumb.Type != "gif" {
+		t.Errorf("ThumbnailFor = %s (%s), want %s (gif)", thumb.Path, thumb.Type, path)
+	}
+}
+
+func TestSniffOffice(t *testing.T) {
+	dir := t.TempDir()
+	tests := []struct {
+		part string
+		want string
+	}{
+		{"word/document.xml", "docx"},
+		{"xl/workbook.xml", "xlsx"},
+		{"ppt/presentation.xml", "pptx"},
+		{"readme.txt", "zip"},
+	}
+	for _, tt := range tests {
+		path := filepath.Join(dir, tt.want+".dat")
+		testZip(t, path, "[Content_Types].xml", tt.part)
+		if got := SniffContent(path); got.Name != tt.want {
+			t.Errorf("SniffContent of a zip with %s = %q, want %q", tt.part, got.Name, tt.want)
+		}
+	}
+}
+
+func TestCategoryOf(t *testing.T) {
+	defaultTypes()
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+	docx := filepath.Join(dir, "report.dat")
+	testZip(t, docx, "[Content_Types].xml", "word/document.xml")
+	zipped := filepath.Join(dir, "song.mp3")
+	testZip(t, zipped, "a.txt")
+	tests := []struct {
+		path string
+		want string
+	}{
+		{write("song.dat", "ID3\x04\x00"), AudioExt},        // by content, not the extension
+		{write("song", "ID3\x04\x00"), AudioExt},            // without one
+		{zipped, ZipExt},                                    // a .mp3 that is a zip
+		{docx, WordExt},                                     // a .docx, as .dat
+		{write("notes.dat", odf("spreadsheet")), SheetExt},  // an .ods
+		{write("scan.kdc", "II*\x00\x08\x00"), CameraExt},   // the content fits, the extension says more
+		{write("list.csv", "a,b\n1,2\n"), SheetExt},         // text is only a guess
+		{write("notes.md", "# notes\n"), DocExt},            // text, an extension that isn't known
+		{write("photo.jpg", "\x00\x01\x02\x03"), CameraExt}, // content that isn't known
+		{write("blob.xyz", "\x00\x01\x02\x03"), UnknownExt}, // neither
+		{dir, FolderExt},
+	}
+	for _, tt := range tests {
+		if got := ExtensionType(tt.path); got != tt.want {
+			t.Errorf("ExtensionType(%s) = %s, want %s", filepath.Base(tt.path), got, tt.want)
+		}
+	}
+	// the providers go by it
+	info := func(path string) os.FileInfo {
+		i, err := os.Stat(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return i
+	}
+	if !(audioProvider{}).Match(tests[0].path, info(tests[0].path)) || (audioProvider{}).Match(zipped, info(zipped)) {
+		t.Errorf("audioProvider matched by the extension")
+	}
+	if !(officeProvider{}).Match(docx, info(docx)) {
+		t.Errorf("officeProvider didn't match a .docx as .dat")
+	}
+}
@@ -0,0 +1,216 @@
+package app
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+/*
+
+  File:    sort.go
+  Author:  Bob Shofner
+
+  MIT License - https://opensource.org/license/mit/
+
+  This permission notice shall be included in all copies
+    or substantial portions of the Software.
+
+*/
+/*
+  Description: The order of the files in a section, and their groups.
+*/
+
+// sort keys
+const SortName = "name"   // natural: IMG_2 before IMG_10, ignoring case
+const SortMtime = "mtime" // modified time
+const SortSize = "size"
+const SortType = "type"
+const SortExif = "exif"   // EXIF date taken (else modified time)
+const SortTrack = "track" // audio track number
+
+// group keys
+const GroupType = "type"
+const GroupDay = "day" // of the date taken (else modified)
+const GroupMonth = "month"
+const GroupArtist = "artist"
+const GroupAlbum = "album" // artist / album
+
+var SortKeys = []string{SortName, SortMtime, SortSize, SortType, SortExif, SortTrack}
+var GroupKeys = []string{GroupType, GroupDay, GroupMonth, GroupArtist, GroupAlbum}
+
+// sortEntries orders the entries by the key, and then by their groups.
+func sortEntries(entries []Entry, key string, descending bool, group string) {
+	less := entryLess(key)
+	sort.SliceStable(entries, func(i, j int) bool {
+		if descending {
+			return less(entries[j], entries[i])
+		}
+		return less(entries[i], entries[j])
+	})
+	if group == "" {
+		return
+	}
+	// groups are in order of their first entry
+	first := make(map[string]int)
+	for i, e := range entries {
+		g := groupOf(e, group)
+		if _, ok := first[g]; !ok {
+			first[g] = i
+		}
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return first[groupOf(entries[i], group)] < first[groupOf(entries[j], group)]
+	})
+}
+
+// entryLess compares entries by a sort key; ties are by name.
+func entryLess(key string) func(a, b Entry) bool {
+	byName := func(a, b Entry) bool {
+		return naturalLess(a.Name, b.Name)
+	}
+	then := func(cmp func(a, b Entry) int) func(a, b Entry) bool {
+		return func(a, b Entry) bool {
+			if c := cmp(a, b); c != 0 {
+				return c < 0
+			}
+			return byName(a, b)
+		}
+	}
+	switch key {
+	case SortMtime:
+		return then(func(a, b Entry) int {
+			return compareTime(metaOf(a).Modified, metaOf(b).Modified)
+		})
+	case SortSize:
+		return then(func(a, b Entry) int {
+			return compareInt(metaOf(a).Size, metaOf(b).Size)
+		})
+	case SortType:
+		return then(func(a, b Entry) int {
+			return strings.Compare(metaOf(a).Category, metaOf(b).Category)
+		})
+	case SortExif:
+		return then(func(a, b Entry) int {
+			return compareTime(photoDate(metaOf(a)), photoDate(metaOf(b)))
+		})
+	case SortTrack:
+		return then(func(a, b Entry) int {
+			return compareInt(int64(trackNumber(metaOf(a))), int64(trackNumber(metaOf(b))))
+		})
+	}
+	return byName
+}
+
+// groupOf is the sub-header the entry is shown under.
+func groupOf(e Entry, group string) string {
+	meta := metaOf(e)
+	switch group {
+	case GroupType:
+		return meta.Category
+	case GroupDay:
+		return photoDate(meta).Format("Monday, January 2, 2006")
+	case GroupMonth:
+		return photoDate(meta).Format("January 2006")
+	case GroupArtist:
+		if artist := meta.Audio["artist"]; artist != "" {
+			return artist
+		}
+		return "Unknown Artist"
+	case GroupAlbum:
+		artist, album := meta.Audio["artist"], meta.Audio["album"]
+		if artist == "" && album == "" {
+			return "Unknown Album"
+		}
+		return strings.TrimSuffix(artist+" / "+album, " / ")
+	}
+	return ""
+}
+
+// metaOf is never nil, to keep the comparisons simple.
+func metaOf(e Entry) *FileMeta {
+	if e.Meta == nil {
+		return &FileMeta{Path: e.Path}
+	}
+	return e.Meta
+}
+
+// photoDate is when the picture was taken, else when the file was modified.
+func photoDate(meta *FileMeta) time.Time {
+	for _, tag := range []string{"DateTimeOriginal", "DateTime"} {
+		if t, err := time.ParseInLocation(ExifDate, meta.Exif[tag], time.Local); err == nil {
+			return t
+		}
+	}
+	return meta.Modified
+}
+
+// trackNumber is from "3" or "3/12". Files without one are last.
+func trackNumber(meta *FileMeta) int {
+	track := strings.TrimSpace(strings.SplitN(meta.Audio["track"], "/", 2)[0])
+	n, err := strconv.Atoi(track)
+	if err != nil {
+		return int(^uint(0) >> 1)
+	}
+	return n
+}
+
+func compareTime(a, b time.Time) int {
+	switch {
+	case a.Before(b):
+		return -1
+	case a.After(b):
+		return 1
+	}
+	return 0
+}
+
+func compareInt(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// naturalLess compares names ignoring case, with runs of digits by their
+// value. Names that differ only by case are in byte order.
+func naturalLess(a, b string) bool {
+	ra, rb := []rune(a), []rune(b)
+	i, j := 0, 0
+	for i < len(ra) && j < len(rb) {
+		if unicode.IsDigit(ra[i]) && unicode.IsDigit(rb[j]) {
+			si, sj := i, j
+			for i < len(ra) && unicode.IsDigit(ra[i]) {
+				i++
+			}
+			for j < len(rb) && unicode.IsDigit(rb[j]) {
+				j++
+			}
+			// compare the values, without leading zeros
+			na := strings.TrimLeft(string(ra[si:i]), "0")
+			nb := strings.TrimLeft(string(rb[sj:j]), "0")
+			if len(na) != len(nb) {
+				return len(na) < len(nb)
+			}
+			if na != nb {
+				return na < nb
+			}
+			continue
+		}
+		ca, cb := unicode.ToLower(ra[i]), unicode.ToLower(rb[j])
+		if ca != cb {
+			return ca < cb
+		}
+		i++
+		j++
+	}
+	if len(ra)-i != len(rb)-j {
+		return len(ra)-i < len(rb)-j
+	}
+	return a < b
+}
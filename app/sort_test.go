@@ -0,0 +1,93 @@
+package app
+
+import (
+	"sort"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNaturalLess(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{"IMG_2.jpg", "IMG_10.jpg", true},
+		{"IMG_10.jpg", "IMG_2.jpg", false},
+		{"img_2.jpg", "IMG_10.jpg", true}, // case is ignored
+		{"a.jpg", "B.jpg", true},
+		{"B.jpg", "a.jpg", false},
+		{"a", "ab", true},
+		{"ab", "a", false},
+		{"a1", "a1b", true},
+		{"a02", "a2", true}, // the same value, then byte order
+		{"a2", "a02", false},
+		{"a007", "a10", true},
+		{"A.jpg", "a.jpg", true}, // the same but for case: byte order
+		{"a.jpg", "A.jpg", false},
+		{"x.jpg", "x.jpg", false},
+		{"12345678901234567890", "12345678901234567891", true}, // larger than an int
+		{"é1", "é10", true},
+		{"", "a", true},
+		{"", "", false},
+	}
+	for _, tt := range tests {
+		if got := naturalLess(tt.a, tt.b); got != tt.want {
+			t.Errorf("naturalLess(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestNaturalLessSorts(t *testing.T) {
+	names := []string{"IMG_10.jpg", "img_1.jpg", "IMG_2.jpg", "IMG_02.jpg", "IMG_1a.jpg", "IMG.jpg"}
+	sort.Slice(names, func(i, j int) bool {
+		return naturalLess(names[i], names[j])
+	})
+	want := "IMG.jpg img_1.jpg IMG_1a.jpg IMG_02.jpg IMG_2.jpg IMG_10.jpg"
+	if got := strings.Join(names, " "); got != want {
+		t.Errorf("sorted %s, want %s", got, want)
+	}
+}
+
+func TestSortEntries(t *testing.T) {
+	day := time.Date(2024, 5, 1, 12, 0, 0, 0, time.Local)
+	entry := func(name string, size int64, category string, modified time.Time) Entry {
+		return Entry{Name: name, Meta: &FileMeta{Size: size, Category: category, Modified: modified}}
+	}
+	names := func(entries []Entry) string {
+		var s []string
+		for _, e := range entries {
+			s = append(s, e.Name)
+		}
+		return strings.Join(s, " ")
+	}
+	entries := func() []Entry {
+		return []Entry{
+			entry("f10", 1, "camera", day),
+			entry("f2", 3, "audio", day.AddDate(0, 0, 1)),
+			entry("f1", 3, "camera", day.AddDate(0, 0, -1)),
+			entry("f3", 2, "audio", day),
+		}
+	}
+	tests := []struct {
+		key        string
+		descending bool
+		group      string
+		want       string
+	}{
+		{SortName, false, "", "f1 f2 f3 f10"},
+		{SortName, true, "", "f10 f3 f2 f1"},
+		{SortSize, false, "", "f10 f3 f1 f2"}, // ties by name
+		{SortMtime, false, "", "f1 f3 f10 f2"},
+		{SortType, false, "", "f2 f3 f1 f10"},
+		{SortName, false, GroupType, "f1 f10 f2 f3"}, // groups in order of their first
+		{SortMtime, false, GroupDay, "f1 f3 f10 f2"},
+	}
+	for _, tt := range tests {
+		e := entries()
+		sortEntries(e, tt.key, tt.descending, tt.group)
+		if got := names(e); got != tt.want {
+			t.Errorf("sortEntries(%s, %v, %s) = %s, want %s", tt.key, tt.descending, tt.group, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package app
+
+import (
+	"fmt"
+	"snap/fileutil"
+	"sort"
+	"strings"
+	"time"
+)
+
+/*
+
+  File:    stats.go
+  Author:  Bob Shofner
+
+  MIT License - https://opensource.org/license/mit/
+
+  This permission notice shall be included in all copies
+    or substantial portions of the Software.
+
+*/
+/*
+  Description: Statistics of the directories shown, for the section
+    headers and the summary page.
+*/
+
+type DirStats struct {
+	Counts  map[string]int // files by category
+	Files   int
+	Folders int
+	Size    uint64 // of the files, and the folders' trees
+	Oldest  time.Time
+	Newest  time.Time
+	Free    uint64 // on the filesystem, 0 if not known
+}
+
+// dirStats gathers the statistics of a directory's entries.
+func dirStats(dir string, entries []Entry) DirStats {
+	stats := DirStats{Counts: make(map[string]int)}
+	for _, e := range entries {
+		meta := metaOf(e)
+		if meta.Category == FolderExt {
+			stats.Folders++
+			var size uint64
+			fileutil.DirTreeSize(e.Path, &size)
+			stats.Size += size
+			continue
+		}
+		stats.Files++
+		stats.Counts[meta.Category]++
+		stats.Size += uint64(meta.Size)
+		stats.dates(meta.Modified)
+	}
+	stats.Free = fileutil.GetDiskUsage(dir).Avail
+	return stats
+}
+
+func (s *DirStats) dates(t time.Time) {
+	if t.IsZero() {
+		return
+	}
+	if s.Oldest.IsZero() || t.Before(s.Oldest) {
+		s.Oldest = t
+	}
+	if t.After(s.Newest) {
+		s.Newest = t
+	}
+}
+
+// add totals the statistics. Free space isn't, the directories
+// may be on the same filesystem.
+func (s *DirStats) add(o DirStats) {
+	if s.Counts == nil {
+		s.Counts = make(map[string]int)
+	}
+	for k, v := range o.Counts {
+		s.Counts[k] += v
+	}
+	s.Files += o.Files
+	s.Folders += o.Folders
+	s.Size += o.Size
+	s.dates(o.Oldest)
+	s.dates(o.Newest)
+}
+
+// Totals of the Sections' DirStats.
+func (d *Document) Totals() DirStats {
+	var totals DirStats
+	for _, section := range d.Sections {
+		if section.Stats != nil {
+			totals.add(*section.Stats)
+		}
+	}
+	return totals
+}
+
+// String is the one line shown under a header.
+func (s DirStats) String() string {
+	parts := []string{fmt.Sprintf("%d files, %d folders", s.Files, s.Folders)}
+	if len(s.Counts) > 0 {
+		categories := make([]string, 0, len(s.Counts))
+		for k := range s.Counts {
+			categories = append(categories, k)
+		}
+		// most first
+		sort.Slice(categories, func(i, j int) bool {
+			if s.Counts[categories[i]] != s.Counts[categories[j]] {
+				return s.Counts[categories[i]] > s.Counts[categories[j]]
+			}
+			return categories[i] < categories[j]
+		})
+		counts := make([]string, 0, len(categories))
+		for _, k := range categories {
+			counts = append(counts, fmt.Sprintf("%d %s", s.Counts[k], k))
+		}
+		parts = append(parts, strings.Join(counts, ", "))
+	}
+	parts = append(parts, strings.TrimSpace(fileutil.PrettyDiskSize(s.Size)))
+	if !s.Oldest.IsZero() {
+		parts = append(parts, fmt.Sprintf("%s to %s", s.Oldest.Format(FilterDate), s.Newest.Format(FilterDate)))
+	}
+	if s.Free > 0 {
+		parts = append(parts, strings.TrimSpace(fileutil.PrettyDiskSize(s.Free))+" free")
+	}
+	return strings.Join(parts, " - ")
+}
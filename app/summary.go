@@ -0,0 +1,93 @@
+package app
+
+import (
+	"fmt"
+	"path/filepath"
+)
+
+/*
+
+  File:    summary.go
+  Author:  Bob Shofner
+
+  MIT License - https://opensource.org/license/mit/
+
+  This permission notice shall be included in all copies
+    or substantial portions of the Software.
+
+*/
+/*
+  Description: Account of a run, shown in the console when done.
+*/
+
+type Summary struct {
+	Directories int
+	Reused      int // directories unchanged since the last time
+	Files       int
+	Mismatches  []Mismatch
+	Issues      []Issue
+	Metadata    []*FileMeta
+	Dupes       []DupeGroup
+	Written     []string // the output files, a PNG/JPEG output has one for each page
+}
+
+// Issue is a file that couldn't be (completely) shown.
+type Issue struct {
+	Path    string
+	Stage   string // IssueRead, IssueThumbnail, ...
+	Message string
+}
+
+const IssueRead = "read"
+const IssueThumbnail = "thumbnail"
+const IssueImage = "image"
+const IssueWrite = "write"
+const IssueAttach = "attach"
+
+func NewSummary() *Summary {
+	return &Summary{Mismatches: make([]Mismatch, 0), Issues: make([]Issue, 0), Metadata: make([]*FileMeta, 0)}
+}
+
+// AddIssue records a problem with a file.
+func (s *Summary) AddIssue(path, stage string, err error) {
+	s.Issues = append(s.Issues, Issue{Path: path, Stage: stage, Message: err.Error()})
+}
+
+func (i Issue) String() string {
+	return fmt.Sprintf("%s [%s] %s", i.Path, i.Stage, i.Message)
+}
+
+// Output is the file written, or the first and last of them.
+func (s *Summary) Output() string {
+	switch len(s.Written) {
+	case 0:
+		return ""
+	case 1:
+		return s.Written[0]
+	}
+	return fmt.Sprintf("%s ... %s (%d files)", s.Written[0], filepath.Base(s.Written[len(s.Written)-1]), len(s.Written))
+}
+
+// Lines of text for the console.
+func (s *Summary) Lines() []string {
+	lines := []string{fmt.Sprintf("%d items in %d directories", s.Files, s.Directories)}
+	if s.Reused > 0 {
+		lines = append(lines, fmt.Sprintf("%d directories unchanged, not read again", s.Reused))
+	}
+	if len(s.Mismatches) > 0 {
+		lines = append(lines, fmt.Sprintf("%d files are not what their extension says:", len(s.Mismatches)))
+		for _, m := range s.Mismatches {
+			lines = append(lines, fmt.Sprintf("  %s is %s", m.Path, m.Content))
+		}
+	}
+	if len(s.Dupes) > 0 {
+		lines = append(lines, fmt.Sprintf("%d groups of duplicate files (\"dupes\" lists them)", len(s.Dupes)))
+	}
+	if len(s.Issues) > 0 {
+		lines = append(lines, fmt.Sprintf("%d files had problems:", len(s.Issues)))
+		for _, i := range s.Issues {
+			lines = append(lines, "  "+i.String())
+		}
+	}
+	return lines
+}
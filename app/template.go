@@ -0,0 +1,248 @@
+package app
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"fyne.io/fyne/v2"
+	"image"
+	"image/color"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+/*
+
+  File:    template.go
+  Author:  Bob Shofner
+
+  MIT License - https://opensource.org/license/mit/
+
+  This permission notice shall be included in all copies
+    or substantial portions of the Software.
+
+*/
+/*
+  Description: The look of the output, from a template file.
+
+  The template is template.json in the fyne storage folder (or the file
+  named by the "template" preference):
+
+    {
+      "font": "Arial",            Arial, Times or Courier (PDF)
+      "headerColor": "#000000",
+      "textColor": "#000000",
+      "logo": "",                 PNG or JPEG, "" is the snap logo
+      "logoPlace": "cover",       cover, header (and cover) or none
+      "header": "",               replace the preferences, if set
+      "footer": "",
+      "border": { "style": "none", "color": "#808080", "width": 0.5 }
+    }
+
+  border style "line" frames each thumbnail. a relative logo path is in
+  the folder of the template.
+*/
+
+const TemplateName = "template.json"
+
+const LogoCover = "cover"
+const LogoHeader = "header"
+const LogoNone = "none"
+
+// TemplateFonts are the fonts a PDF has without embedding them.
+var TemplateFonts = []string{"Arial", "Times", "Courier"}
+
+type Template struct {
+	Font        string `json:"font"`
+	HeaderColor string `json:"headerColor"`
+	TextColor   string `json:"textColor"`
+	Logo        string `json:"logo"`
+	LogoPlace   string `json:"logoPlace"`
+	Header      string `json:"header"`
+	Footer      string `json:"footer"`
+	Border      struct {
+		Style string  `json:"style"`
+		Color string  `json:"color"`
+		Width float64 `json:"width"`
+	} `json:"border"`
+}
+
+// DefaultLogo is the snap logo, set by main.
+var DefaultLogo *fyne.StaticResource
+
+func DefaultTemplate() *Template {
+	t := &Template{
+		Font:        "Arial",
+		HeaderColor: "#000000",
+		TextColor:   "#000000",
+		LogoPlace:   LogoCover,
+	}
+	t.Border.Style = "none"
+	t.Border.Color = "#808080"
+	t.Border.Width = 0.5
+	return t
+}
+
+// LoadTemplate reads a template file, file "" is the one in storage.
+// If that isn't there, the default is written to be edited.
+func LoadTemplate(file string) (*Template, error) {
+	if file == "" {
+		file = filepath.Join(GetSystem().Storage, TemplateName)
+		if _, err := os.Stat(file); os.IsNotExist(err) {
+			content, err := json.MarshalIndent(DefaultTemplate(), "", "  ")
+			if err == nil {
+				err = os.WriteFile(file, content, 0644)
+			}
+			return DefaultTemplate(), err
+		}
+	}
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return DefaultTemplate(), err
+	}
+	t := DefaultTemplate()
+	if err = json.Unmarshal(content, t); err != nil {
+		return DefaultTemplate(), errors.New(file + ": " + err.Error())
+	}
+	if t.Logo != "" && !filepath.IsAbs(t.Logo) {
+		t.Logo = filepath.Join(filepath.Dir(file), t.Logo)
+	}
+	// an unknown font is Arial, and an error
+	font, ok := templateFont(t.Font)
+	if !ok {
+		err = errors.New(fmt.Sprintf("%s: font %q is not %s", file, t.Font, strings.Join(TemplateFonts, ", ")))
+	}
+	t.Font = font
+	for _, c := range []string{t.HeaderColor, t.TextColor, t.Border.Color} {
+		if _, cerr := parseColor(c); cerr != nil {
+			return t, errors.New(file + ": " + cerr.Error())
+		}
+	}
+	return t, err
+}
+
+// templateFont is the name of one of the TemplateFonts, in any case.
+// "" is Arial.
+func templateFont(name string) (string, bool) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return TemplateFonts[0], true
+	}
+	for _, f := range TemplateFonts {
+		if strings.EqualFold(f, name) {
+			return f, true
+		}
+	}
+	return TemplateFonts[0], false
+}
+
+// logo is the logo file, "" if there isn't one.
+func (t *Template) logo() string {
+	if t.LogoPlace == LogoNone {
+		return ""
+	}
+	if t.Logo != "" {
+		return t.Logo
+	}
+	if DefaultLogo == nil {
+		return ""
+	}
+	path, err := getTempImagePath(DefaultLogo)
+	if err != nil {
+		return ""
+	}
+	return path
+}
+
+// rgba of a template color, black if it isn't one.
+func rgba(s string) color.RGBA {
+	c, err := parseColor(s)
+	if err != nil || strings.TrimSpace(s) == "" {
+		return color.RGBA{A: 0xFF}
+	}
+	r, g, b, a := c.RGBA()
+	return color.RGBA{R: uint8(r >> 8), G: uint8(g >> 8), B: uint8(b >> 8), A: uint8(a >> 8)}
+}
+
+// picture fits an image file in box, keeping its shape, centered.
+func picture(path string, box Rect) (*Picture, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	config, _, err := image.DecodeConfig(f)
+	_ = f.Close()
+	if err != nil {
+		return nil, err
+	}
+	if config.Width == 0 || config.Height == 0 {
+		return nil, errors.New("empty image")
+	}
+	w, h := box.W, box.W*float64(config.Height)/float64(config.Width)
+	if h > box.H {
+		w, h = box.H*float64(config.Width)/float64(config.Height), box.H
+	}
+	return &Picture{
+		Path: path,
+		Type: SniffContent(path).Image,
+		Rect: Rect{X: box.X + (box.W-w)/2, Y: box.Y + (box.H-h)/2, W: w, H: h},
+	}, nil
+}
+
+// applyTemplate sets the colors, frames and logos of the Document.
+func (d *Document) applyTemplate(t *Template) {
+	d.Font = strings.TrimSpace(t.Font)
+	header, text := rgba(t.HeaderColor), rgba(t.TextColor)
+	var logo *Picture
+	if t.LogoPlace == LogoHeader {
+		if path := t.logo(); path != "" {
+			logo, _ = picture(path, Rect{X: pageMargin, Y: pageMargin - 5, W: 30, H: 30})
+		}
+	}
+	style := func(page *Page) {
+		page.Header.Color = header
+		page.Footer.Color = text
+		for i := range page.Lines {
+			page.Lines[i].Color = text
+		}
+		if logo != nil {
+			page.Pictures = append(page.Pictures, *logo)
+		}
+		for i := range page.Cells {
+			cell := &page.Cells[i]
+			cell.Name.Color = text
+			cell.Note.Color = text
+			for j := range cell.Caption {
+				cell.Caption[j].Color = text
+			}
+			if t.Border.Style == "line" {
+				b := cell.Image
+				cell.Frame = &Frame{
+					Rect:  Rect{X: b.X - 1, Y: b.Y - 1, W: b.W + 2, H: b.H + 2},
+					Color: rgba(t.Border.Color),
+					Width: t.Border.Width,
+				}
+			}
+		}
+	}
+	for i := range d.Sections {
+		for j := range d.Sections[i].Pages {
+			style(&d.Sections[i].Pages[j])
+		}
+	}
+	for i := range d.Summary {
+		style(&d.Summary[i])
+	}
+	for i := range d.Issues {
+		style(&d.Issues[i])
+	}
+	if d.Cover != nil {
+		for i := range d.Cover.Lines {
+			d.Cover.Lines[i].Color = text
+		}
+		if len(d.Cover.Lines) > 0 { // the title
+			d.Cover.Lines[0].Color = header
+		}
+	}
+}
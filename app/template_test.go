@@ -0,0 +1,54 @@
+package app
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLoadTemplateFont(t *testing.T) {
+	tests := []struct {
+		json string
+		font string
+		err  string
+	}{
+		{`{"font": "Times"}`, "Times", ""},
+		{`{"font": " courier "}`, "Courier", ""},
+		{`{}`, "Arial", ""},
+		{`{"font": ""}`, "Arial", ""},
+		{`{"font": "Comic Sans"}`, "Arial", `font "Comic Sans"`},
+		{`{"font": "times", "textColor": "#12"}`, "Times", "#12"},
+	}
+	dir := t.TempDir()
+	for _, tt := range tests {
+		file := filepath.Join(dir, "template.json")
+		if err := os.WriteFile(file, []byte(tt.json), 0644); err != nil {
+			t.Fatal(err)
+		}
+		template, err := LoadTemplate(file)
+		if template.Font != tt.font {
+			t.Errorf("LoadTemplate(%s) font = %q, want %q", tt.json, template.Font, tt.font)
+		}
+		if tt.err == "" && err != nil || tt.err != "" && (err == nil || !strings.Contains(err.Error(), tt.err)) {
+			t.Errorf("LoadTemplate(%s) error = %v, want %q", tt.json, err, tt.err)
+		}
+	}
+}
+
+func TestJobCheckFont(t *testing.T) {
+	dir := t.TempDir()
+	template := filepath.Join(dir, "client.json")
+	if err := os.WriteFile(template, []byte(`{"font": "Wingdings"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	job := Job{Sources: []string{dir}, Output: filepath.Join(dir, "out.pdf"), Options: DefaultOptions()}
+	if problems := job.check(); len(problems) != 0 {
+		t.Fatalf("check() = %v, want none", problems)
+	}
+	job.Options.Template = template
+	problems := job.check()
+	if len(problems) != 1 || !strings.Contains(problems[0], `font "Wingdings"`) {
+		t.Errorf("check() = %v, want the font", problems)
+	}
+}
@@ -16,34 +16,98 @@ package app
 */
 
 import (
+	"context"
+	"crypto/sha256"
+	"errors"
 	"fmt"
 	"fyne.io/fyne/v2"
+	"io/fs"
 	"os"
 	"path/filepath"
-	"strings"
 )
 
-// ImageResourcePath insures a file with image data exists. internal images
-//
-//	are in fyne storage folder.
-func ImageResourcePath(dir, name string) (path string, err error) {
-	path = filepath.Join(dir, name)
-	switch strings.ToLower(filepath.Ext(path)) {
-	case ".jpg", ".jpeg", ".png", ".gif", ".bmp":
-		return
+// the built-in providers. anything else gets the icon for its type.
+func init() {
+	RegisterThumbnailProvider("image", 300, imageProvider{})
+	RegisterThumbnailProvider("audio", 200, audioProvider{})
+	RegisterThumbnailProvider("office", 100, officeProvider{})
+}
+
+// imageProvider uses the file itself, whatever its extension,
+// if it has an image the PDF can hold.
+type imageProvider struct{}
+
+func (imageProvider) Match(path string, info fs.FileInfo) bool {
+	return !info.IsDir() && ContentOf(path, info).Image != ""
+}
+func (imageProvider) Render(_ context.Context, path string, _ int) (Thumbnail, error) {
+	return Thumbnail{Path: path}, nil // typed by ThumbnailFor
+}
+
+// audioProvider uses the cover image from the ID3 tags.
+type audioProvider struct{}
+
+func (audioProvider) Match(path string, info fs.FileInfo) bool {
+	return !info.IsDir() && categoryOf(path, info) == AudioExt
+}
+func (audioProvider) Render(_ context.Context, path string, _ int) (Thumbnail, error) {
+	details, err := ID3Details(path)
+	if err != nil {
+		return Thumbnail{}, err
 	}
-	switch ExtensionType(path) {
-	case AudioExt:
-		details, err := ID3Details(path)
-		if err == nil && details.cover != nil {
-			p := details.cover.StaticName
-			details.cover.StaticName = fmt.Sprintf("%s.%s",
-				strings.TrimSuffix(p, filepath.Ext(p)),
-				details.mime)
-			return getTempImagePath(details.cover)
-		}
+	if details.cover == nil {
+		return Thumbnail{}, errors.New(fmt.Sprintf("no cover image in %s", path))
 	}
-	return getResourceImagePath(imageResourceMap[ExtensionType(path)])
+	image, err := getPreviewPath(path, details.cover.StaticContent, details.mime)
+	return Thumbnail{Path: image, Meta: details.Meta()}, err
+}
+
+// officeProvider uses the preview image of OOXML / ODF documents.
+type officeProvider struct{}
+
+func (officeProvider) Match(path string, info fs.FileInfo) bool {
+	switch categoryOf(path, info) {
+	case SheetExt, SlideExt, WordExt:
+		return !info.IsDir()
+	}
+	return false
+}
+func (officeProvider) Render(ctx context.Context, path string, size int) (Thumbnail, error) {
+	details, err := OfficeDetails(path)
+	if err != nil {
+		return Thumbnail{}, err
+	}
+	thumb := Thumbnail{Caption: details.Caption(), Meta: details.Meta()}
+	if details.cover != nil {
+		thumb.Path, err = getPreviewPath(path, details.cover.StaticContent, details.mime)
+		return thumb, err
+	}
+	// no preview, but keep the details with the family icon
+	icon, err := iconProvider{}.Render(ctx, path, size)
+	thumb.Path = icon.Path
+	return thumb, err
+}
+
+// iconProvider is the fallback: the generic image for the type of file.
+type iconProvider struct{}
+
+func (iconProvider) Match(_ string, _ fs.FileInfo) bool {
+	return true
+}
+func (iconProvider) Render(_ context.Context, path string, _ int) (Thumbnail, error) {
+	return iconThumbnail(path, ExtensionType(path))
+}
+
+func iconThumbnail(path, category string) (Thumbnail, error) {
+	if icon := GetFileTypes().Icon(path, category); icon != "" {
+		return Thumbnail{Path: icon}, nil
+	}
+	resource, ok := imageResourceMap[category]
+	if !ok { // a user's category without an icon
+		resource = imageResourceMap[UnknownExt]
+	}
+	image, err := getResourceImagePath(resource)
+	return Thumbnail{Path: image}, err
 }
 
 //  to create resource: fyne bundle -o images.go --pkg app images
@@ -53,6 +117,9 @@ const AudioExt = "audio"
 const BitmapExt = "bitMap"
 const CameraExt = "camera"
 const DocExt = "doc"
+const SheetExt = "sheet"
+const SlideExt = "slide"
+const WordExt = "word"
 const ExeExt = "exe"
 const PdfExt = "pdf"
 const ZipExt = "zip"
@@ -66,33 +133,22 @@ func ExtensionType(path string) string {
 	if err != nil {
 		return UnknownExt
 	}
+	return categoryOf(path, info)
+}
+
+// categoryOf a file by its content. The extension's is used when the
+// content is not known, or fits the extension (which may say more, as
+// a .kdc is a camera's tiff), or is only guessed (text).
+func categoryOf(path string, info fs.FileInfo) string {
 	if info.IsDir() {
 		return FolderExt
 	}
-	switch strings.ToLower(filepath.Ext(path)) {
-	case ".jpg", ".jpeg", ".png", ".gif", ".kdc", ".sfw", ".raw":
-		return CameraExt
-	case ".mp3", ".m4a", ".flac", ".wav", ".wma", ".aac", ".ogg":
-		return AudioExt
-	case ".pdf":
-		return PdfExt
-	case ".heic":
-		return AppleExt
-	case ".mp4", ".m4v", ".mov", ".wmv", ".avi", ".avchd", ".hevc",
-		".flv", ".f4v", ".swf", ".3gp", ".mpeg", ".mpg":
-		return VideoExt
-	case ".zip", ".gz", "tgz", ".gzip", ".7z", ".jar":
-		return ZipExt
-	case ".exe", ".com", ".bat", ".cmd", ".sh", ".bin":
-		return ExeExt
-	case ".bmp", ".tiff", ".tif":
-		return BitmapExt
-	case ".html", ".htm":
-		return HtmlExt
-	case ".dat", ".txt", ".csv",
-		".xls", ".xlsx", ".doc", ".docx",
-		".odt", ".ods", "odp", ".odg":
-		return DocExt
+	category := GetFileTypes().Category(path)
+	if content := ContentOf(path, info); content.Category != "" && (category == "" || !contentFits(path, content)) {
+		return content.Category
+	}
+	if category != "" {
+		return category
 	}
 	return UnknownExt
 }
@@ -105,6 +161,9 @@ var imageResourceMap = map[string]*fyne.StaticResource{
 	BitmapExt:  resourceBitmapJpg,
 	CameraExt:  resourceCameraPng,
 	DocExt:     resourceDocPng,
+	SheetExt:   resourceSheetPng,
+	SlideExt:   resourceSlidePng,
+	WordExt:    resourceWordPng,
 	ExeExt:     resourceExePng,
 	PdfExt:     resourcePdfPng,
 	ZipExt:     resourceZipPng,
@@ -124,6 +183,28 @@ func getResourceImagePath(resource *fyne.StaticResource) (string, error) {
 	}
 	return path, err
 }
+
+// getPreviewPath writes the preview image embedded in a file, named for
+// the file as it is now (so a changed file, or another with the same name,
+// is not shown with the old one).
+func getPreviewPath(path string, content []byte, mime string) (string, error) {
+	return previewFile(GetSystem().TempDir, path, content, mime)
+}
+
+func previewFile(dir, path string, content []byte, mime string) (string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", err
+	}
+	abs, _ := filepath.Abs(path)
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s\x00%d\x00%d", abs, info.Size(), info.ModTime().UnixNano())))
+	file := filepath.Join(dir, fmt.Sprintf("preview-%x.%s", sum[:8], mime))
+	if _, err = os.Stat(file); os.IsNotExist(err) {
+		err = os.WriteFile(file, content, 0644)
+	}
+	return file, err
+}
+
 func getTempImagePath(resource *fyne.StaticResource) (string, error) {
 	name := resource.StaticName
 	content := resource.StaticContent
@@ -0,0 +1,51 @@
+package app
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestPreviewFile(t *testing.T) {
+	dir, temp := t.TempDir(), t.TempDir()
+	song := filepath.Join(dir, "song.mp3")
+	if err := os.WriteFile(song, []byte("ID3 one"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	first, err := previewFile(temp, song, []byte("cover 1"), "jpeg")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if filepath.Dir(first) != temp || filepath.Ext(first) != ".jpeg" {
+		t.Errorf("preview %s", first)
+	}
+	again, _ := previewFile(temp, song, []byte("cover 1"), "jpeg")
+	if again != first {
+		t.Errorf("the same file has previews %s and %s", first, again)
+	}
+	// another file of the same name
+	other := filepath.Join(dir, "sub", "song.mp3")
+	_ = os.Mkdir(filepath.Dir(other), 0755)
+	if err = os.WriteFile(other, []byte("ID3 one"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if p, _ := previewFile(temp, other, []byte("cover 2"), "jpeg"); p == first {
+		t.Errorf("another song.mp3 has the same preview")
+	}
+	// the file is changed
+	later := time.Now().Add(time.Minute)
+	if err = os.Chtimes(song, later, later); err != nil {
+		t.Fatal(err)
+	}
+	changed, _ := previewFile(temp, song, []byte("cover 3"), "jpeg")
+	if changed == first {
+		t.Fatalf("a changed file has the old preview")
+	}
+	if content, _ := os.ReadFile(changed); string(content) != "cover 3" {
+		t.Errorf("preview has %q, want the new cover", content)
+	}
+	if _, err = previewFile(temp, filepath.Join(dir, "missing.mp3"), nil, "jpeg"); err == nil {
+		t.Errorf("a preview of a missing file")
+	}
+}
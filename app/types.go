@@ -0,0 +1,160 @@
+package app
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+/*
+
+  File:    types.go
+  Author:  Bob Shofner
+
+  MIT License - https://opensource.org/license/mit/
+
+  This permission notice shall be included in all copies
+    or substantial portions of the Software.
+
+*/
+/*
+  Description: User configurable mapping of file types and icons.
+
+  The mapping is kept in types.json in the fyne storage folder:
+
+    {
+      "extensions": { ".jpg": "camera", ".scn": "scan", ... },
+      "icons": { "scan": "scan.png", ".heic": "/home/me/heic.png" }
+    }
+
+  icons are PNG files by category or by extension (the extension wins).
+  relative icon paths are in the storage folder.
+*/
+
+const FileTypesName = "types.json"
+
+type FileTypes struct {
+	Extensions map[string]string `json:"extensions"`
+	Icons      map[string]string `json:"icons"`
+}
+
+var defaultExtensions = map[string][]string{
+	CameraExt: {".jpg", ".jpeg", ".png", ".gif", ".kdc", ".sfw", ".raw"},
+	AudioExt:  {".mp3", ".m4a", ".flac", ".wav", ".wma", ".aac", ".ogg"},
+	PdfExt:    {".pdf"},
+	AppleExt:  {".heic"},
+	VideoExt: {".mp4", ".m4v", ".mov", ".wmv", ".avi", ".avchd", ".hevc",
+		".flv", ".f4v", ".swf", ".3gp", ".mpeg", ".mpg"},
+	ZipExt:    {".zip", ".gz", ".tgz", ".gzip", ".7z", ".jar"},
+	ExeExt:    {".exe", ".com", ".bat", ".cmd", ".sh", ".bin"},
+	BitmapExt: {".bmp", ".tiff", ".tif"},
+	HtmlExt:   {".html", ".htm"},
+	SheetExt:  {".csv", ".xls", ".xlsx", ".ods"},
+	SlideExt:  {".ppt", ".pptx", ".odp"},
+	WordExt:   {".doc", ".docx", ".odt", ".rtf"},
+	DocExt:    {".dat", ".txt", ".odg"},
+}
+
+// DefaultFileTypes is the built-in mapping, with no custom icons.
+func DefaultFileTypes() *FileTypes {
+	types := &FileTypes{
+		Extensions: make(map[string]string),
+		Icons:      make(map[string]string),
+	}
+	for category, exts := range defaultExtensions {
+		for _, ext := range exts {
+			types.Extensions[ext] = category
+		}
+	}
+	return types
+}
+
+// LoadFileTypes reads the mapping from the storage folder.
+// If there isn't one, the default is written there to be edited.
+func LoadFileTypes(storage string) (*FileTypes, error) {
+	file := filepath.Join(storage, FileTypesName)
+	content, err := os.ReadFile(file)
+	if os.IsNotExist(err) {
+		types := DefaultFileTypes()
+		content, err = json.MarshalIndent(types, "", "  ")
+		if err == nil {
+			err = os.WriteFile(file, content, 0644)
+		}
+		return types, err
+	}
+	if err != nil {
+		return DefaultFileTypes(), err
+	}
+	types := &FileTypes{}
+	if err = json.Unmarshal(content, types); err != nil {
+		return DefaultFileTypes(), errors.New(file + ": " + err.Error())
+	}
+	// be forgiving of "JPG" or "tgz"
+	extensions := make(map[string]string)
+	for ext, category := range types.Extensions {
+		extensions[normalExt(ext)] = category
+	}
+	icons := make(map[string]string)
+	for name, icon := range types.Icons {
+		if !filepath.IsAbs(icon) {
+			icon = filepath.Join(storage, icon)
+		}
+		if strings.HasPrefix(name, ".") {
+			name = normalExt(name)
+		}
+		icons[name] = icon
+	}
+	types.Extensions = extensions
+	types.Icons = icons
+	return types, nil
+}
+
+func normalExt(ext string) string {
+	ext = strings.ToLower(strings.TrimSpace(ext))
+	if !strings.HasPrefix(ext, ".") {
+		ext = "." + ext
+	}
+	return ext
+}
+
+var fileTypes *FileTypes
+var fileTypesOnce sync.Once
+
+// GetFileTypes is the mapping in use, loaded once from storage.
+func GetFileTypes() *FileTypes {
+	fileTypesOnce.Do(func() {
+		var err error
+		fileTypes, err = LoadFileTypes(GetSystem().Storage)
+		if err != nil {
+			log.Printf("LoadFileTypes error: %s\n", err)
+		}
+	})
+	return fileTypes
+}
+
+// Category of a file's extension. "" if it isn't mapped.
+func (t *FileTypes) Category(path string) string {
+	ext := strings.ToLower(filepath.Ext(path))
+	if ext == "" {
+		return ""
+	}
+	return t.Extensions[ext]
+}
+
+// Icon is the user's PNG for the file, "" if there isn't one.
+func (t *FileTypes) Icon(path, category string) string {
+	icon, ok := t.Icons[strings.ToLower(filepath.Ext(path))]
+	if !ok {
+		icon, ok = t.Icons[category]
+	}
+	if ok {
+		if _, err := os.Stat(icon); err == nil {
+			return icon
+		}
+	}
+	return ""
+}
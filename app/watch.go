@@ -0,0 +1,151 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"github.com/fsnotify/fsnotify"
+	"os"
+	"sort"
+	"time"
+)
+
+/*
+
+  File:    watch.go
+  Author:  Bob Shofner
+
+  MIT License - https://opensource.org/license/mit/
+
+  This permission notice shall be included in all copies
+    or substantial portions of the Software.
+
+*/
+/*
+  Description: Make the output again when the files of the PATHs change.
+
+  The changes are collected until there have been none for Quiet, so a
+  scanner writing a page (or a copy of 100 files) is one rebuild. The
+  thumbnails of the files that didn't change are from the cache.
+*/
+
+const DefaultQuiet = 2 * time.Second
+
+type Watcher struct {
+	Dirs      []string
+	Recursive bool          // the directories under them too, as they come and go
+	Filter    Filter        // hidden directories aren't watched
+	Outputs   []string      // changes to them (and their manifests, pages, ...) are ignored
+	Quiet     time.Duration // after the last change, before the rebuild
+	Rebuild   func(changes []string)
+	Problem   func(err error)
+}
+
+// Run watches until ctx is done.
+func (w Watcher) Run(ctx context.Context) error {
+	notify, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = notify.Close()
+	}()
+	add := func(dir string) {
+		for _, d := range AllDirs([]string{dir}, w.Recursive, w.Filter) {
+			if err := notify.Add(d); err != nil {
+				w.Problem(err)
+			}
+		}
+	}
+	for _, dir := range w.Dirs {
+		add(dir)
+	}
+	quiet := w.Quiet
+	if quiet <= 0 {
+		quiet = DefaultQuiet
+	}
+	changes := make(map[string]bool)
+	timer := time.NewTimer(quiet)
+	timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil
+		case err := <-notify.Errors:
+			w.Problem(err)
+		case event, ok := <-notify.Events:
+			if !ok {
+				return nil
+			}
+			if event.Op == fsnotify.Chmod || w.isOutput(event.Name) {
+				continue
+			}
+			if event.Has(fsnotify.Create) && w.Recursive {
+				if info, err := os.Stat(event.Name); err == nil && info.IsDir() {
+					add(event.Name)
+				}
+			}
+			if event.Has(fsnotify.Rename) { // a removed directory is already forgotten
+				_ = notify.Remove(event.Name)
+			}
+			changes[event.Name] = true
+			// a tick that wasn't read yet would rebuild before quiet
+			if !timer.Stop() {
+				select {
+				case <-timer.C:
+				default:
+				}
+			}
+			timer.Reset(quiet)
+		case <-timer.C:
+			list := make([]string, 0, len(changes))
+			for c := range changes {
+				list = append(list, c)
+			}
+			sort.Strings(list)
+			changes = make(map[string]bool)
+			w.Rebuild(list)
+		}
+	}
+}
+
+// isOutput is true for the files writing the Outputs makes.
+func (w Watcher) isOutput(path string) bool {
+	for _, output := range w.Outputs {
+		if outputFile(output, path) {
+			return true
+		}
+	}
+	return false
+}
+
+// WatchJobs runs each job again when its sources change, until ctx is done.
+func WatchJobs(ctx context.Context, jobs []Job, report func(lines []string)) {
+	done := make(chan bool)
+	for _, job := range jobs {
+		job := job
+		job.Options.Force = false // the changed directories are read again
+		w := Watcher{
+			Dirs:      job.Sources,
+			Recursive: job.Recursive,
+			Filter:    job.Options.Filter,
+			Outputs:   job.Outputs(),
+			Rebuild: func(changes []string) {
+				report([]string{fmt.Sprintf("** %s: %d changes", job.Name, len(changes))})
+				_ = RunJobs([]Job{job}, report)
+			},
+			Problem: func(err error) {
+				report([]string{fmt.Sprintf("!! %s: %v", job.Name, err)})
+			},
+		}
+		go func() {
+			if err := w.Run(ctx); err != nil {
+				report([]string{fmt.Sprintf("!! %s: %v", job.Name, err)})
+			}
+			done <- true
+		}()
+	}
+	for range jobs {
+		<-done
+	}
+}
@@ -0,0 +1,131 @@
+package app
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestOutputFile(t *testing.T) {
+	dir := t.TempDir()
+	tests := []struct {
+		output string
+		path   string
+		want   bool
+	}{
+		{"sheet.pdf", "sheet.pdf", true},
+		{"sheet.pdf", "sheet.snap.json", true},
+		{"sheet.pdf", "sheet.json", true},
+		{"sheet.pdf", "sheet.csv", true},
+		{"sheet.pdf", "sheet-001.pdf", false},
+		{"sheet.pdf", "sheet-2.jpg", false},      // a photo, not the output
+		{"sheet.pdf", "sheets.pdf", false},       // not the same name
+		{"sheet.pdf", "sheet_files/a.png", true}, // if it is HTML after all
+		{"gallery.html", "gallery_files", true},
+		{"gallery.html", "gallery_files/001-0001.jpg", true},
+		{"gallery.html", "gallery_filesx/a.jpg", false},
+		{"gallery.html", "gallery.jpg", false},
+		{"page.png", "page-001.png", true},
+		{"page.png", "page-1234.png", true},
+		{"page.png", "page-01.png", false},
+		{"page.png", "page-001.jpg", false},
+		{"page.png", "sub/page-001.png", false},
+		{"page.jpg", "page-002.jpg", true},
+		{"", "sheet.pdf", false},
+	}
+	for _, tt := range tests {
+		output := tt.output
+		if output != "" {
+			output = filepath.Join(dir, output)
+		}
+		if got := outputFile(output, filepath.Join(dir, tt.path)); got != tt.want {
+			t.Errorf("outputFile(%s, %s) = %v, want %v", tt.output, tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestWatcherIgnoresOutput(t *testing.T) {
+	dir := t.TempDir()
+	rebuilt := make(chan []string, 4)
+	w := Watcher{
+		Dirs:    []string{dir},
+		Filter:  DefaultFilter(),
+		Outputs: []string{filepath.Join(dir, "sheet.png")},
+		Quiet:   100 * time.Millisecond,
+		Rebuild: func(changes []string) {
+			rebuilt <- changes
+		},
+		Problem: func(err error) {
+			t.Error(err)
+		},
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	done := make(chan bool)
+	go func() {
+		if err := w.Run(ctx); err != nil {
+			t.Error(err)
+		}
+		done <- true
+	}()
+	time.Sleep(100 * time.Millisecond) // for the watches to be added
+	for _, name := range []string{"sheet-001.png", "sheet.snap.json", "sheet-2.png", "scan.jpg"} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte("x"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	select {
+	case changes := <-rebuilt:
+		if len(changes) != 2 || changes[0] != filepath.Join(dir, "scan.jpg") || changes[1] != filepath.Join(dir, "sheet-2.png") {
+			t.Errorf("changes = %v, want scan.jpg and sheet-2.png", changes)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("no rebuild")
+	}
+	cancel()
+	<-done
+}
+
+func TestWatcherQuiet(t *testing.T) {
+	dir := t.TempDir()
+	rebuilt := make(chan []string, 4)
+	w := Watcher{
+		Dirs:   []string{dir},
+		Filter: DefaultFilter(),
+		Quiet:  300 * time.Millisecond,
+		Rebuild: func(changes []string) {
+			rebuilt <- changes
+		},
+		Problem: func(err error) {
+			t.Error(err)
+		},
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		_ = w.Run(ctx)
+	}()
+	time.Sleep(100 * time.Millisecond)
+	// changes closer than Quiet are one rebuild
+	for i := 0; i < 5; i++ {
+		if err := os.WriteFile(filepath.Join(dir, string(rune('a'+i))+".jpg"), []byte("x"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	select {
+	case changes := <-rebuilt:
+		if len(changes) != 5 {
+			t.Errorf("changes = %v, want all 5", changes)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("no rebuild")
+	}
+	select {
+	case changes := <-rebuilt:
+		t.Errorf("another rebuild, of %v", changes)
+	case <-time.After(500 * time.Millisecond):
+	}
+}
@@ -0,0 +1,156 @@
+package element
+
+import (
+	"os"
+	"path/filepath"
+	"snap/fileutil"
+	"sort"
+	"strings"
+)
+
+/*
+
+  File:    complete.go
+  Author:  Bob Shofner
+
+  MIT License - https://opensource.org/license/mit/
+
+  This permission notice shall be included in all copies
+    or substantial portions of the Software.
+
+*/
+/*
+  Description: Tab completion in the Console.
+
+  The first word is completed from the Commands, the others as paths.
+  One match replaces the word; with more, as much as they share is put
+  in, and they are listed.
+*/
+
+const completeMax = 60 // matches listed
+
+func (c *Console) complete() {
+	line, matches := completeLine(c.entry.Text, func(word string, command bool) []string {
+		if command {
+			return prefixed(c.Commands, word)
+		}
+		return pathMatches(word)
+	})
+	if len(matches) > 1 {
+		names := make([]string, 0, len(matches))
+		for i, m := range matches {
+			if i == completeMax {
+				names = append(names, "...")
+				break
+			}
+			name := filepath.Base(m)
+			if strings.HasSuffix(m, string(filepath.Separator)) {
+				name += string(filepath.Separator)
+			}
+			names = append(names, name)
+		}
+		c.Speak(strings.Join(names, "  "))
+	}
+	if len(matches) > 0 {
+		c.entry.setText(line)
+	}
+}
+
+// completeLine completes the last word of text (split as SplitWords
+// does, so the commands read it the same) from what match finds for it,
+// the first word being a command. The word is typed again in its quote,
+// or with \ escapes if it had them.
+func completeLine(text string, match func(word string, command bool) []string) (string, []string) {
+	words, open := SplitWords(text)
+	word := Word{Start: len(text)} // after a space, a new word
+	if n := len(words); n > 0 && (open != 0 || words[n-1].End == len(text)) {
+		word = words[n-1]
+	}
+	matches := match(word.Text, strings.TrimSpace(text[:word.Start]) == "")
+	if len(matches) == 0 {
+		return text, nil
+	}
+	done := matches[0]
+	if len(matches) > 1 {
+		done = commonPrefix(matches)
+	}
+	quote := word.Quote
+	if quote == 0 && !word.Escaped && strings.ContainsAny(done, " '") ||
+		quote == '\'' && strings.ContainsRune(done, '\'') {
+		quote = '"'
+	}
+	typed := QuoteWord(done, quote)
+	if quote != 0 {
+		typed = string(quote) + typed
+	}
+	// a whole name is followed by a space, a directory may go on
+	if len(matches) == 1 && !strings.HasSuffix(done, string(filepath.Separator)) {
+		if quote != 0 {
+			typed += string(quote)
+		}
+		typed += " "
+	}
+	return text[:word.Start] + typed, matches
+}
+
+func prefixed(list []string, prefix string) []string {
+	matches := make([]string, 0)
+	for _, s := range list {
+		if strings.HasPrefix(s, prefix) {
+			matches = append(matches, s)
+		}
+	}
+	return matches
+}
+
+// pathMatches are the paths that word starts, directories ending in a
+// separator. ~ is kept as typed.
+func pathMatches(word string) []string {
+	path, home := word, ""
+	if word == "~" || strings.HasPrefix(word, "~/") || strings.HasPrefix(word, `~\`) {
+		if h, err := os.UserHomeDir(); err == nil {
+			home = h
+			path = h + word[1:]
+			if word == "~" {
+				path += string(filepath.Separator)
+			}
+		}
+	}
+	dir, base := filepath.Split(path)
+	if dir == "" {
+		dir = "."
+	}
+	contents, err := fileutil.PathContents(dir)
+	if err != nil {
+		return nil
+	}
+	typedDir := path[:len(path)-len(base)]
+	matches := make([]string, 0)
+	for _, p := range contents {
+		name := filepath.Base(p)
+		if !strings.HasPrefix(name, base) || strings.HasPrefix(name, ".") && !strings.HasPrefix(base, ".") {
+			continue
+		}
+		match := typedDir + name
+		if info, err := os.Stat(p); err == nil && info.IsDir() {
+			match += string(filepath.Separator)
+		}
+		if home != "" {
+			match = "~" + strings.TrimPrefix(match, home)
+		}
+		matches = append(matches, match)
+	}
+	sort.Strings(matches)
+	return matches
+}
+
+func commonPrefix(list []string) string {
+	prefix := list[0]
+	for _, s := range list[1:] {
+		for !strings.HasPrefix(s, prefix) {
+			r := []rune(prefix)
+			prefix = string(r[:len(r)-1])
+		}
+	}
+	return prefix
+}
@@ -0,0 +1,102 @@
+package element
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestCommonPrefix(t *testing.T) {
+	tests := []struct {
+		list []string
+		want string
+	}{
+		{[]string{"photos"}, "photos"},
+		{[]string{"photos", "photo", "phone"}, "pho"},
+		{[]string{"abc", "xyz"}, ""},
+		{[]string{"café", "cafés", "cafe"}, "caf"},
+	}
+	for _, tt := range tests {
+		if got := commonPrefix(tt.list); got != tt.want {
+			t.Errorf("commonPrefix(%q) = %q, want %q", tt.list, got, tt.want)
+		}
+	}
+}
+
+func TestCompleteLine(t *testing.T) {
+	sep := string(filepath.Separator)
+	names := []string{"My Docs" + sep, "Music" + sep, "notes.txt", "it's.txt"}
+	commands := []string{"cd", "clear", "pdf"}
+	var asked string
+	match := func(word string, command bool) []string {
+		asked = word
+		if command {
+			return prefixed(commands, word)
+		}
+		base := word[strings.LastIndex(word, sep)+1:]
+		dir := word[:len(word)-len(base)]
+		matches := make([]string, 0)
+		for _, name := range names {
+			if strings.HasPrefix(name, base) {
+				matches = append(matches, dir+name)
+			}
+		}
+		return matches
+	}
+	tests := []struct {
+		text   string
+		want   string
+		asked  string
+		listed int
+	}{
+		{"p", "pdf ", "p", 0},
+		{"c", "c", "c", 2},
+		{"cd ", "cd ", "", 4},
+		{"cd M", "cd M", "M", 2},
+		{"cd My", `cd "My Docs` + sep, "My", 0},
+		{`cd My\ `, `cd My\ Docs` + sep, "My ", 0},
+		{`cd ~` + sep + `My\ Docs` + sep + "n", `cd ~` + sep + `My\ Docs` + sep + "notes.txt ", "~" + sep + "My Docs" + sep + "n", 0},
+		{`cd "My`, `cd "My Docs` + sep, "My", 0},
+		{`cd "My Docs` + sep + `no`, `cd "My Docs` + sep + `notes.txt" `, "My Docs" + sep + "no", 0},
+		{`cd 'My Docs` + sep + `i`, `cd "My Docs` + sep + `it's.txt" `, "My Docs" + sep + "i", 0},
+		{"cd i", `cd "it's.txt" `, "i", 0},
+		{"cd x", "cd x", "x", 0},
+	}
+	for _, tt := range tests {
+		got, matches := completeLine(tt.text, match)
+		if got != tt.want || asked != tt.asked {
+			t.Errorf("completeLine(%q) = %q for %q, want %q for %q", tt.text, got, asked, tt.want, tt.asked)
+		}
+		if listed := len(matches); listed > 1 && listed != tt.listed {
+			t.Errorf("completeLine(%q) matched %q", tt.text, matches)
+		}
+		// the commands read the completed word as it was matched
+		if words, open := SplitWords(got); open == 0 && len(matches) == 1 {
+			if last := words[len(words)-1].Text; last != matches[0] {
+				t.Errorf("%q is read as %q, not %q", got, last, matches[0])
+			}
+		}
+	}
+}
+
+func TestPathMatches(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"a b.txt", "ab.txt", ".hidden"} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte("x"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.Mkdir(filepath.Join(dir, "abc"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	sep := string(filepath.Separator)
+	want := []string{dir + sep + "a b.txt", dir + sep + "ab.txt", dir + sep + "abc" + sep}
+	if got := pathMatches(dir + sep + "a"); !reflect.DeepEqual(got, want) {
+		t.Errorf("pathMatches(a) = %q, want %q", got, want)
+	}
+	if got := pathMatches(dir + sep + "."); len(got) != 1 || got[0] != dir+sep+".hidden" {
+		t.Errorf("pathMatches(.) = %q, want .hidden", got)
+	}
+}
@@ -2,11 +2,10 @@ package element
 
 import (
 	"fyne.io/fyne/v2"
-	"fyne.io/fyne/v2/canvas"
 	"fyne.io/fyne/v2/container"
-	"fyne.io/fyne/v2/theme"
 	"fyne.io/fyne/v2/widget"
 	"strings"
+	"sync"
 )
 
 /*
@@ -25,56 +24,99 @@ import (
 */
 
 type Console struct {
-	Content *fyne.Container
-	Buttons []*widget.Button
-	Focus   func()
-	wait    chan string
-	view    *fyne.Container
-	row     int // current row
-	rowMax  int
-	entry   *widget.Entry
-	prompt  string
-	font    fyne.TextStyle
+	Content  *fyne.Container
+	Buttons  []*widget.Button
+	Focus    func()
+	Commands []string // completed by the tab key
+	wait     chan string
+	window   fyne.Window
+	view     *fyne.Container
+	scroll   *container.Scroll
+	mu       sync.Mutex
+	rows     []*consoleRow // the scrollback
+	first    int           // serial of rows[0]
+	anchor   int           // serial of the first line selected, -1 if none
+	end      int           // and the last
+	find     *find
+	progress *consoleRow // the last Progress line
+	entry    *consoleEntry
+	label    *widget.Label
+	prompt   string
+	font     fyne.TextStyle
+	history  *history
+	search   *search
 }
 
 var Prompt = ">> "
 
-func NewConsole(window fyne.Window, command string, rowMax int) *Console {
+func NewConsole(window fyne.Window, command string) *Console {
 	console := Console{
 		Content: nil,
 		wait:    make(chan string),
+		window:  window,
 		view:    nil,
-		row:     0,
-		rowMax:  rowMax,
-		entry:   widget.NewEntry(),
+		rows:    make([]*consoleRow, 0),
+		anchor:  -1,
+		end:     -1,
 		prompt:  Prompt,
 		font:    fyne.TextStyle{Monospace: true},
 	}
+	console.entry = newConsoleEntry(&console)
 	console.entry.PlaceHolder = command
-	p := widget.NewLabel(Prompt)
-	p.TextStyle = fyne.TextStyle{Bold: true}
-	bottom := container.NewBorder(nil, nil, p, nil, console.entry)
+	console.label = widget.NewLabel(Prompt)
+	console.label.TextStyle = fyne.TextStyle{Bold: true}
+	bottom := container.NewBorder(nil, nil, console.label, nil, console.entry)
 	console.view = container.NewVBox()
-	console.Content = container.NewBorder(nil, bottom, nil, nil, console.view)
+	console.scroll = container.NewVScroll(console.view)
+	console.Content = container.NewBorder(nil, bottom, nil, nil, console.scroll)
 	console.Focus = func() { window.Canvas().Focus(console.entry) }
 	return &console
 }
 func (c *Console) speakResponse(required string) {
 	c.Speak("\n" + c.prompt + required)
 }
+
+// Ask for a line. "/text" is not a response, it finds text (see scrollback.go).
 func (c *Console) Ask(required string) (b string) {
 	c.entry.SetText("")
 	c.entry.OnSubmitted = func(response string) {
+		if strings.HasPrefix(response, "/") {
+			if c.history != nil {
+				c.history.add(response)
+			}
+			c.entry.SetText("")
+			c.Find(response[1:])
+			return
+		}
 		if response == "" && required != "" {
 			c.speakResponse(required)
 		} else {
 			c.speakResponse(response)
+			if c.history != nil {
+				c.history.add(response)
+			}
 			c.wait <- response
 		}
 	}
 	b = <-c.wait
 	return
 }
+
+// AskPassword hides what is typed, and doesn't show it.
+func (c *Console) AskPassword(prompt string) (b string) {
+	c.Speak(prompt)
+	c.entry.Password = true
+	c.entry.SetText("")
+	c.entry.OnSubmitted = func(response string) {
+		c.speakResponse("********")
+		c.wait <- response
+	}
+	c.Focus()
+	b = <-c.wait
+	c.entry.Password = false
+	c.entry.SetText("")
+	return
+}
 func (c *Console) AskYesNo(required string) (b bool) {
 	c.entry.SetText("")
 	c.entry.OnSubmitted = func(response string) {
@@ -100,21 +142,5 @@ func (c *Console) Speak(txt string) {
 	if txt == "" {
 		return
 	}
-	lines := strings.Split(txt, "\n")
-	if len(lines) > c.rowMax {
-		lines = lines[len(lines)-c.rowMax:]
-	}
-	avail := c.rowMax - c.row
-	if avail < len(lines) {
-		// remove from top
-		c.view.Objects = c.view.Objects[len(lines)-avail:]
-		c.row = c.rowMax - len(lines)
-	}
-	for _, line := range lines {
-		t := canvas.NewText(line, theme.ForegroundColor())
-		t.TextStyle = c.font
-		c.view.Objects = append(c.view.Objects, t)
-		c.row++
-	}
-	c.view.Refresh()
+	c.add(levelPlain, txt, nil)
 }
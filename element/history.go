@@ -0,0 +1,238 @@
+package element
+
+import (
+	"fmt"
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/widget"
+	"os"
+	"strings"
+)
+
+/*
+
+  File:    history.go
+  Author:  Bob Shofner
+
+  MIT License - https://opensource.org/license/mit/
+
+  This permission notice shall be included in all copies
+    or substantial portions of the Software.
+
+*/
+/*
+  Description: The keys of the Console's entry.
+
+  Up and Down move through the commands typed before (kept in a file for
+  the next time). Ctrl-R searches back through them as you type: Ctrl-R
+  again finds an older one, Enter runs it, Esc goes back, and any other
+  key keeps it to be changed. Tab completes (see complete.go). Ctrl-C
+  copies the lines selected (see scrollback.go).
+*/
+
+const historyMax = 500
+
+// consoleEntry is the Entry of the Console, with its own keys.
+type consoleEntry struct {
+	widget.Entry
+	console *Console
+}
+
+func newConsoleEntry(c *Console) *consoleEntry {
+	e := &consoleEntry{console: c}
+	e.ExtendBaseWidget(e)
+	return e
+}
+
+// AcceptsTab is true, the tab key completes.
+func (e *consoleEntry) AcceptsTab() bool {
+	return true
+}
+
+func (e *consoleEntry) TypedKey(key *fyne.KeyEvent) {
+	if !e.Password && e.console.typedKey(key) {
+		return
+	}
+	e.Entry.TypedKey(key)
+}
+
+func (e *consoleEntry) TypedRune(r rune) {
+	if e.console.search != nil {
+		e.console.searchRune(r)
+		return
+	}
+	e.Entry.TypedRune(r)
+}
+
+func (e *consoleEntry) TypedShortcut(s fyne.Shortcut) {
+	if cs, ok := s.(*desktop.CustomShortcut); ok && !e.Password &&
+		cs.KeyName == fyne.KeyR && cs.Modifier == fyne.KeyModifierControl {
+		e.console.reverseSearch()
+		return
+	}
+	if _, ok := s.(*fyne.ShortcutCopy); ok && e.SelectedText() == "" && e.console.Copy() {
+		return
+	}
+	e.Entry.TypedShortcut(s)
+}
+
+// setText puts the cursor at the end.
+func (e *consoleEntry) setText(text string) {
+	e.SetText(text)
+	e.CursorColumn = len([]rune(text))
+	e.Refresh()
+}
+
+// history of the commands typed.
+type history struct {
+	file  string
+	lines []string
+	pos   int    // of the line shown, len(lines) for the one being typed
+	typed string // the one being typed, while moving
+}
+
+// search is a reverse search of the history.
+type search struct {
+	query string
+	at    int    // the line found, len(lines) if none yet
+	typed string // to go back to
+}
+
+// SetHistory reads the commands typed before from file, and keeps them there.
+func (c *Console) SetHistory(file string) {
+	h := &history{file: file, lines: make([]string, 0)}
+	if content, err := os.ReadFile(file); err == nil {
+		for _, line := range strings.Split(string(content), "\n") {
+			if line = strings.TrimRight(line, "\r"); line != "" {
+				h.lines = append(h.lines, line)
+			}
+		}
+	}
+	h.pos = len(h.lines)
+	c.history = h
+}
+
+func (h *history) add(line string) {
+	h.pos = len(h.lines)
+	if strings.TrimSpace(line) == "" || len(h.lines) > 0 && h.lines[len(h.lines)-1] == line {
+		return
+	}
+	h.lines = append(h.lines, line)
+	if len(h.lines) > historyMax {
+		h.lines = h.lines[len(h.lines)-historyMax:]
+	}
+	h.pos = len(h.lines)
+	if h.file != "" {
+		_ = os.WriteFile(h.file, []byte(strings.Join(h.lines, "\n")+"\n"), 0644)
+	}
+}
+
+// move up (-1) or down (+1), from the line being typed.
+func (h *history) move(by int, current string) (string, bool) {
+	if h.pos == len(h.lines) {
+		h.typed = current
+	}
+	pos := h.pos + by
+	if pos < 0 || pos > len(h.lines) {
+		return "", false
+	}
+	h.pos = pos
+	if pos == len(h.lines) {
+		return h.typed, true
+	}
+	return h.lines[pos], true
+}
+
+// find the newest line before "before" with query in it, -1 if none.
+func (h *history) find(query string, before int) int {
+	for i := before - 1; i >= 0; i-- {
+		if strings.Contains(h.lines[i], query) {
+			return i
+		}
+	}
+	return -1
+}
+
+// typedKey is true if the key was for the history, or completion.
+func (c *Console) typedKey(key *fyne.KeyEvent) bool {
+	if c.search != nil {
+		switch key.Name {
+		case fyne.KeyEscape:
+			c.entry.setText(c.search.typed)
+			c.endSearch()
+			return true
+		case fyne.KeyBackspace:
+			if q := []rune(c.search.query); len(q) > 0 {
+				c.search.query = string(q[:len(q)-1])
+			}
+			c.search.at = len(c.history.lines)
+			c.findNext()
+			return true
+		}
+		c.endSearch() // keep what was found, the key is for it
+		return false
+	}
+	switch key.Name {
+	case fyne.KeyUp, fyne.KeyDown:
+		if c.history == nil {
+			return false
+		}
+		by := 1
+		if key.Name == fyne.KeyUp {
+			by = -1
+		}
+		if line, ok := c.history.move(by, c.entry.Text); ok {
+			c.entry.setText(line)
+		}
+		return true
+	case fyne.KeyTab:
+		c.complete()
+		return true
+	}
+	return false
+}
+
+func (c *Console) reverseSearch() {
+	if c.history == nil {
+		return
+	}
+	if c.search == nil {
+		c.search = &search{at: len(c.history.lines), typed: c.entry.Text}
+	}
+	c.findNext()
+}
+
+func (c *Console) searchRune(r rune) {
+	c.search.query += string(r)
+	if c.search.at < len(c.history.lines) && strings.Contains(c.history.lines[c.search.at], c.search.query) {
+		c.showSearch(true) // still found
+		return
+	}
+	c.search.at = len(c.history.lines)
+	c.findNext()
+}
+
+// findNext finds an older line, and shows it.
+func (c *Console) findNext() {
+	if i := c.history.find(c.search.query, c.search.at); i >= 0 {
+		c.search.at = i
+		c.entry.setText(c.history.lines[i])
+		c.showSearch(true)
+		return
+	}
+	c.showSearch(false)
+}
+
+func (c *Console) showSearch(found bool) {
+	if found {
+		c.label.SetText(fmt.Sprintf("(search `%s') ", c.search.query))
+	} else {
+		c.label.SetText(fmt.Sprintf("(failed search `%s') ", c.search.query))
+	}
+}
+
+func (c *Console) endSearch() {
+	c.search = nil
+	c.history.pos = len(c.history.lines)
+	c.label.SetText(c.prompt)
+}
@@ -0,0 +1,80 @@
+package element
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestHistoryMove(t *testing.T) {
+	h := &history{lines: []string{"one", "two", "three"}, pos: 3}
+	moves := []struct {
+		by   int
+		want string
+		ok   bool
+	}{
+		{-1, "three", true},
+		{-1, "two", true},
+		{-1, "one", true},
+		{-1, "", false}, // at the oldest
+		{1, "two", true},
+		{1, "three", true},
+		{1, "typing", true}, // back to the line being typed
+		{1, "", false},
+	}
+	for i, m := range moves {
+		got, ok := h.move(m.by, "typing")
+		if got != m.want || ok != m.ok {
+			t.Errorf("move %d (%d) = %q, %v, want %q, %v", i, m.by, got, ok, m.want, m.ok)
+		}
+	}
+	if h.pos != 3 {
+		t.Errorf("pos = %d after moving back, want 3", h.pos)
+	}
+}
+
+func TestHistoryFind(t *testing.T) {
+	h := &history{lines: []string{"cd photos", "pdf out.pdf", "cd docs", "ls"}}
+	tests := []struct {
+		query  string
+		before int
+		want   int
+	}{
+		{"cd", 4, 2},
+		{"cd", 2, 0},
+		{"cd", 0, -1},
+		{"pdf", 4, 1},
+		{"", 4, 3},
+		{"missing", 4, -1},
+	}
+	for _, tt := range tests {
+		if got := h.find(tt.query, tt.before); got != tt.want {
+			t.Errorf("find(%q, %d) = %d, want %d", tt.query, tt.before, got, tt.want)
+		}
+	}
+}
+
+func TestHistoryAdd(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "history")
+	c := &Console{}
+	c.SetHistory(file)
+	for _, line := range []string{"ls", "ls", " ", "cd x"} {
+		c.history.add(line)
+	}
+	if len(c.history.lines) != 2 || c.history.pos != 2 {
+		t.Errorf("lines = %q, pos %d, want ls and cd x", c.history.lines, c.history.pos)
+	}
+	c.SetHistory(file)
+	if len(c.history.lines) != 2 || c.history.lines[1] != "cd x" {
+		t.Errorf("read back %q", c.history.lines)
+	}
+	for i := 0; i < historyMax+10; i++ {
+		c.history.add(string(rune('a' + i%20)))
+	}
+	if len(c.history.lines) != historyMax {
+		t.Errorf("%d lines kept, want %d", len(c.history.lines), historyMax)
+	}
+	if _, err := os.Stat(file); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,197 @@
+package element
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+	"image/color"
+	"strings"
+)
+
+/*
+
+  File:    levels.go
+  Author:  Bob Shofner
+
+  MIT License - https://opensource.org/license/mit/
+
+  This permission notice shall be included in all copies
+    or substantial portions of the Software.
+
+*/
+/*
+  Description: What the Console says, by how much it matters.
+
+  Info, Success, Warn and Error lines have an icon, and the theme's color
+  for them. With ScsiMonochrome they are all in the foreground color, the
+  icon tells them apart (and warnings and errors are bold).
+
+  Progress changes its line while it is the last one, a new one is
+  started after anything else is said.
+
+  An Error may have details, hidden until its arrow is clicked.
+*/
+
+type level int
+
+const (
+	levelPlain level = iota // Speak
+	levelInfo
+	levelSuccess
+	levelWarn
+	levelError
+	levelProgress
+	levelDetail // of an Error
+)
+
+// Info is something to know.
+func (c *Console) Info(txt string) {
+	c.add(levelInfo, txt, nil)
+}
+
+// Success is something done.
+func (c *Console) Success(txt string) {
+	c.add(levelSuccess, txt, nil)
+}
+
+// Warn of something not as it should be, that went on.
+func (c *Console) Warn(txt string) {
+	c.add(levelWarn, txt, nil)
+}
+
+// Error is something that didn't get done, and why.
+func (c *Console) Error(txt string, details ...string) {
+	c.add(levelError, txt, details)
+}
+
+// Progress of something being done.
+func (c *Console) Progress(txt string) {
+	c.mu.Lock()
+	if p := c.progress; p != nil && len(c.rows) > 0 && c.rows[len(c.rows)-1] == p {
+		p.text.Text = txt
+		p.back.SetMinSize(p.text.MinSize())
+		p.obj.Refresh() // only its row, the others are as they were
+		c.mu.Unlock()
+		return
+	}
+	c.mu.Unlock()
+	c.add(levelProgress, txt, nil)
+}
+
+func (l level) color() color.Color {
+	if ScsiMonochrome {
+		return theme.ForegroundColor()
+	}
+	switch l {
+	case levelSuccess:
+		return theme.SuccessColor()
+	case levelWarn:
+		return theme.WarningColor()
+	case levelError:
+		return theme.ErrorColor()
+	}
+	return theme.ForegroundColor()
+}
+
+func (l level) style(font fyne.TextStyle) fyne.TextStyle {
+	if ScsiMonochrome && (l == levelWarn || l == levelError) {
+		font.Bold = true
+	}
+	return font
+}
+
+// icon of the level, nil if none.
+func (l level) icon() fyne.Resource {
+	switch l {
+	case levelInfo:
+		return theme.InfoIcon()
+	case levelSuccess:
+		if ScsiMonochrome {
+			return theme.ConfirmIcon()
+		}
+		return theme.NewSuccessThemedResource(theme.ConfirmIcon())
+	case levelWarn:
+		if ScsiMonochrome {
+			return theme.WarningIcon()
+		}
+		return theme.NewWarningThemedResource(theme.WarningIcon())
+	case levelError:
+		if ScsiMonochrome {
+			return theme.ErrorIcon()
+		}
+		return theme.NewErrorThemedResource(theme.ErrorIcon())
+	case levelProgress:
+		return theme.ViewRefreshIcon()
+	}
+	return nil
+}
+
+// prefix of the level's lines when copied or saved, without the colors.
+func (l level) prefix() string {
+	switch l {
+	case levelWarn:
+		return "Warning: "
+	case levelError:
+		return "Error: "
+	case levelDetail:
+		return "    "
+	}
+	return ""
+}
+
+// expand shows and hides the details of an Error.
+func (c *Console) expand(r *consoleRow) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.showDetails(r, !r.details[0].obj.Visible())
+	c.view.Refresh()
+}
+
+func (c *Console) showDetails(r *consoleRow, show bool) {
+	for _, d := range r.details {
+		if show {
+			d.obj.Show()
+		} else {
+			d.obj.Hide()
+		}
+	}
+	if show {
+		r.arrow.image.Resource = theme.MenuDropDownIcon()
+	} else {
+		r.arrow.image.Resource = theme.MenuExpandIcon()
+	}
+	r.arrow.image.Refresh()
+}
+
+// arrow is a small icon that is tapped.
+type arrow struct {
+	widget.BaseWidget
+	image *canvas.Image
+	onTap func()
+}
+
+func newArrow(size float32, onTap func()) *arrow {
+	a := &arrow{image: canvas.NewImageFromResource(theme.MenuExpandIcon()), onTap: onTap}
+	a.image.FillMode = canvas.ImageFillContain
+	a.image.SetMinSize(fyne.NewSize(size, size))
+	a.ExtendBaseWidget(a)
+	return a
+}
+
+func (a *arrow) CreateRenderer() fyne.WidgetRenderer {
+	return widget.NewSimpleRenderer(a.image)
+}
+
+func (a *arrow) Tapped(*fyne.PointEvent) {
+	a.onTap()
+}
+
+// splitLines of each of the texts.
+func splitLines(texts []string) []string {
+	lines := make([]string, 0, len(texts))
+	for _, t := range texts {
+		lines = append(lines, strings.Split(t, "\n")...)
+	}
+	return lines
+}
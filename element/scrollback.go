@@ -0,0 +1,271 @@
+package element
+
+import (
+	"fmt"
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/theme"
+	"image/color"
+	"os"
+	"snap/element/tappable"
+	"strings"
+)
+
+/*
+
+  File:    scrollback.go
+  Author:  Bob Shofner
+
+  MIT License - https://opensource.org/license/mit/
+
+  This permission notice shall be included in all copies
+    or substantial portions of the Software.
+
+*/
+/*
+  Description: The lines spoken in the Console.
+
+  The last scrollbackMax lines are kept, in a scroll that takes the
+  height of the window.
+
+  "/text" shows the lines with text in them (any case), and scrolls to the
+  newest. "/text" again goes to an older one, "/" alone ends the search.
+
+  A click selects a line, a right click selects the lines from there to
+  it. Ctrl-C copies them (unless text of the entry is selected).
+*/
+
+const scrollbackMax = 2000
+
+// consoleRow is a line of the scrollback.
+type consoleRow struct {
+	level   level
+	back    *canvas.Rectangle // selected
+	text    *canvas.Text
+	obj     fyne.CanvasObject
+	arrow   *arrow        // of an Error with details
+	details []*consoleRow // of an Error
+	owner   *consoleRow   // of a detail
+}
+
+// find is a search of the scrollback.
+type find struct {
+	query string // lower case
+	at    int    // serial of the row shown, past the last if none yet
+}
+
+// newRow for the line numbered serial.
+func (c *Console) newRow(serial int, line string, l level) *consoleRow {
+	t := canvas.NewText(line, l.color())
+	t.TextStyle = l.style(c.font)
+	b := canvas.NewRectangle(theme.SelectionColor())
+	b.Hidden = true
+	b.SetMinSize(t.MinSize())
+	tap := tappable.NewText(t, c.tapped)
+	tap.ID = serial
+	r := &consoleRow{level: l, back: b, text: t}
+	if l == levelPlain {
+		r.obj = container.NewStack(b, tap)
+		return r
+	}
+	size := t.MinSize().Height
+	left := container.NewHBox()
+	if res := l.icon(); res != nil {
+		icon := canvas.NewImageFromResource(res)
+		icon.FillMode = canvas.ImageFillContain
+		icon.SetMinSize(fyne.NewSize(size, size))
+		left.Add(icon)
+	} else { // a detail, under the text of its Error
+		space := canvas.NewRectangle(color.Transparent)
+		space.SetMinSize(fyne.NewSize(size*2, size))
+		left.Add(space)
+	}
+	if l == levelError {
+		r.arrow = newArrow(size, func() { c.expand(r) })
+		r.arrow.Hide()
+		left.Add(r.arrow)
+	}
+	r.obj = container.NewStack(b, container.NewBorder(nil, nil, left, nil, tap))
+	return r
+}
+
+// line as copied or saved.
+func (r *consoleRow) line() string {
+	return r.level.prefix() + r.text.Text
+}
+
+// add the lines of txt to the scrollback, dropping the oldest. The
+// details are for the last of them, hidden.
+func (c *Console) add(l level, txt string, details []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	before := len(c.rows)
+	var r *consoleRow
+	for _, line := range strings.Split(txt, "\n") {
+		r = c.row(line, l)
+	}
+	if l == levelProgress {
+		c.progress = r
+	}
+	if l == levelError && len(details) > 0 {
+		for _, line := range splitLines(details) {
+			d := c.row(line, levelDetail)
+			d.owner = r
+			d.obj.Hide()
+			r.details = append(r.details, d)
+		}
+		r.arrow.Show()
+	}
+	objects := c.view.Objects
+	for _, r := range c.rows[before:] {
+		objects = append(objects, r.obj)
+	}
+	if n := len(c.rows) - scrollbackMax; n > 0 {
+		c.rows = c.rows[n:]
+		c.first += n
+		objects = objects[n:]
+	}
+	// the rows before are moved, not refreshed: only the new ones are drawn
+	c.view.Objects = objects
+	c.view.Layout.Layout(objects, c.view.Size())
+	canvas.Refresh(c.view)
+	c.scroll.ScrollToBottom()
+}
+
+// row is a new last row.
+func (c *Console) row(line string, l level) *consoleRow {
+	serial := c.first + len(c.rows)
+	r := c.newRow(serial, line, l)
+	c.paint(r, serial)
+	c.rows = append(c.rows, r)
+	return r
+}
+
+// paint the row numbered serial as found and selected.
+func (c *Console) paint(r *consoleRow, serial int) {
+	r.text.Color = r.level.color()
+	if c.find != nil && strings.Contains(strings.ToLower(r.text.Text), c.find.query) {
+		r.text.Color = theme.PrimaryColor()
+	}
+	r.back.Hidden = !c.selected(serial)
+}
+
+func (c *Console) selected(serial int) bool {
+	if c.anchor < 0 {
+		return false
+	}
+	from, to := c.anchor, c.end
+	if from > to {
+		from, to = to, from
+	}
+	return serial >= from && serial <= to
+}
+
+// repaint all of the rows.
+func (c *Console) repaint() {
+	for i, r := range c.rows {
+		c.paint(r, c.first+i)
+		r.text.Refresh()
+		r.back.Refresh()
+	}
+}
+
+func (c *Console) tapped(kind tappable.Tapper, serial int, _ *fyne.PointEvent) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	switch {
+	case kind == tappable.Secondary && c.anchor >= 0:
+		c.end = serial
+	case c.anchor == serial && c.end == serial:
+		c.anchor, c.end = -1, -1
+	default:
+		c.anchor, c.end = serial, serial
+	}
+	c.repaint()
+	c.Focus()
+}
+
+// Selected lines, in order.
+func (c *Console) Selected() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	lines := make([]string, 0)
+	for i, r := range c.rows {
+		if c.selected(c.first + i) {
+			lines = append(lines, r.line())
+		}
+	}
+	return lines
+}
+
+// Copy the selected lines to the clipboard. False if there are none.
+func (c *Console) Copy() bool {
+	lines := c.Selected()
+	if len(lines) == 0 {
+		return false
+	}
+	c.window.Clipboard().SetContent(strings.Join(lines, "\n"))
+	return true
+}
+
+// Transcript is all of the lines kept.
+func (c *Console) Transcript() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	lines := make([]string, len(c.rows))
+	for i, r := range c.rows {
+		lines[i] = r.line()
+	}
+	return lines
+}
+
+// SaveTranscript writes the lines kept to file.
+func (c *Console) SaveTranscript(file string) error {
+	return os.WriteFile(file, []byte(strings.Join(c.Transcript(), "\n")+"\n"), 0644)
+}
+
+// Find the lines with query in them, see the Description.
+func (c *Console) Find(query string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	query = strings.ToLower(query)
+	if query == "" {
+		c.find = nil
+		c.repaint()
+		c.label.SetText(c.prompt)
+		return
+	}
+	if c.find == nil || c.find.query != query {
+		c.find = &find{query: query, at: c.first + len(c.rows)}
+		c.repaint()
+	}
+	found, count, newest := -1, 0, -1
+	for i := len(c.rows) - 1; i >= 0; i-- {
+		if !strings.Contains(strings.ToLower(c.rows[i].text.Text), query) {
+			continue
+		}
+		count++
+		if newest < 0 {
+			newest = i
+		}
+		if found < 0 && c.first+i < c.find.at {
+			found = i
+		}
+	}
+	if found < 0 {
+		found = newest // around again
+	}
+	if found < 0 {
+		c.label.SetText(fmt.Sprintf("(not found `%s') ", query))
+		return
+	}
+	c.find.at = c.first + found
+	if owner := c.rows[found].owner; owner != nil && !c.rows[found].obj.Visible() {
+		c.showDetails(owner, true)
+		c.view.Refresh()
+	}
+	c.label.SetText(fmt.Sprintf("(%d found `%s') ", count, query))
+	c.scroll.Offset.Y = c.rows[found].obj.Position().Y
+	c.scroll.Refresh()
+}
@@ -0,0 +1,50 @@
+package element
+
+import (
+	"fmt"
+	"fyne.io/fyne/v2/test"
+	"strings"
+	"testing"
+)
+
+func TestScrollbackAdd(t *testing.T) {
+	a := test.NewApp()
+	defer a.Quit()
+	c := NewConsole(a.NewWindow("console"), "")
+	c.Speak("one\ntwo")
+	first := c.view.Objects[0]
+	c.Error("three", "a detail")
+	if len(c.rows) != 4 || len(c.view.Objects) != 4 {
+		t.Fatalf("%d rows, %d objects, want 4", len(c.rows), len(c.view.Objects))
+	}
+	if c.view.Objects[0] != first {
+		t.Errorf("the rows before were made again")
+	}
+	if c.view.Objects[3].Visible() {
+		t.Errorf("the detail is shown")
+	}
+	for i := 0; i < scrollbackMax; i += 100 {
+		lines := make([]string, 100)
+		for j := range lines {
+			lines[j] = fmt.Sprintf("line %d", i+j)
+		}
+		c.Speak(strings.Join(lines, "\n"))
+	}
+	if len(c.rows) != scrollbackMax || len(c.view.Objects) != scrollbackMax || c.first != 4 {
+		t.Fatalf("%d rows, %d objects, first %d", len(c.rows), len(c.view.Objects), c.first)
+	}
+	for i, r := range c.rows {
+		if c.view.Objects[i] != r.obj {
+			t.Fatalf("object %d is not of row %d", i, i)
+		}
+	}
+	last := c.view.Objects[scrollbackMax-1]
+	if last.Position().Y <= c.view.Objects[0].Position().Y {
+		t.Errorf("the new row is not laid out under the others")
+	}
+	c.Progress("reading 1")
+	c.Progress("reading 2")
+	if got := c.Transcript(); got[len(got)-1] != "reading 2" || len(got) != scrollbackMax {
+		t.Errorf("progress is %q, of %d lines", got[len(got)-1], len(got))
+	}
+}
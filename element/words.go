@@ -0,0 +1,114 @@
+package element
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+/*
+
+  File:    words.go
+  Author:  Bob Shofner
+
+  MIT License - https://opensource.org/license/mit/
+
+  This permission notice shall be included in all copies
+    or substantial portions of the Software.
+
+*/
+/*
+  Description: The words of a typed line, for the commands and for
+  completing them.
+
+  A line is split into words at spaces. "double" or 'single' quotes keep
+  spaces in a word, and \ before a quote, a space or a \ keeps it as is
+  (any other \ is part of the word, as in C:\Users).
+*/
+
+// escaped are the characters a \ keeps as they are.
+const escaped = `"' \`
+
+// Word is a word of a line, without its quotes and escapes.
+type Word struct {
+	Text    string
+	Start   int  // in the line, in bytes
+	End     int  // after it
+	Quote   rune // that it started with, 0 if none
+	Escaped bool // it has a \ escape
+}
+
+// SplitWords splits a line into its words. open is the quote the line
+// ends in (the last word is not finished), 0 if none.
+func SplitWords(line string) (words []Word, open rune) {
+	words = make([]Word, 0)
+	var text strings.Builder
+	var word *Word
+	var quote rune
+	begin := func(at int) {
+		if word == nil {
+			word = &Word{Start: at}
+		}
+	}
+	end := func(at int) {
+		if word != nil {
+			word.Text, word.End = text.String(), at
+			words = append(words, *word)
+			text.Reset()
+			word = nil
+		}
+	}
+	for i := 0; i < len(line); {
+		r, size := utf8.DecodeRuneInString(line[i:])
+		next, nextSize := utf8.DecodeRuneInString(line[i+size:])
+		switch {
+		case r == '\\' && quote != '\'' && i+size < len(line) && strings.ContainsRune(escaped, next):
+			begin(i)
+			word.Escaped = true
+			text.WriteRune(next)
+			size += nextSize
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				text.WriteRune(r)
+			}
+		case r == '"' || r == '\'':
+			if word == nil {
+				begin(i)
+				word.Quote = r
+			}
+			quote = r
+		case r == ' ' || r == '\t':
+			end(i)
+		default:
+			begin(i)
+			text.WriteRune(r)
+		}
+		i += size
+	}
+	end(len(line))
+	return words, quote
+}
+
+// QuoteWord is text as it would be typed: in the quote (if not 0), else
+// with a \ before the spaces and quotes. A \ that would be read as an
+// escape is doubled.
+func QuoteWord(text string, quote rune) string {
+	if quote == '\'' { // nothing is escaped in single quotes
+		return text
+	}
+	var b strings.Builder
+	runes := []rune(text)
+	for i, r := range runes {
+		switch {
+		case r == '\\' && (i+1 == len(runes) || strings.ContainsRune(escaped, runes[i+1])):
+			b.WriteString(`\\`)
+		case r == '"' || quote == 0 && (r == ' ' || r == '\''):
+			b.WriteRune('\\')
+			b.WriteRune(r)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
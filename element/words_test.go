@@ -0,0 +1,81 @@
+package element
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSplitWords(t *testing.T) {
+	tests := []struct {
+		line  string
+		texts []string
+		open  rune
+	}{
+		{"", []string{}, 0},
+		{"  ls   -l\tdir ", []string{"ls", "-l", "dir"}, 0},
+		{`cd ~/My\ Docs/`, []string{"cd", "~/My Docs/"}, 0},
+		{`cd "My Docs"`, []string{"cd", "My Docs"}, 0},
+		{`cd 'My "Docs"'`, []string{"cd", `My "Docs"`}, 0},
+		{`a 'no \' escape`, []string{"a", `no \`, "escape"}, 0},
+		{`C:\Users\bob`, []string{`C:\Users\bob`}, 0},
+		{`say \"hi\" \\`, []string{"say", `"hi"`, `\`}, 0},
+		{`""`, []string{""}, 0},
+		{`cd "My Do`, []string{"cd", "My Do"}, '"'},
+		{`cd 'x`, []string{"cd", "x"}, '\''},
+		{`end\`, []string{`end\`}, 0},
+	}
+	for _, tt := range tests {
+		words, open := SplitWords(tt.line)
+		texts := make([]string, 0, len(words))
+		for _, w := range words {
+			texts = append(texts, w.Text)
+		}
+		if !reflect.DeepEqual(texts, tt.texts) || open != tt.open {
+			t.Errorf("SplitWords(%q) = %q, %q, want %q, %q", tt.line, texts, open, tt.texts, tt.open)
+		}
+	}
+}
+
+func TestSplitWordsPlace(t *testing.T) {
+	line := `cd  "a b" c\ d`
+	words, _ := SplitWords(line)
+	want := []Word{
+		{Text: "cd", Start: 0, End: 2},
+		{Text: "a b", Start: 4, End: 9, Quote: '"'},
+		{Text: "c d", Start: 10, End: 14, Escaped: true},
+	}
+	if !reflect.DeepEqual(words, want) {
+		t.Errorf("SplitWords(%q) = %+v, want %+v", line, words, want)
+	}
+}
+
+func TestQuoteWord(t *testing.T) {
+	tests := []struct {
+		text  string
+		quote rune
+		want  string
+	}{
+		{"plain", 0, "plain"},
+		{"My Docs", 0, `My\ Docs`},
+		{`it's "x"`, 0, `it\'s\ \"x\"`},
+		{`say "hi" it's`, '"', `say \"hi\" it's`},
+		{`no \ escape`, '\'', `no \ escape`},
+		{`C:\Users\bob`, 0, `C:\Users\bob`},
+		{`dir\`, 0, `dir\\`},
+		{`a\ b`, '"', `a\\ b`},
+	}
+	for _, tt := range tests {
+		got := QuoteWord(tt.text, tt.quote)
+		if got != tt.want {
+			t.Errorf("QuoteWord(%q, %q) = %q, want %q", tt.text, tt.quote, got, tt.want)
+		}
+		// read back, it is the text again
+		typed := got
+		if tt.quote != 0 {
+			typed = string(tt.quote) + got + string(tt.quote)
+		}
+		if words, _ := SplitWords(typed); len(words) != 1 || words[0].Text != tt.text {
+			t.Errorf("%q is read back as %+v", typed, words)
+		}
+	}
+}
@@ -1,5 +1,10 @@
 package fileutil
 
+import (
+	"path/filepath"
+	"regexp"
+)
+
 /*
 
   File:    etc.go
@@ -18,6 +23,16 @@ package fileutil
 //goland:noinspection GoUnusedGlobalVariable
 var DefaultHiddenFiles = "(^[^\\w].+)|(.+\\.bak)$"
 
+// IsHidden is true if the base name of a path matches the hidden expression.
+// A bad expression hides everything, so it is noticed.
+func IsHidden(hidden string, path string) bool {
+	if hidden == "" {
+		return false
+	}
+	match, err := regexp.MatchString(hidden, filepath.Base(path))
+	return match || err != nil
+}
+
 // StringList is the type of array
 type StringList []string
 
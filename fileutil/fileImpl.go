@@ -6,7 +6,6 @@ import (
 	"log"
 	"os"
 	"path/filepath"
-	"regexp"
 	"strings"
 )
 
@@ -47,12 +46,8 @@ func openFileImpl(path string, sel FileSelectFilter) (*DirectoryEntry, error) {
 			entries, e := os.ReadDir(path)
 			for _, entry := range entries {
 				// skip if a FILE matches the hidden expression
-				if sel.Hidden != "" {
-					//						if sel.Hidden != "" && !entry.IsDir() {
-					match, e := regexp.MatchString(sel.Hidden, filepath.Base(entry.Name()))
-					if match || e != nil {
-						continue
-					}
+				if IsHidden(sel.Hidden, entry.Name()) {
+					continue
 				}
 				// skip non-directories if only want directories
 				if sel.FileType == Dir && !entry.IsDir() {
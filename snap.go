@@ -15,6 +15,9 @@ package main
 */
 
 import (
+	"context"
+	"errors"
+	"flag"
 	"fmt"
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/canvas"
@@ -23,9 +26,11 @@ import (
 	"log"
 	"net/url"
 	"os"
+	"os/signal"
 	"path/filepath"
 	"snap/app"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -53,6 +58,10 @@ import (
 )
 
 func main() {
+	job := flag.String("job", "", "run the jobs of a job file, and exit")
+	watch := flag.Bool("watch", false, "with -job, run the jobs again when their sources change;\nwithout, watch the PATHs")
+	force := flag.Bool("force", false, "read every directory again, not only the changed ones")
+	flag.Parse()
 	// system has global variables
 	system := app.GetSystem()
 	defer func() { // remove TempDir, if normal exit
@@ -60,6 +69,7 @@ func main() {
 	}()
 
 	system.MainWindow.SetIcon(resourcePDFphotoPng)
+	app.DefaultLogo = resourcePDFphotoPng
 	system.App.Settings().SetTheme(element.NewTheme(system.App.Preferences()))
 
 	file := filepath.Join(system.Storage, system.AppName) + ".log"
@@ -73,8 +83,33 @@ func main() {
 	}
 	log.Printf("snap - System:  %s\n", system)
 
+	if *job != "" { // without the console
+		report := func(lines []string) {
+			fmt.Println(strings.Join(lines, "\n"))
+		}
+		jobs, err := app.LoadJobs(*job)
+		for i := range jobs {
+			jobs[i].Options.Force = *force
+		}
+		if err == nil {
+			err = app.RunJobs(jobs, report)
+		}
+		if err == nil && *watch { // until ^C
+			ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+			defer stop()
+			fmt.Println("** Watching the sources (^C to stop)")
+			app.WatchJobs(ctx, jobs, report)
+		}
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			app.DeleteTemp()
+			os.Exit(1)
+		}
+		return
+	}
+
 	system.App.Settings().SetTheme(element.NewTheme(system.App.Preferences()))
-	console := element.NewConsole(system.MainWindow, "command", 15)
+	console := element.NewConsole(system.MainWindow, "command")
 	content := container.NewBorder(nil, nil, nil, nil, console.Content)
 
 	prefs := system.App.Preferences()
@@ -82,8 +117,11 @@ func main() {
 	boundLast := binding.BindString(&lastPath)
 	pdfPath := prefs.StringWithFallback("pdf", app.UserHomeDir())
 	boundPDF := binding.BindString(&pdfPath)
+	output := prefs.String("output") // the last file written
+	options := app.LoadOptions(prefs)
+	forceFirst := *force // the first output only, not the session
 
-	paths := make([]string, 0)
+	paths := app.LoadPaths(prefs)
 	// unique list of sorted paths
 	var addPath = func(p string) {
 		for i, v := range paths {
@@ -96,14 +134,17 @@ func main() {
 		sort.SliceStable(paths, func(i, j int) bool {
 			return paths[i] < paths[j]
 		})
+		app.SavePaths(prefs, paths)
 		app.ShowCount(console, len(paths))
 	}
 
-	var addAction = func() {
+	var addAction = func(recursive bool) {
 		app.GetNextInputPath(system.MainWindow, boundLast, func(d string) {
-			console.Speak(fmt.Sprintf("** Added Path: %s", d))
 			prefs.SetString("last", lastPath)
-			addPath(d)
+			for _, dir := range app.AllDirs([]string{d}, recursive, options.Filter) {
+				console.Info(fmt.Sprintf("Added Path: %s", dir))
+				addPath(dir)
+			}
 		})
 		console.Focus()
 	}
@@ -122,29 +163,92 @@ func main() {
 		return fyne.CurrentApp().OpenURL(u)
 	}
 
-	var pdfAction = func() {
+	var writeOutput = func(d string, opts app.Options) {
+		err := app.RemoveOutput(d)
+		if err != nil {
+			app.ErrorText(console, fmt.Sprintf("Unable to remove old file. %s", err))
+			return
+		}
+		format := strings.ToUpper(app.OutputFormat(d))
+		opts.Progress = app.ShowProgress(console)
+		opts.Force = opts.Force || forceFirst
+		forceFirst = false
+		summary, err := app.CreateOutput(paths, d, opts)
+		if err != nil {
+			app.ErrorText(console, fmt.Sprintf("Unable to write %s. %s", format, err), summary.Lines()...)
+			return
+		}
+		console.Success(fmt.Sprintf("%s Written: %s", format, summary.Output()))
+		output = d
+		prefs.SetString("output", output)
+		app.ShowText(console, "Summary:", summary.Lines())
+		if len(summary.Written) > 0 {
+			if err = browse(summary.Written[0]); err != nil {
+				app.ErrorText(console, fmt.Sprintf("%v", err))
+			}
+		}
+		console.Focus()
+	}
+	var pdfAction = func(opts app.Options) {
+		app.GetNextOutputPath(system.MainWindow, boundPDF, func(d string) {
+			prefs.SetString("pdf", pdfPath)
+			writeOutput(d, opts)
+		})
+	}
+
+	var stopWatch context.CancelFunc
+	var watchAction = func(recursive bool) {
+		if stopWatch != nil {
+			stopWatch()
+			stopWatch = nil
+		}
 		if len(paths) < 1 {
-			app.ShowCount(console, len(paths))
 			app.ErrorText(console, "NO PATHS. Use \"(a) Add Path...\"")
-		} else {
-			app.GetNextOutputPath(system.MainWindow, boundPDF, func(d string) {
-				prefs.SetString("pdf", pdfPath)
-				err := os.Remove(d)
-				if err != nil && !os.IsNotExist(err) {
-					app.ErrorText(console, fmt.Sprintf("Unable to remove old file. %s", err))
-					return
-				}
-				app.CreatePDF(func(err error) {
-					app.ErrorText(console, err.Error())
-				}, paths, d)
-				console.Speak(fmt.Sprintf("** PDF Written: %s", d))
-				err = browse(d)
-				if err != nil {
-					app.ErrorText(console, fmt.Sprintf("%v", err))
-				}
-				console.Focus()
-			})
+			return
+		}
+		if output == "" {
+			app.ErrorText(console, "NO OUTPUT. Use \"(p) generate PDF\" first")
+			return
 		}
+		// as they are now, a later change is a new watch
+		dirs, file, opts := append([]string{}, paths...), output, options
+		opts.Progress = app.ShowProgress(console)
+		opts.Force = forceFirst
+		forceFirst = false
+		rebuild := func(changes []string) {
+			start := time.Now()
+			if err := app.RemoveOutput(file); err != nil {
+				app.ErrorText(console, fmt.Sprintf("Unable to remove old file. %s", err))
+				return
+			}
+			// the PATHs as p uses them, recursive is only what is watched
+			summary, err := app.CreateOutput(dirs, file, opts)
+			opts.Force = false
+			if err != nil {
+				app.ErrorText(console, fmt.Sprintf("Unable to rebuild %s. %s", file, err), summary.Lines()...)
+				return
+			}
+			rebuilt := fmt.Sprintf("Rebuilt %s: %d changes, %d files, %d issues, %.1fs",
+				file, len(changes), summary.Files, len(summary.Issues), time.Since(start).Seconds())
+			if len(summary.Issues) > 0 {
+				console.Warn(rebuilt)
+			} else {
+				console.Success(rebuilt)
+			}
+		}
+		ctx, cancel := context.WithCancel(context.Background())
+		stopWatch = cancel
+		w := app.Watcher{Dirs: dirs, Recursive: recursive, Filter: opts.Filter, Outputs: []string{file}, Rebuild: rebuild,
+			Problem: func(err error) {
+				console.Warn(fmt.Sprintf("watch: %v", err))
+			}}
+		console.Info(fmt.Sprintf("Watching %d PATHs for %s (\"watch off\" to stop)", len(dirs), file))
+		go func() {
+			rebuild(nil)
+			if err := w.Run(ctx); err != nil {
+				app.ErrorText(console, fmt.Sprintf("watch: %v", err))
+			}
+		}()
 	}
 
 	photo := canvas.NewImageFromResource(resourcePDFphotoPng)
@@ -155,22 +259,321 @@ func main() {
 	system.MainWindow.SetOnClosed(func() {
 	})
 
-	// process typed commands
-	var action = func(typed string) {
-		switch strings.ToLower(typed) {
-		case "x", "exit", "q", "quit":
+	// the typed commands
+	var noPaths = func() error {
+		if len(paths) < 1 {
+			return errors.New("NO PATHS. Use \"(a) Add Path...\"")
+		}
+		return nil
+	}
+	var absPath = func(word string) (string, error) {
+		return filepath.Abs(app.ExpandPath(word, ""))
+	}
+	commands := &app.Commands{}
+	commands.Add(app.Command{
+		Names: []string{"x", "exit", "q", "quit"},
+		Help:  "eXit or Quit",
+		Run: func(app.Args) error {
 			system.App.Quit()
-		case "a", "add":
-			addAction()
-		case "p", "pdf":
-			pdfAction()
-		case "l", "list":
-			app.ShowText(console, "Paths:", paths)
-		case "c", "clear":
+			return nil
+		}})
+	commands.Add(app.Command{
+		Names: []string{"l", "list"},
+		Help:  "List the PATHs",
+		Run: func(app.Args) error {
+			lines := make([]string, 0, len(paths))
+			for i, p := range paths {
+				lines = append(lines, fmt.Sprintf("%3d %s", i+1, p))
+			}
+			app.ShowText(console, "Paths:", lines)
+			return nil
+		}})
+	commands.Add(app.Command{
+		Names: []string{"a", "add"},
+		Usage: "[-r] [path ...]",
+		Help:  "Add PATHs (a selection window if none are typed)",
+		Flags: []app.Flag{{Name: "recursive", Short: "r", Help: "and all of the directories under them"}},
+		Run: func(args app.Args) error {
+			if len(args.Words) == 0 {
+				addAction(args.Has("recursive"))
+				return nil
+			}
+			for _, w := range args.Words {
+				dir, err := absPath(w)
+				if err != nil {
+					return err
+				}
+				if info, err := os.Stat(dir); err != nil || !info.IsDir() {
+					return errors.New(fmt.Sprintf("%s is not a directory", dir))
+				}
+				for _, d := range app.AllDirs([]string{dir}, args.Has("recursive"), options.Filter) {
+					console.Info(fmt.Sprintf("Added Path: %s", d))
+					addPath(d)
+				}
+			}
+			return nil
+		}})
+	commands.Add(app.Command{
+		Names: []string{"rm", "remove"},
+		Usage: "<number|path> ...",
+		Help:  "Remove PATHs, by their number in the list or name",
+		Run: func(args app.Args) error {
+			if len(args.Words) == 0 {
+				return errors.New("rm needs the number (from \"l\") or name of a PATH")
+			}
+			remove := make(map[string]bool)
+			for _, w := range args.Words {
+				if n, err := strconv.Atoi(w); err == nil {
+					if n < 1 || n > len(paths) {
+						return errors.New(fmt.Sprintf("there is no PATH %d", n))
+					}
+					remove[paths[n-1]] = true
+					continue
+				}
+				dir, err := absPath(w)
+				if err != nil {
+					return err
+				}
+				remove[dir] = true
+			}
+			kept := make([]string, 0, len(paths))
+			for _, p := range paths {
+				if remove[p] {
+					console.Info(fmt.Sprintf("Removed Path: %s", p))
+				} else {
+					kept = append(kept, p)
+				}
+			}
+			paths = kept
+			app.SavePaths(prefs, paths)
+			app.ShowCount(console, len(paths))
+			return nil
+		}})
+	commands.Add(app.Command{
+		Names: []string{"c", "clear"},
+		Help:  "Clear the PATHs",
+		Run: func(app.Args) error {
 			paths = nil
+			app.SavePaths(prefs, paths)
 			app.ShowCount(console, len(paths))
-		default:
-			app.ShowText(console, "Valid Commands:", help)
+			return nil
+		}})
+	commands.Add(app.Command{
+		Names: []string{"d", "dupes"},
+		Help:  "list Duplicate files in the PATHs",
+		Run: func(app.Args) error {
+			if err := noPaths(); err != nil {
+				return err
+			}
+			console.Progress("Looking for duplicates ...")
+			groups := app.FindDupes(paths, options.Filter)
+			if len(groups) == 0 {
+				console.Info("No duplicate files")
+			}
+			for _, g := range groups {
+				app.ShowText(console, "", g.Lines())
+			}
+			return nil
+		}})
+	commands.Add(app.Command{
+		Names: []string{"f", "filter"},
+		Usage: "[include|match|hidden|size|after|before|type|folders|clear] ...",
+		Help:  "Filter the files shown",
+		Raw:   true,
+		Run: func(args app.Args) error {
+			err := options.Filter.Set(args.Words)
+			options.Save(prefs)
+			app.ShowText(console, "Filter:", options.Filter.Lines())
+			return err
+		}})
+	commands.Add(app.Command{
+		Names: []string{"set"},
+		Usage: "[option] [value]",
+		Help:  "show the options of the output, or change one",
+		Raw:   true,
+		Run: func(args app.Args) error {
+			err := options.Set(args.Words)
+			options.Save(prefs)
+			app.ShowText(console, "Options:", options.Lines())
+			return err
+		}})
+	commands.Add(app.Command{
+		Names: []string{"p", "pdf"},
+		Usage: "[--cols n] [--rows n] [--force] [file]",
+		Help:  "generate PDF (or HTML, PNG, JPEG) (a selection window if no file)",
+		Flags: []app.Flag{
+			{Name: "cols", Value: true, Help: "of thumbnails on a page, this time"},
+			{Name: "rows", Value: true, Help: "of thumbnails on a page, this time"},
+			{Name: "force", Help: "read every directory again"},
+		},
+		Run: func(args app.Args) error {
+			if err := noPaths(); err != nil {
+				app.ShowCount(console, len(paths))
+				return err
+			}
+			opts := options
+			var err error
+			if opts.Cols, err = args.Int("cols", opts.Cols); err != nil {
+				return err
+			}
+			if opts.Rows, err = args.Int("rows", opts.Rows); err != nil {
+				return err
+			}
+			if opts.Cols < 0 || opts.Rows < 0 || opts.Cols > 20 || opts.Rows > 20 {
+				return errors.New("cols and rows are 1 to 20")
+			}
+			opts.Force = args.Has("force")
+			if len(args.Words) == 0 {
+				pdfAction(opts)
+				return nil
+			}
+			file, err := absPath(args.Word(0))
+			if err != nil {
+				return err
+			}
+			_ = boundPDF.Set(filepath.Dir(file))
+			prefs.SetString("pdf", pdfPath)
+			writeOutput(file, opts)
+			return nil
+		}})
+	commands.Add(app.Command{
+		Names: []string{"protect"},
+		Usage: "[off]",
+		Help:  "set PDF passwords and permissions",
+		Run: func(args app.Args) error {
+			if strings.ToLower(args.Word(0)) == "off" {
+				options.Protection = nil
+			} else {
+				options.Protection = app.AskProtection(console)
+			}
+			app.ShowText(console, "PDF Protection:", options.Protection.Lines())
+			return nil
+		}})
+	commands.Add(app.Command{
+		Names: []string{"watch"},
+		Usage: "[-r] [off]",
+		Help:  "rebuild the output when the PATHs change",
+		Flags: []app.Flag{{Name: "recursive", Short: "r", Help: "and the directories under them"}},
+		Run: func(args app.Args) error {
+			if strings.ToLower(args.Word(0)) == "off" {
+				if stopWatch != nil {
+					stopWatch()
+					stopWatch = nil
+				}
+				console.Info("Not watching")
+				return nil
+			}
+			watchAction(args.Has("recursive"))
+			return nil
+		}})
+	commands.Add(app.Command{
+		Names: []string{"run"},
+		Usage: "<job.json>",
+		Help:  "run the jobs of a job file",
+		Run: func(args app.Args) error {
+			if len(args.Words) != 1 {
+				return errors.New("run needs a job file")
+			}
+			jobs, err := app.LoadJobs(args.Word(0))
+			if err != nil {
+				return err
+			}
+			return app.RunJobs(jobs, func(lines []string) {
+				app.ShowText(console, "", lines)
+			})
+		}})
+	commands.Add(app.Command{
+		Names: []string{"save"},
+		Usage: "<name> | transcript <file>",
+		Help:  "save the PATHs, options and output as a project, or the console's lines",
+		Run: func(args app.Args) error {
+			if args.Word(0) == "transcript" {
+				if len(args.Words) != 2 {
+					return errors.New("save transcript needs a file")
+				}
+				file, err := absPath(args.Word(1))
+				if err != nil {
+					return err
+				}
+				if err = console.SaveTranscript(file); err != nil {
+					return err
+				}
+				console.Success(fmt.Sprintf("Transcript Saved: %s", file))
+				return nil
+			}
+			if len(args.Words) != 1 {
+				return errors.New("save needs a project name")
+			}
+			project := app.Project{Name: args.Word(0), Paths: paths, Output: output, Options: options}
+			if err := app.SaveProject(project); err != nil {
+				return err
+			}
+			console.Success(fmt.Sprintf("Project Saved: %s", project.Name))
+			return nil
+		}})
+	commands.Add(app.Command{
+		Names: []string{"load"},
+		Usage: "<name>",
+		Help:  "load a project",
+		Run: func(args app.Args) error {
+			if len(args.Words) != 1 {
+				return errors.New("load needs a project name")
+			}
+			project, err := app.LoadProject(args.Word(0))
+			if err != nil {
+				return err
+			}
+			project.Options.Protection = options.Protection
+			paths, options = project.Paths, project.Options
+			app.SavePaths(prefs, paths)
+			options.Save(prefs)
+			if project.Output != "" {
+				output = project.Output
+				prefs.SetString("output", output)
+				_ = boundPDF.Set(filepath.Dir(output))
+				prefs.SetString("pdf", pdfPath)
+			}
+			app.ShowText(console, "Project Loaded:", project.Lines())
+			return nil
+		}})
+	commands.Add(app.Command{
+		Names: []string{"projects"},
+		Help:  "list the projects",
+		Run: func(app.Args) error {
+			names := app.Projects()
+			if len(names) == 0 {
+				console.Info("No projects. Use \"save <name>\"")
+				return nil
+			}
+			app.ShowText(console, "Projects:", names)
+			return nil
+		}})
+	commands.Add(app.Command{
+		Names: []string{"h", "help"},
+		Usage: "[command]",
+		Help:  "Help, or the help of a command",
+		Run: func(args app.Args) error {
+			if len(args.Words) == 0 {
+				app.ShowText(console, "Valid Commands:", commands.Help())
+				return nil
+			}
+			lines, err := commands.HelpFor(args.Word(0))
+			if err != nil {
+				return errors.New(fmt.Sprintf("%q: %v", args.Word(0), err))
+			}
+			app.ShowText(console, "", lines)
+			return nil
+		}})
+
+	console.Commands = commands.Names()
+	console.SetHistory(filepath.Join(system.Storage, "history.txt"))
+
+	var action = func(typed string) {
+		err := commands.Run(typed)
+		if err == app.ErrUnknownCommand {
+			app.ShowText(console, "Valid Commands:", commands.Help())
+		} else if err != nil {
+			app.ErrorText(console, fmt.Sprintf("%v", err))
 		}
 		console.Focus()
 	}
@@ -179,7 +582,10 @@ func main() {
 		splash.Objects[0] = content
 		splash.Refresh()
 		app.ShowText(console, "", first)
-		app.ShowText(console, "Valid Commands", help)
+		app.ShowText(console, "Valid Commands", commands.Help())
+		if *watch {
+			watchAction(false)
+		}
 		// start the input console
 		go app.Input(console, action)
 		console.Focus()
@@ -193,11 +599,3 @@ var first = []string{
 	"\nSNAP (by Bob) is a program to create a PDF file",
 	"  showing thumbnails of  files on your hard drive.",
 }
-var help = []string{
-	"(x) eXit  or (q) Quit",
-	"(l) List PATHs",
-	"(a) Add PATHs ...",
-	"(c) Clear PATHs",
-	"(p) generate PDF ...",
-	"(h) Help",
-}